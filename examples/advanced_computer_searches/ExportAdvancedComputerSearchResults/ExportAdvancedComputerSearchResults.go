@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	searchID := 1 // Replace with the ID of the saved advanced computer search
+
+	// Retrieve the search results, keyed by display field
+	results, err := client.GetAdvancedComputerSearchResultsByID(searchID)
+	if err != nil {
+		log.Fatalf("Error fetching advanced computer search results: %v", err)
+	}
+
+	fmt.Printf("Advanced computer search %q returned %d rows with columns %v\n", results.Name, len(results.Results), results.Columns())
+
+	// Export the results to CSV
+	csvFile, err := os.Create("advanced_computer_search_results.csv")
+	if err != nil {
+		log.Fatalf("Error creating CSV file: %v", err)
+	}
+	defer csvFile.Close()
+
+	if err := results.WriteCSV(csvFile); err != nil {
+		log.Fatalf("Error exporting results to CSV: %v", err)
+	}
+
+	// Export the results to JSON
+	jsonFile, err := os.Create("advanced_computer_search_results.json")
+	if err != nil {
+		log.Fatalf("Error creating JSON file: %v", err)
+	}
+	defer jsonFile.Close()
+
+	if err := results.WriteJSON(jsonFile); err != nil {
+		log.Fatalf("Error exporting results to JSON: %v", err)
+	}
+
+	fmt.Println("Exported advanced computer search results to CSV and JSON")
+}
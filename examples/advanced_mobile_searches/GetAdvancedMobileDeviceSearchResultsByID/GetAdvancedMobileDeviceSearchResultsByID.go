@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	searchID := 1 // Replace with the ID of the saved advanced mobile device search
+
+	results, err := client.GetAdvancedMobileDeviceSearchResultsByID(searchID)
+	if err != nil {
+		log.Fatalf("Error fetching advanced mobile device search results: %v", err)
+	}
+
+	// Print each row using the search's display fields as columns
+	for _, row := range results.Results {
+		fmt.Printf("%d %s\n", row.ID, row.Name)
+		for _, field := range results.DisplayFields {
+			fmt.Printf("    %s: %s\n", field, row.Fields[field])
+		}
+	}
+}
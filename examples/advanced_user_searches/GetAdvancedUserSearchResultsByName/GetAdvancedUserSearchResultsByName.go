@@ -0,0 +1,31 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	searchName := "Advanced User Search Name" // Replace with the name of the saved advanced user search
+
+	results, err := client.GetAdvancedUserSearchResultsByName(searchName)
+	if err != nil {
+		log.Fatalf("Error fetching advanced user search results: %v", err)
+	}
+
+	// Write the results to stdout as CSV
+	if err := results.WriteCSV(os.Stdout); err != nil {
+		log.Fatalf("Error exporting results to CSV: %v", err)
+	}
+}
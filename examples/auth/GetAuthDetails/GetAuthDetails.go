@@ -0,0 +1,33 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Retrieve the account, privileges and sites of the current auth token
+	details, err := client.GetAuthDetails()
+	if err != nil {
+		log.Fatalf("Error fetching auth details: %v", err)
+	}
+
+	// Pretty print the auth details in JSON
+	detailsJSON, err := json.MarshalIndent(details, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling auth details data: %v", err)
+	}
+	fmt.Println("Auth Details:\n", string(detailsJSON))
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Check at startup that the token can call every SDK method the service uses
+	missing, err := client.GetMissingPrivilegesForMethods("GetComputersInventory", "GetComputerGroups", "UpdateComputerGroupByID")
+	if err != nil {
+		log.Fatalf("Error checking privileges: %v", err)
+	}
+
+	if len(missing) > 0 {
+		log.Fatalf("The API role is missing privileges: %v", missing)
+	}
+
+	fmt.Println("The API role has every privilege the service needs")
+}
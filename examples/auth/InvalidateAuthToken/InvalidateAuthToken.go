@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Do the work of the service
+	if _, err := client.GetJamfProVersion(); err != nil {
+		log.Fatalf("Error fetching Jamf Pro version: %v", err)
+	}
+
+	// Revoke the auth token on shutdown
+	if err := client.InvalidateAuthToken(); err != nil {
+		log.Fatalf("Error invalidating auth token: %v", err)
+	}
+
+	fmt.Println("Auth token invalidated")
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Exchange the current token for a new one with a fresh expiry
+	token, err := client.KeepAliveAuthToken()
+	if err != nil {
+		log.Fatalf("Error keeping auth token alive: %v", err)
+	}
+
+	fmt.Printf("Auth token renewed, expires: %s\n", token.Expires)
+}
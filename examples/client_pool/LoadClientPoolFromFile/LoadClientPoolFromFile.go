@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the client pool configuration file, for example:
+	//
+	//	{
+	//	  "Defaults": {"Environment": {"APIType": "jamfpro"}, "ClientOptions": {"LogLevel": "LogLevelWarn"}},
+	//	  "Instances": [
+	//	    {"Name": "acme", "Environment": {"InstanceName": "acme"}, "EnvPrefix": "ACME_"},
+	//	    {"Name": "globex", "ConfigFile": "globex.json"}
+	//	  ]
+	//	}
+	poolConfigFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientpool.json"
+
+	// Load the client pool
+	pool, err := jamfpro.LoadClientPoolFromFile(poolConfigFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load Jamf Pro client pool: %v", err)
+	}
+
+	// Build the client of a single tenant on demand
+	for _, tenant := range pool.Tenants() {
+		client, err := pool.Client(tenant)
+		if err != nil {
+			log.Printf("Failed to build client for %s: %v", tenant, err)
+			continue
+		}
+
+		details, err := client.GetAuthDetails()
+		if err != nil {
+			log.Printf("Error fetching auth details for %s: %v", tenant, err)
+			continue
+		}
+		fmt.Printf("%s: authenticated as %s\n", tenant, details.Account.Username)
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the client pool configuration file
+	poolConfigFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientpool.json"
+
+	// Load the client pool, clients are built when a tenant is first used
+	pool, err := jamfpro.LoadClientPoolFromFile(poolConfigFilePath)
+	if err != nil {
+		log.Fatalf("Failed to load Jamf Pro client pool: %v", err)
+	}
+	pool.MaxConcurrency = 4
+
+	// Count the scripts of every tenant concurrently
+	results := pool.Run(func(tenant string, client *jamfpro.Client) (interface{}, error) {
+		scripts, err := client.GetScripts("")
+		if err != nil {
+			return nil, err
+		}
+		return scripts.Size, nil
+	})
+
+	// Pretty print the per tenant results in JSON
+	resultsJSON, err := json.MarshalIndent(results, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling client pool results: %v", err)
+	}
+	fmt.Println("Client Pool Results:\n", string(resultsJSON))
+
+	if err := results.Err(); err != nil {
+		log.Fatalf("Error running across tenants: %v", err)
+	}
+}
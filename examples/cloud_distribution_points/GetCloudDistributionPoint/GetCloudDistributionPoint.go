@@ -0,0 +1,33 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Get the cloud distribution point configuration
+	cloudDistributionPoint, err := client.GetCloudDistributionPoint()
+	if err != nil {
+		log.Fatalf("Error fetching cloud distribution point: %v", err)
+	}
+
+	// Pretty print the configuration in JSON
+	response, err := json.MarshalIndent(cloudDistributionPoint, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling cloud distribution point data: %v", err)
+	}
+	fmt.Println("Cloud distribution point:\n", string(response))
+}
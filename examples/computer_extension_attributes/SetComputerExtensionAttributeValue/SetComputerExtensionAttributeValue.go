@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	computerID := 1                     // Replace with the actual computer ID
+	attributeName := "Pop Up Menu Test" // Replace with the name of the extension attribute
+	attributeValue := "Option 1"        // Must be a valid choice for pop-up menu attributes
+
+	// Set a single extension attribute value on the computer
+	err = client.SetComputerExtensionAttributeValue(computerID, attributeName, attributeValue)
+	if err != nil {
+		log.Fatalf("Error setting Computer Extension Attribute value: %v", err)
+	}
+
+	// Read the value back to confirm the update
+	value, err := client.GetComputerExtensionAttributeValue(computerID, attributeName)
+	if err != nil {
+		log.Fatalf("Error fetching Computer Extension Attribute value: %v", err)
+	}
+
+	fmt.Printf("Computer %d extension attribute %q is now: %s\n", computerID, attributeName, value)
+}
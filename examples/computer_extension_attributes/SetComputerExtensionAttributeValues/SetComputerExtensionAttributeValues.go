@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Set several extension attribute values on one computer in a single request
+	computerID := 1 // Replace with the actual computer ID
+	values := map[string]string{
+		"Asset Owner":      "IT Department",
+		"Warranty Expires": "2027-01-31",
+	}
+
+	err = client.SetComputerExtensionAttributeValues(computerID, values)
+	if err != nil {
+		log.Fatalf("Error setting Computer Extension Attribute values: %v", err)
+	}
+
+	fmt.Printf("Successfully set %d extension attribute values on computer %d\n", len(values), computerID)
+
+	// Set the same extension attribute value on several computers
+	computerIDs := []int{1, 2, 3} // Replace with the actual computer IDs
+	err = client.SetComputerExtensionAttributeValueForComputers(computerIDs, "Asset Owner", "IT Department")
+	if err != nil {
+		log.Fatalf("Error setting Computer Extension Attribute value on computers: %v", err)
+	}
+
+	fmt.Printf("Successfully set extension attribute value on %d computers\n", len(computerIDs))
+}
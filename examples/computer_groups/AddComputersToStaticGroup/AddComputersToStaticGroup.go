@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	groupID := 1 // Replace with the ID of the static group
+
+	// Add computers by ID. Large lists are sent in batches.
+	computerIDs := []int{1, 2, 3} // Replace with the computer IDs to add
+	if err := client.AddComputersToStaticGroup(groupID, computerIDs); err != nil {
+		log.Fatalf("Error adding computers to static group: %v", err)
+	}
+	fmt.Printf("Added %d computers to static computer group %d\n", len(computerIDs), groupID)
+
+	// Add computers by serial number
+	serialNumbers := []string{"C02ABC123DEF", "C02XYZ987UVW"} // Replace with the serial numbers to add
+	if err := client.AddComputersToStaticGroupBySerialNumber(groupID, serialNumbers); err != nil {
+		log.Fatalf("Error adding computers to static group by serial number: %v", err)
+	}
+	fmt.Printf("Added %d computers by serial number to static computer group %d\n", len(serialNumbers), groupID)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Build the criteria. Priorities, and/or joins and parentheses are assigned by the builder
+	// and the result is validated before anything is sent to Jamf Pro.
+	criteria, err := jamfpro.Where("Operating System Version").GreaterThanOrEqual("14.0").
+		And("Last Check-in").LessThanXDaysAgo(30).
+		AndGroup(jamfpro.Where("Model").Like("MacBook").Or("Model").Like("iMac")).
+		Build()
+	if err != nil {
+		log.Fatalf("Error building criteria: %v", err)
+	}
+
+	newSmartGroup := &jamfpro.ResourceComputerGroup{
+		Name:    "Recently active Sonoma laptops and iMacs",
+		IsSmart: true,
+		Site: jamfpro.SharedResourceSite{
+			ID:   -1,
+			Name: "None",
+		},
+		Criteria: criteria,
+	}
+
+	// Call CreateComputerGroup function
+	createdGroup, err := client.CreateComputerGroup(newSmartGroup)
+	if err != nil {
+		log.Fatalf("Error creating Computer Group: %v", err)
+	}
+
+	// Pretty print the created group in XML
+	groupXML, err := xml.MarshalIndent(createdGroup, "", "    ") // Indent with 4 spaces
+	if err != nil {
+		log.Fatalf("Error marshaling Computer Group data: %v", err)
+	}
+	fmt.Println("Created Computer Group:\n", string(groupXML))
+}
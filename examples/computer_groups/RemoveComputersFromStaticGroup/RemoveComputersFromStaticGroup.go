@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	groupID := 1 // Replace with the ID of the static group
+
+	// Remove computers by ID. Large lists are sent in batches.
+	computerIDs := []int{1, 2, 3} // Replace with the computer IDs to remove
+	if err := client.RemoveComputersFromStaticGroup(groupID, computerIDs); err != nil {
+		log.Fatalf("Error removing computers from static group: %v", err)
+	}
+	fmt.Printf("Removed %d computers from static computer group %d\n", len(computerIDs), groupID)
+}
@@ -1,2 +1,26 @@
-// TODO
 package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Delete the Cloud Services Connection token exchange
+	if err := client.DeleteCSATokenExchange(); err != nil {
+		log.Fatalf("Error deleting CSA token exchange: %v", err)
+	}
+
+	fmt.Println("Deleted CSA token exchange")
+}
@@ -1,2 +1,33 @@
-// TODO
 package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Retrieve the Cloud Services Connection token exchange details
+	exchange, err := client.GetCSATokenExchangeInfo()
+	if err != nil {
+		log.Fatalf("Error fetching CSA token exchange: %v", err)
+	}
+
+	// Pretty print the token exchange details in JSON
+	exchangeJSON, err := json.MarshalIndent(exchange, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling CSA token exchange data: %v", err)
+	}
+	fmt.Println("CSA Token Exchange:\n", string(exchangeJSON))
+}
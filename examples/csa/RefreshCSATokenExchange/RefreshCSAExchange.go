@@ -1,2 +1,27 @@
-// TODO
 package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Refresh the token exchange with the Jamf Account credentials
+	exchange, err := client.RefreshCSATokenExchange("admin@example.com", "password")
+	if err != nil {
+		log.Fatalf("Error refreshing CSA token exchange: %v", err)
+	}
+
+	fmt.Printf("Refreshed CSA token exchange with scopes: %v\n", exchange.Scopes)
+}
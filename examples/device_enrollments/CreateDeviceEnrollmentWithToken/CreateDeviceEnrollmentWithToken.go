@@ -0,0 +1,43 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Define the path to the server token downloaded from Apple Business Manager
+	tokenPath := "/Users/dafyddwatkins/localtesting/jamfpro/server_token.p7m"
+
+	tokenData, err := os.ReadFile(tokenPath)
+	if err != nil {
+		log.Fatalf("Error reading server token: %v", err)
+	}
+
+	token := jamfpro.ResourceDeviceEnrollmentToken{
+		TokenFileName: filepath.Base(tokenPath),
+		EncodedToken:  base64.StdEncoding.EncodeToString(tokenData),
+	}
+
+	// Create the device enrollment instance with the server token
+	created, err := client.CreateDeviceEnrollmentWithToken(token)
+	if err != nil {
+		log.Fatalf("Error creating device enrollment: %v", err)
+	}
+
+	fmt.Printf("Created device enrollment with ID: %s\n", created.ID)
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Define the path to the server token downloaded from Apple Business Manager
+	tokenPath := "/Users/dafyddwatkins/localtesting/jamfpro/server_token.p7m"
+
+	// Create the device enrollment instance from the server token file
+	created, err := client.CreateDeviceEnrollmentWithTokenFile(tokenPath)
+	if err != nil {
+		log.Fatalf("Error creating device enrollment: %v", err)
+	}
+
+	fmt.Printf("Created device enrollment with ID: %s\n", created.ID)
+}
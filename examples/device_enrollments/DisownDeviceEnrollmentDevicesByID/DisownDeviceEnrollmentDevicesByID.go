@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Define the ID of the device enrollment instance and the devices to release
+	enrollmentID := "1"
+	serialNumbers := []string{"C02XXXXXXXXX", "C02YYYYYYYYY"}
+
+	// Disown the devices. This releases them from the organization in Apple Business Manager.
+	result, err := client.DisownDeviceEnrollmentDevicesByID(enrollmentID, serialNumbers)
+	if err != nil {
+		log.Fatalf("Error disowning devices: %v", err)
+	}
+
+	for serialNumber, status := range result.Devices {
+		fmt.Printf("%s: %s\n", serialNumber, status)
+	}
+}
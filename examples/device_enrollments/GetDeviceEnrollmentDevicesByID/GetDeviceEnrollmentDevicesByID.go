@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Define the ID of the device enrollment instance
+	enrollmentID := "1"
+
+	// Retrieve the devices assigned to the device enrollment instance
+	devices, err := client.GetDeviceEnrollmentDevicesByID(enrollmentID)
+	if err != nil {
+		log.Fatalf("Error fetching device enrollment devices: %v", err)
+	}
+
+	for _, device := range devices.Results {
+		fmt.Printf("%s\t%s\t%s\n", device.SerialNumber, device.Model, device.ProfileStatus)
+	}
+	fmt.Printf("Total devices: %d\n", devices.TotalCount)
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Define the ID of the device enrollment instance
+	enrollmentID := "1"
+
+	// Retrieve the history of the device enrollment instance, newest first
+	history, err := client.GetDeviceEnrollmentHistoryByID(enrollmentID, "sort=date:desc")
+	if err != nil {
+		log.Fatalf("Error fetching device enrollment history: %v", err)
+	}
+
+	// Pretty print the history in JSON
+	historyJSON, err := json.MarshalIndent(history, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling device enrollment history data: %v", err)
+	}
+	fmt.Println("Device Enrollment History:\n", string(historyJSON))
+}
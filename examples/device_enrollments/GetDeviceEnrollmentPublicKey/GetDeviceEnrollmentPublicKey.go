@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Retrieve the public key to upload to Apple Business Manager
+	publicKey, err := client.GetDeviceEnrollmentPublicKey()
+	if err != nil {
+		log.Fatalf("Error fetching device enrollment public key: %v", err)
+	}
+
+	// Save the public key to disk
+	if err := os.WriteFile("jamf_pro_public_key.pem", publicKey, 0644); err != nil {
+		log.Fatalf("Error writing public key: %v", err)
+	}
+
+	fmt.Println("Saved device enrollment public key to jamf_pro_public_key.pem")
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Define the ID of the device enrollment instance
+	enrollmentID := "1"
+
+	// Retrieve the sync history of the device enrollment instance
+	syncStates, err := client.GetDeviceEnrollmentSyncStatesByID(enrollmentID)
+	if err != nil {
+		log.Fatalf("Error fetching device enrollment sync states: %v", err)
+	}
+
+	// Pretty print the sync states in JSON
+	syncStatesJSON, err := json.MarshalIndent(syncStates, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling sync states data: %v", err)
+	}
+	fmt.Println("Device Enrollment Sync States:\n", string(syncStatesJSON))
+}
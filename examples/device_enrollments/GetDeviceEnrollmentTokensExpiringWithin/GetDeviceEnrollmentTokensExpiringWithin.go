@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Find server tokens that have expired or expire in the next 30 days
+	expiring, err := client.GetDeviceEnrollmentTokensExpiringWithin(30 * 24 * time.Hour)
+	if err != nil {
+		log.Fatalf("Error checking device enrollment token expiry: %v", err)
+	}
+
+	if len(expiring) == 0 {
+		fmt.Println("No device enrollment tokens expire in the next 30 days")
+		return
+	}
+
+	for _, token := range expiring {
+		if token.Expired {
+			fmt.Printf("WARNING: token for %s (ID %s) expired on %s\n", token.Name, token.ID, token.ExpirationDate.Format("2006-01-02"))
+			continue
+		}
+		fmt.Printf("WARNING: token for %s (ID %s) expires in %d days on %s\n", token.Name, token.ID, token.DaysRemaining, token.ExpirationDate.Format("2006-01-02"))
+	}
+}
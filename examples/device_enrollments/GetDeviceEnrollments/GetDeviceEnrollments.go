@@ -0,0 +1,33 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Retrieve all device enrollment instances
+	enrollments, err := client.GetDeviceEnrollments("")
+	if err != nil {
+		log.Fatalf("Error fetching device enrollments: %v", err)
+	}
+
+	// Pretty print the device enrollments in JSON
+	enrollmentsJSON, err := json.MarshalIndent(enrollments, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling device enrollments data: %v", err)
+	}
+	fmt.Println("Device Enrollments:\n", string(enrollmentsJSON))
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Define the ID of the device enrollment instance and the new server token
+	enrollmentID := "1"
+	tokenPath := "/Users/dafyddwatkins/localtesting/jamfpro/server_token.p7m"
+
+	// Renew the device enrollment instance with the new server token
+	renewed, err := client.RenewDeviceEnrollmentTokenByID(enrollmentID, tokenPath)
+	if err != nil {
+		log.Fatalf("Error renewing device enrollment token: %v", err)
+	}
+
+	fmt.Printf("Renewed device enrollment %s, token now expires %s\n", renewed.Name, renewed.TokenExpirationDate)
+}
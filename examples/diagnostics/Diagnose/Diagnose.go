@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Gather the tenant diagnostics report
+	report, err := client.Diagnose()
+	if err != nil {
+		log.Fatalf("Error running diagnostics: %v", err)
+	}
+
+	// Print the human readable summary
+	fmt.Println(report.String())
+
+	// Write the full report as JSON, e.g. for a monitoring system
+	if err := report.WriteJSON(os.Stdout); err != nil {
+		log.Fatalf("Error writing diagnostics report: %v", err)
+	}
+
+	// Exit non-zero when the tenant is not healthy
+	if report.Status != jamfpro.DiagnosticStatusOK {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Show upload progress on stderr
+	client.Progress = jamfpro.NewTerminalProgressBar(os.Stderr)
+
+	// Describe what Jamf Pro does not report about the distribution points
+	options := &jamfpro.DistributionPointUploaderOptions{
+		MountPoints: map[string]string{
+			"Primary File Share": "/Volumes/CasperShare",
+		},
+		S3: &jamfpro.S3DistributionPointUploader{
+			Bucket:          "example-jamf-packages",
+			Region:          "eu-west-1",
+			SecretAccessKey: os.Getenv("JAMF_S3_SECRET_ACCESS_KEY"),
+		},
+	}
+
+	// List the distribution points and the uploader selected for each
+	targets, err := client.GetDistributionPointUploaders(options)
+	if err != nil {
+		log.Fatalf("Error selecting distribution point uploaders: %v", err)
+	}
+	for _, target := range targets {
+		if target.Uploader == nil {
+			fmt.Printf("%s (%s): unavailable, %s\n", target.Name, target.Type, target.Unavailable)
+			continue
+		}
+		fmt.Printf("%s (%s): ready\n", target.Name, target.Type)
+	}
+
+	// Upload the package to every available distribution point, stopping the uploads on Ctrl+C
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	results, err := client.UploadPackageToDistributionPointsWithContext(ctx, "/Users/dafyddwatkins/Downloads/Microsoft_Edge_121.0.2277.83.pkg", options)
+	if err != nil {
+		log.Fatalf("Error uploading package to distribution points: %v", err)
+	}
+
+	response, err := json.MarshalIndent(results, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling upload results: %v", err)
+	}
+	fmt.Println("Upload results:\n", string(response))
+}
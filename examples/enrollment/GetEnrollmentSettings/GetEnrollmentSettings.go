@@ -0,0 +1,33 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Retrieve the enrollment settings
+	settings, err := client.GetEnrollmentSettings()
+	if err != nil {
+		log.Fatalf("Error fetching enrollment settings: %v", err)
+	}
+
+	// Pretty print the enrollment settings in JSON
+	settingsJSON, err := json.MarshalIndent(settings, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling enrollment settings data: %v", err)
+	}
+	fmt.Println("Enrollment Settings:\n", string(settingsJSON))
+}
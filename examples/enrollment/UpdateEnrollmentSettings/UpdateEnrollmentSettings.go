@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Retrieve the current enrollment settings so unchanged values are preserved
+	settings, err := client.GetEnrollmentSettings()
+	if err != nil {
+		log.Fatalf("Error fetching enrollment settings: %v", err)
+	}
+
+	// Restrict re-enrollment and flush policy history when a computer re-enrolls
+	settings.RestrictReenrollment = true
+	settings.FlushPolicyHistory = true
+
+	updated, err := client.UpdateEnrollmentSettings(settings)
+	if err != nil {
+		log.Fatalf("Error updating enrollment settings: %v", err)
+	}
+
+	fmt.Printf("Updated enrollment settings, restrict re-enrollment: %t\n", updated.RestrictReenrollment)
+}
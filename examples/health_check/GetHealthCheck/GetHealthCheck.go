@@ -24,10 +24,10 @@ func main() {
 		log.Fatalf("Error fetching Health Check properties: %v", err)
 	}
 
-	// Pretty print the Health Check files in XML
+	// Pretty print the Health Check result in JSON
 	response, err := json.MarshalIndent(HealthCheck, "", "    ") // Indent with 4 spaces
 	if err != nil {
-		log.Fatalf("Error marshaling Health Check package data: %v", err)
+		log.Fatalf("Error marshaling Health Check data: %v", err)
 	}
 	fmt.Println("Fetched Health Check properties:\n", string(response))
 }
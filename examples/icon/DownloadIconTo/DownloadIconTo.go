@@ -0,0 +1,34 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Icon ID, resolution and scale to download
+	iconID := 1
+	res := "original"
+	scale := "0"
+
+	// Download the icon into memory
+	var icon bytes.Buffer
+	contentType, err := client.DownloadIconTo(iconID, &icon, res, scale)
+	if err != nil {
+		log.Fatalf("Error downloading icon: %v", err)
+	}
+
+	fmt.Printf("Downloaded icon %d: %d bytes of %s, file extension %s\n", iconID, icon.Len(), contentType, jamfpro.IconFileExtension(contentType))
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Download every Self Service icon used by policies and apps
+	export, err := client.ExportSelfServiceIcons("/Users/dafyddwatkins/localtesting/icons", &jamfpro.SelfServiceIconExportOptions{
+		Res:          "original",
+		SkipExisting: true,
+	})
+	if export != nil {
+		response, marshalErr := json.MarshalIndent(export, "", "    ")
+		if marshalErr != nil {
+			log.Fatalf("Error marshaling icon export: %v", marshalErr)
+		}
+		fmt.Println("Icon export:\n", string(response))
+	}
+	if err != nil {
+		log.Fatalf("Error exporting icons: %v", err)
+	}
+}
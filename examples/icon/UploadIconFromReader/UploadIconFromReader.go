@@ -0,0 +1,35 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Icon data already in memory, e.g. generated or fetched from another system
+	iconData, err := os.ReadFile("/Users/dafyddwatkins/Downloads/icon.png")
+	if err != nil {
+		log.Fatalf("Error reading icon: %v", err)
+	}
+
+	// Upload the icon; its type is detected from the content
+	uploadResponse, err := client.UploadIconFromReader("icon.png", bytes.NewReader(iconData))
+	if err != nil {
+		log.Fatalf("Error uploading icon: %v", err)
+	}
+
+	fmt.Printf("Uploaded icon ID %d: %s\n", uploadResponse.ID, uploadResponse.URL)
+}
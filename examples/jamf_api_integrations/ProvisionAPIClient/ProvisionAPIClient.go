@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Describe the identity of the automation tool by the SDK methods it calls
+	request := jamfpro.APIClientProvisioningRequest{
+		DisplayName:                "Package Uploader",
+		Methods:                    []string{"GetCategories", "GetPackages", "CreatePackage", "UpdatePackageByID", "CreateJCDS2PackageV2", "RenewJCDS2Credentials"},
+		AccessTokenLifetimeSeconds: 600,
+	}
+
+	// Preview the privileges and changes before provisioning
+	plan, err := client.PlanAPIClientProvisioning(request)
+	if err != nil {
+		log.Fatalf("Error planning API client provisioning: %v", err)
+	}
+
+	planJSON, err := json.MarshalIndent(plan, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling provisioning plan: %v", err)
+	}
+	fmt.Println("Provisioning Plan:\n", string(planJSON))
+
+	if !plan.Valid() {
+		log.Fatalf("Provisioning plan is not valid, unknown methods: %v, unavailable privileges: %v", plan.UnknownMethods, plan.UnavailablePrivileges)
+	}
+
+	// Create or update the API role and API client
+	result, err := client.ProvisionAPIClient(request)
+	if err != nil {
+		log.Fatalf("Error provisioning API client: %v", err)
+	}
+
+	fmt.Printf("API role %s holds %d privileges\n", result.Role.DisplayName, len(result.Role.Privileges))
+	if result.Credentials != nil {
+		fmt.Printf("New API client ID: %s\n", result.Credentials.ClientID)
+		fmt.Printf("New API client secret: %s\n", result.Credentials.ClientSecret)
+	}
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Issue new credentials for the API client, the previous secret stops working
+	credentials, err := client.RotateAPIClientCredentials("Package Uploader")
+	if err != nil {
+		log.Fatalf("Error rotating API client credentials: %v", err)
+	}
+
+	fmt.Printf("API client ID: %s\n", credentials.ClientID)
+	fmt.Printf("New API client secret: %s\n", credentials.ClientSecret)
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Look up the privileges an automation tool needs for the SDK methods it calls
+	privileges, unknown := jamfpro.RequiredPrivilegesForMethods("GetComputersInventory", "GetPolicies", "UpdatePolicyByID")
+	for _, privilege := range privileges {
+		fmt.Println(privilege)
+	}
+	if len(unknown) > 0 {
+		fmt.Printf("No privilege mapping for: %v\n", unknown)
+	}
+
+	// Explain a 403 from an SDK call by the privilege its endpoint needs
+	_, err = client.GetComputersInventory("")
+	if err != nil {
+		var missing *jamfpro.MissingPrivilegeError
+		if errors.As(jamfpro.EnrichAccessDeniedError(err), &missing) {
+			log.Fatalf("Access denied to %s %s, missing privilege: %v", missing.Method, missing.Path, missing.Privileges)
+		}
+		log.Fatalf("Error fetching computer inventory: %v", err)
+	}
+}
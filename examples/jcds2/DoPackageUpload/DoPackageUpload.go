@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"os"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 )
@@ -18,6 +19,9 @@ func main() {
 		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
 	}
 
+	// Show upload progress on stderr so it does not mix with the JSON response on stdout
+	client.Progress = jamfpro.NewTerminalProgressBar(os.Stderr)
+
 	// Specify the path to the file you want to upload
 	filePath := "/Users/dafyddwatkins/localtesting/terraform/support_files/packages/microsoft-edge-121-0-2277-106.pkg"
 
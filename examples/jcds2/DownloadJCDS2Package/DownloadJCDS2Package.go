@@ -0,0 +1,41 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Define the JCDS 2.0 file to download and where to save it
+	fileName := "microsoft-edge-121-0-2277-106.pkg"
+	savePath := "/Users/dafyddwatkins/localtesting/jamfpro/downloads/microsoft-edge-121-0-2277-106.pkg"
+
+	// Show download progress on stderr
+	client.Progress = jamfpro.NewTerminalProgressBar(os.Stderr)
+
+	// Download the file; running the example again after an interruption resumes the download
+	result, err := client.DownloadJCDS2PackageToFile(fileName, savePath)
+	if err != nil {
+		log.Fatalf("Error downloading JCDS 2.0 package: %v", err)
+	}
+
+	// Pretty print the download result in JSON
+	resultJSON, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling download result: %v", err)
+	}
+	fmt.Println("Downloaded JCDS 2.0 package:\n", string(resultJSON))
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Initialize the client of the tenant to promote packages to
+	productionClient, err := jamfpro.BuildClientWithConfigFile("/Users/dafyddwatkins/localtesting/jamfpro/clientconfig-production.json")
+	if err != nil {
+		log.Fatalf("Failed to initialize production Jamf Pro client: %v", err)
+	}
+
+	// Preview which JCDS 2.0 files would be copied from staging to production
+	plan, err := client.MirrorJCDS2PackagesToClient(productionClient, &jamfpro.JCDS2MirrorOptions{DryRun: true})
+	if err != nil {
+		log.Fatalf("Error planning JCDS 2.0 mirror: %v", err)
+	}
+	fmt.Printf("%d files to copy, %d already in production\n", len(plan.Copied), len(plan.Skipped))
+
+	// Copy the files
+	result, err := client.MirrorJCDS2PackagesToClient(productionClient, nil)
+	if err != nil {
+		log.Fatalf("Error mirroring JCDS 2.0 packages: %v", err)
+	}
+
+	// Pretty print the mirror result in JSON
+	resultJSON, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling mirror result: %v", err)
+	}
+	fmt.Println("JCDS 2.0 Mirror Result:\n", string(resultJSON))
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Define the local directory to mirror the JCDS 2.0 inventory to
+	mirrorDir := "/Users/dafyddwatkins/localtesting/jamfpro/jcds-mirror"
+
+	// Mirror every JCDS 2.0 file, skipping files that are already identical locally
+	result, err := client.MirrorJCDS2PackagesToDirectory(mirrorDir, &jamfpro.JCDS2MirrorOptions{Concurrency: 3})
+	if err != nil {
+		log.Fatalf("Error mirroring JCDS 2.0 packages: %v", err)
+	}
+
+	// Pretty print the mirror result in JSON
+	resultJSON, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling mirror result: %v", err)
+	}
+	fmt.Println("JCDS 2.0 Mirror Result:\n", string(resultJSON))
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Specify the path to the file you want to upload
+	filePath := "/Users/dafyddwatkins/localtesting/terraform/support_files/packages/Xcode_15.3.pkg"
+
+	// Stream the package in 128 MiB parts, 6 at a time. If the upload is interrupted, running the
+	// example again with the same state file resumes it from the last completed part.
+	options := &jamfpro.JCDS2UploadOptions{
+		PartSize:      128 * 1024 * 1024,
+		Concurrency:   6,
+		StateFilePath: "/Users/dafyddwatkins/localtesting/jamfpro/Xcode_15.3.pkg.upload.json",
+		// Log progress as JSON lines, e.g. for CI pipelines that collect structured logs
+		Progress: jamfpro.ProgressListenerFunc(func(progress jamfpro.TransferProgress) {
+			line, _ := json.Marshal(progress)
+			log.Println(string(line))
+		}),
+	}
+
+	fileResponse, err := client.UploadJCDS2PackageFromFile(filePath, options)
+	if err != nil {
+		log.Fatalf("Failed to upload package: %v", err)
+	}
+
+	// Pretty print the file upload response in JSON
+	responseJSON, err := json.MarshalIndent(fileResponse, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling file upload response: %v", err)
+	}
+	fmt.Println("Uploaded JCDS 2.0 file:\n", string(responseJSON))
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	groupID := 1 // Replace with the ID of the static group
+
+	// Add mobile devices by serial number. Large lists are sent in batches.
+	serialNumbers := []string{"F9FXXXXXXXXX", "DMPXXXXXXXXX"} // Replace with the serial numbers to add
+	if err := client.AddMobileDevicesToStaticGroupBySerialNumber(groupID, serialNumbers); err != nil {
+		log.Fatalf("Error adding mobile devices to static group: %v", err)
+	}
+	fmt.Printf("Added %d mobile devices to static mobile device group %d\n", len(serialNumbers), groupID)
+
+	// Remove a mobile device by ID
+	if err := client.RemoveMobileDevicesFromStaticGroup(groupID, []int{1}); err != nil {
+		log.Fatalf("Error removing mobile device from static group: %v", err)
+	}
+	fmt.Printf("Removed mobile device 1 from static mobile device group %d\n", groupID)
+}
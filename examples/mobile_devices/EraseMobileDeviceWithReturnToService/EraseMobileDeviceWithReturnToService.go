@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Look up the return to service configuration to use for the wipe
+	configuration, err := client.GetReturnToServiceConfigurationByName("Classroom iPads")
+	if err != nil {
+		log.Fatalf("Error fetching return to service configuration: %v", err)
+	}
+
+	// Erase the mobile device and return it to service
+	mobileDeviceID := 1 // Replace with a real mobile device ID
+	commands, err := client.EraseMobileDeviceByID(mobileDeviceID, jamfpro.MobileDeviceEraseOptions{
+		PreserveDataPlan:               true,
+		ReturnToServiceConfigurationID: configuration.ID,
+	})
+	if err != nil {
+		log.Fatalf("Error erasing mobile device: %v", err)
+	}
+
+	for _, command := range commands {
+		fmt.Printf("Queued erase command %s for mobile device %d\n", command.ID, mobileDeviceID)
+	}
+}
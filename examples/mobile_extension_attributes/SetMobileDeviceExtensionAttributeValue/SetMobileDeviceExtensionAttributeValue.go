@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	mobileDeviceID := 1               // Replace with the actual mobile device ID
+	attributeName := "Asset Owner"    // Replace with the name of the extension attribute
+	attributeValue := "IT Department" // Replace with the value to set
+
+	err = client.SetMobileDeviceExtensionAttributeValue(mobileDeviceID, attributeName, attributeValue)
+	if err != nil {
+		log.Fatalf("Error setting Mobile Device Extension Attribute value: %v", err)
+	}
+
+	value, err := client.GetMobileDeviceExtensionAttributeValue(mobileDeviceID, attributeName)
+	if err != nil {
+		log.Fatalf("Error fetching Mobile Device Extension Attribute value: %v", err)
+	}
+
+	fmt.Printf("Mobile device %d extension attribute %q is now: %s\n", mobileDeviceID, attributeName, value)
+}
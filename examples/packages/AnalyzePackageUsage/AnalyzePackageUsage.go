@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Cross-reference packages with policies, patch titles, prestages and JCDS 2.0
+	report, err := client.AnalyzePackageUsage(&jamfpro.PackageUsageOptions{Concurrency: 8})
+	if err != nil {
+		log.Fatalf("Error analyzing package usage: %v", err)
+	}
+
+	// Print the human readable summary
+	fmt.Println(report.String())
+
+	// List packages that nothing refers to, candidates for deletion
+	for _, pkg := range report.Unused() {
+		fmt.Printf("Unused: %d %s (%s)\n", pkg.ID, pkg.Name, pkg.FileName)
+	}
+
+	// Write the full report as JSON
+	if err := report.WriteJSON(os.Stdout); err != nil {
+		log.Fatalf("Error writing package usage report: %v", err)
+	}
+}
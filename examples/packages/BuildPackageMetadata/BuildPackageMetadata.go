@@ -0,0 +1,43 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Specify the path to the package. A sidecar manifest such as Firefox.pkg.yaml next to it sets the
+	// package metadata, see Firefox.pkg.yaml in this directory.
+	filePath := "/Users/dafyddwatkins/localtesting/terraform/support_files/packages/Firefox.pkg"
+
+	// Read the bundle ID, version and title from the flat package
+	info, err := jamfpro.ReadFlatPackageInfo(filePath)
+	if err != nil {
+		log.Fatalf("Error reading flat package: %v", err)
+	}
+	infoJSON, err := json.MarshalIndent(info, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling flat package info: %v", err)
+	}
+	fmt.Println("Flat package info:\n", string(infoJSON))
+
+	// Defaults for fields that neither the package nor its manifest set
+	defaults := &jamfpro.ResourcePackage{
+		Category: "Web Browsers",
+		Priority: 10,
+	}
+
+	// Build the metadata that DoPackageUploadWithManifest would create the package with
+	packageData, err := jamfpro.BuildPackageMetadata(filePath, defaults)
+	if err != nil {
+		log.Fatalf("Error building package metadata: %v", err)
+	}
+	packageJSON, err := json.MarshalIndent(packageData, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling package metadata: %v", err)
+	}
+	fmt.Println("Package metadata:\n", string(packageJSON))
+}
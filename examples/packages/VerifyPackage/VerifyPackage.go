@@ -0,0 +1,40 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Define the ID of the package to verify
+	packageID := 42
+
+	// Stream the JCDS 2.0 object of the package and check it against the hash recorded on the package
+	verification, err := client.VerifyPackage(packageID)
+	if err != nil {
+		log.Fatalf("Error verifying package: %v", err)
+	}
+
+	// Pretty print the verification in JSON
+	verificationJSON, err := json.MarshalIndent(verification, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling package verification data: %v", err)
+	}
+	fmt.Println("Package Verification:\n", string(verificationJSON))
+
+	if !verification.Valid {
+		log.Fatalf("Package %d does not match its recorded %s hash", packageID, verification.HashType)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Build the policy. Package, script, group and category names are resolved to IDs
+	// and the policy is validated locally before anything is created.
+	policy, err := client.NewPolicyBuilder("jamfpro-sdk-builder-policy").
+		Category("Applications").
+		Triggers(jamfpro.PolicyTriggerCheckin, jamfpro.PolicyTriggerEnrollmentComplete).
+		CustomTrigger("install-chrome").
+		Frequency(jamfpro.PolicyFrequencyOncePerComputer).
+		Retry(jamfpro.PolicyRetryEventCheckin, 3, false).
+		DateLimits(time.Now(), time.Now().AddDate(0, 1, 0)).
+		InstallPackage("GoogleChrome.pkg").
+		AddScript("Remove Quarantine", jamfpro.PolicyScriptPriorityAfter, "/Applications/Google Chrome.app").
+		ScopeComputerGroups("All Managed Clients").
+		ExcludeComputerGroups("Kiosk Macs").
+		Reboot(jamfpro.PolicySubsetReboot{
+			StartupDisk:    "Current Startup Disk",
+			NoUserLoggedIn: "Do not restart",
+			UserLoggedIn:   "Do not restart",
+		}).
+		Build()
+	if err != nil {
+		log.Fatalf("Error building policy: %v", err)
+	}
+
+	// Create the policy
+	created, err := client.CreatePolicy(policy)
+	if err != nil {
+		log.Fatalf("Error creating policy: %v", err)
+	}
+
+	fmt.Printf("Created policy with ID: %d\n", created.ID)
+}
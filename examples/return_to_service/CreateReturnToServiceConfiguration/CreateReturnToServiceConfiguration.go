@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Define the return to service configuration, referencing the Wi-Fi configuration profile by ID
+	configuration := jamfpro.ResourceReturnToServiceConfiguration{
+		DisplayName:   "Classroom iPads",
+		WifiProfileID: "1", // Replace with a real mobile device configuration profile ID
+	}
+
+	// Create the return to service configuration
+	created, err := client.CreateReturnToServiceConfiguration(configuration)
+	if err != nil {
+		log.Fatalf("Error creating return to service configuration: %v", err)
+	}
+
+	fmt.Printf("Created return to service configuration with ID: %s\n", created.ID)
+}
@@ -18,16 +18,16 @@ func main() {
 		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
 	}
 
-	// Call GetReturnToService function
-	returnToService, err := client.GetReturnToService()
+	// Fetch all return to service configurations
+	returnToService, err := client.GetReturnToServiceConfigurations()
 	if err != nil {
-		log.Fatalf("Error fetching return to service  properties: %v", err)
+		log.Fatalf("Error fetching return to service configurations: %v", err)
 	}
 
-	// Pretty print the return to service  files in JSON
+	// Pretty print the return to service configurations in JSON
 	response, err := json.MarshalIndent(returnToService, "", "    ") // Indent with 4 spaces
 	if err != nil {
 		log.Fatalf("Error marshaling return to service data: %v", err)
 	}
-	fmt.Println("Fetched return to service properties:\n", string(response))
+	fmt.Println("Fetched return to service configurations:\n", string(response))
 }
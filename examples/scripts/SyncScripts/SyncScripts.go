@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Directory of script files with front-matter headers, e.g. a Git checkout
+	scriptDirectory := "/Users/dafyddwatkins/GitHub/jamf-scripts"
+
+	options := &jamfpro.ScriptSyncOptions{
+		// Plan only; set to false to apply the changes
+		DryRun: true,
+		// Delete scripts without a file, but only in the categories this repository manages
+		Delete:            true,
+		ManagedCategories: []string{"Utilities"},
+	}
+
+	plan, err := client.SyncScripts(scriptDirectory, options)
+	if plan != nil {
+		if writeErr := plan.WriteText(os.Stdout); writeErr != nil {
+			log.Fatalf("Error writing script sync plan: %v", writeErr)
+		}
+	}
+	if err != nil {
+		log.Fatalf("Error syncing scripts: %v", err)
+	}
+
+	if plan.DryRun && plan.HasChanges() {
+		fmt.Println("Jamf Pro scripts differ from the repository")
+		os.Exit(2)
+	}
+}
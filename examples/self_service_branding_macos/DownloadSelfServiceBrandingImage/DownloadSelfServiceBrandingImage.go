@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Retrieve the branding configuration to find its header image
+	branding, err := client.GetSelfServiceBrandingMacOSByID("1")
+	if err != nil {
+		log.Fatalf("Error fetching self service branding: %v", err)
+	}
+
+	// Download the header image
+	image, err := client.DownloadSelfServiceBrandingImage(branding.BrandingHeaderImageId)
+	if err != nil {
+		log.Fatalf("Error downloading branding image: %v", err)
+	}
+
+	if err := os.WriteFile("branding_header.png", image, 0644); err != nil {
+		log.Fatalf("Error writing branding image: %v", err)
+	}
+
+	fmt.Printf("Saved branding header image (%d bytes) to branding_header.png\n", len(image))
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Download the SSO certificate
+	certificate, err := client.DownloadSSOCertificate()
+	if err != nil {
+		log.Fatalf("Error downloading SSO certificate: %v", err)
+	}
+
+	// Save the certificate to disk
+	savePath := "jamfpro-sso.pem"
+	if err := os.WriteFile(savePath, certificate, 0644); err != nil {
+		log.Fatalf("Error saving SSO certificate: %v", err)
+	}
+
+	fmt.Printf("SSO certificate saved to %s\n", savePath)
+}
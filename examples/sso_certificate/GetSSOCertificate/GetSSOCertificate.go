@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Fetch the current SSO certificate
+	certificate, err := client.GetSSOCertificate()
+	if err != nil {
+		log.Fatalf("Error fetching SSO certificate: %v", err)
+	}
+
+	details := certificate.KeystoreDetails
+	fmt.Printf("Keystore: %s (%s, %s)\n", certificate.Keystore.KeystoreFileName, certificate.Keystore.Type, certificate.Keystore.KeystoreSetupType)
+	fmt.Printf("Subject: %s\nIssuer: %s\nExpiration: %s\n", details.Subject, details.Issuer, details.Expiration)
+
+	// Warn when the certificate expires within the next 30 days
+	expiring, err := details.ExpiresWithin(30 * 24 * time.Hour)
+	if err != nil {
+		log.Fatalf("Error checking SSO certificate expiry: %v", err)
+	}
+	if expiring {
+		fmt.Println("WARNING: the SSO certificate expires within 30 days")
+	}
+}
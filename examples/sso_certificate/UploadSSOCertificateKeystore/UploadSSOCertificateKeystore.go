@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	keystorePath := "/path/to/saml-signing.p12" // Replace with the path to the PKCS12 keystore
+	keystorePassword := "password"              // Replace with the keystore password
+	keyPassword := "password"                   // Replace with the private key password, often the keystore password
+
+	// Inspect the keystore locally before uploading it
+	metadata, err := jamfpro.ReadPKCS12KeystoreMetadata(keystorePath, keystorePassword)
+	if err != nil {
+		log.Fatalf("Error reading keystore: %v", err)
+	}
+	fmt.Printf("Uploading keystore for %s, valid until %s\n", metadata.Subject, metadata.NotAfter.Format(time.RFC3339))
+
+	// Upload the keystore as the SSO certificate
+	keystore, err := client.UploadSSOCertificateKeystore(keystorePath, keystorePassword, keyPassword)
+	if err != nil {
+		log.Fatalf("Error uploading SSO keystore: %v", err)
+	}
+
+	fmt.Printf("SSO keystore %s uploaded using key %s\n", keystore.KeystoreFileName, keystore.Key)
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Fetch the startup status of the Jamf Pro server
+	startupStatus, err := client.GetStartupStatus()
+	if err != nil {
+		log.Fatalf("Error fetching startup status: %v", err)
+	}
+
+	// Pretty print the startup status in JSON
+	response, err := json.MarshalIndent(startupStatus, "", "    ") // Indent with 4 spaces
+	if err != nil {
+		log.Fatalf("Error marshaling startup status data: %v", err)
+	}
+	fmt.Println("Fetched startup status:\n", string(response))
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Define the TeamViewer connection
+	configuration := jamfpro.ResourceTeamViewerRemoteAdministrationConfiguration{
+		DisplayName:    "TeamViewer",
+		SiteID:         "-1",
+		ScriptToken:    "script-token-from-teamviewer",
+		Enabled:        true,
+		SessionTimeout: 60,
+	}
+
+	created, err := client.CreateTeamViewerRemoteAdministrationConfiguration(configuration)
+	if err != nil {
+		log.Fatalf("Error creating TeamViewer configuration: %v", err)
+	}
+
+	fmt.Printf("Created TeamViewer configuration with ID: %s\n", created.ID)
+}
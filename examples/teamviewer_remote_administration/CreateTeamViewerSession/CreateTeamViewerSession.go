@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Define the ID of the TeamViewer configuration
+	configurationID := "1"
+
+	// Define the session with the target computer
+	session := jamfpro.ResourceTeamViewerSession{
+		DeviceID:    "1",
+		DeviceType:  "COMPUTER",
+		Description: "Helpdesk ticket 1234",
+	}
+
+	created, err := client.CreateTeamViewerSession(configurationID, session)
+	if err != nil {
+		log.Fatalf("Error creating TeamViewer session: %v", err)
+	}
+
+	// Retrieve the session to get the supporter link
+	details, err := client.GetTeamViewerSessionByID(configurationID, created.ID)
+	if err != nil {
+		log.Fatalf("Error fetching TeamViewer session: %v", err)
+	}
+
+	fmt.Printf("Created TeamViewer session %s, supporter link: %s\n", details.ID, details.SupporterLink)
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Retrieve all TeamViewer remote administration configurations
+	configurations, err := client.GetTeamViewerRemoteAdministrationConfigurations("")
+	if err != nil {
+		log.Fatalf("Error fetching TeamViewer configurations: %v", err)
+	}
+
+	// Pretty print the configurations in JSON
+	configurationsJSON, err := json.MarshalIndent(configurations, "", "    ")
+	if err != nil {
+		log.Fatalf("Error marshaling TeamViewer configurations data: %v", err)
+	}
+	fmt.Println("TeamViewer Remote Administration Configurations:\n", string(configurationsJSON))
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	userID := 1                    // Replace with the actual user ID
+	attributeName := "Cost Centre" // Replace with the name of the extension attribute
+	attributeValue := "CC-1001"    // Replace with the value to set
+
+	err = client.SetUserExtensionAttributeValue(userID, attributeName, attributeValue)
+	if err != nil {
+		log.Fatalf("Error setting User Extension Attribute value: %v", err)
+	}
+
+	value, err := client.GetUserExtensionAttributeValue(userID, attributeName)
+	if err != nil {
+		log.Fatalf("Error fetching User Extension Attribute value: %v", err)
+	}
+
+	fmt.Printf("User %d extension attribute %q is now: %s\n", userID, attributeName, value)
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	groupID := 1 // Replace with the ID of the static group
+
+	// Add users by username. Large lists are sent in batches.
+	usernames := []string{"jdoe", "asmith"} // Replace with the usernames to add
+	if err := client.AddUsersToStaticGroupByUsername(groupID, usernames); err != nil {
+		log.Fatalf("Error adding users to static group: %v", err)
+	}
+	fmt.Printf("Added %d users to static user group %d\n", len(usernames), groupID)
+
+	// Remove a user by ID
+	if err := client.RemoveUsersFromStaticGroup(groupID, []int{1}); err != nil {
+		log.Fatalf("Error removing user from static group: %v", err)
+	}
+	fmt.Printf("Removed user 1 from static user group %d\n", groupID)
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Define the Venafi CA configuration
+	venafi := jamfpro.ResourceVenafi{
+		Name:              "Venafi CA",
+		ProxyAddress:      "venafi-proxy.example.com:9443",
+		RevocationEnabled: true,
+		ClientID:          "jamf-pro",
+		RefreshToken:      "refresh-token",
+	}
+
+	// Create the Venafi CA configuration
+	created, err := client.CreateVenafi(venafi)
+	if err != nil {
+		log.Fatalf("Error creating Venafi CA configuration: %v", err)
+	}
+
+	fmt.Printf("Created Venafi CA configuration with ID: %s\n", created.ID)
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Check the connection status of a Venafi CA configuration
+	venafiID := 1 // Replace with a real Venafi CA configuration ID
+	status, err := client.GetVenafiConnectionStatusByID(venafiID)
+	if err != nil {
+		log.Fatalf("Error fetching Venafi connection status: %v", err)
+	}
+
+	fmt.Printf("Venafi CA configuration %d connection status: %s\n", venafiID, status.Status)
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Fetch the configuration profiles that use a Venafi CA configuration
+	venafiID := 1 // Replace with a real Venafi CA configuration ID
+	profiles, err := client.GetVenafiDependentProfilesByID(venafiID)
+	if err != nil {
+		log.Fatalf("Error fetching Venafi dependent profiles: %v", err)
+	}
+
+	fmt.Printf("Configuration profiles using Venafi CA configuration %d: %d\n", venafiID, profiles.TotalCount)
+	for _, profile := range profiles.Results {
+		fmt.Printf("ID: %s, Name: %s, URL Path: %s\n", profile.ID, profile.Name, profile.URLPath)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Download the proxy trust store of a Venafi CA configuration
+	venafiID := 1 // Replace with a real Venafi CA configuration ID
+	trustStore, err := client.GetVenafiProxyTrustStoreByID(venafiID)
+	if err != nil {
+		log.Fatalf("Error downloading Venafi proxy trust store: %v", err)
+	}
+
+	// Save the trust store to disk
+	savePath := "venafi-proxy-trust.jks"
+	if err := os.WriteFile(savePath, trustStore, 0600); err != nil {
+		log.Fatalf("Error saving Venafi proxy trust store: %v", err)
+	}
+
+	fmt.Printf("Saved proxy trust store for Venafi CA configuration %d to %s\n", venafiID, savePath)
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func main() {
+	// Define the path to the JSON configuration file
+	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
+
+	// Initialize the Jamf Pro client with the HTTP client configuration
+	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
+	if err != nil {
+		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+	}
+
+	// Upload a proxy trust store for a Venafi CA configuration
+	venafiID := 1                                       // Replace with a real Venafi CA configuration ID
+	trustStorePath := "/path/to/venafi-proxy-trust.jks" // Replace with the path to the JKS file
+	if err := client.UploadVenafiProxyTrustStoreByID(venafiID, trustStorePath); err != nil {
+		log.Fatalf("Error uploading Venafi proxy trust store: %v", err)
+	}
+
+	fmt.Printf("Uploaded proxy trust store for Venafi CA configuration %d\n", venafiID)
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"os"
 )
 
 // Base64EncodeCertificate reads a certificate file and returns its content as a base64-encoded string.
@@ -23,6 +24,37 @@ func Base64EncodeCertificate(certPath string) (string, error) {
 	return encoded, nil
 }
 
+// Base64EncodeKeystore reads a PKCS12 keystore file and returns its content as a base64-encoded string.
+func Base64EncodeKeystore(keystorePath string) (string, error) {
+	allowedExtensions := []string{".p12", ".pfx"} // Define allowed keystore file extensions
+
+	// Use the secure file reading helper
+	data, err := SafeReadCertificateFile(keystorePath, allowedExtensions)
+	if err != nil {
+		return "", fmt.Errorf("failed to read keystore file securely: %v", err)
+	}
+
+	// Base64 encode the file's content
+	encoded := base64.StdEncoding.EncodeToString(data)
+	return encoded, nil
+}
+
+// Base64EncodeServerToken reads an Automated Device Enrollment server token file downloaded from Apple Business
+// or School Manager and returns its content as a base64-encoded string.
+func Base64EncodeServerToken(tokenPath string) (string, error) {
+	allowedExtensions := []string{".p7m"} // Define allowed server token file extensions
+
+	// Use the secure file reading helper
+	data, err := SafeReadCertificateFile(tokenPath, allowedExtensions)
+	if err != nil {
+		return "", fmt.Errorf("failed to read server token file securely: %v", err)
+	}
+
+	// Base64 encode the file's content
+	encoded := base64.StdEncoding.EncodeToString(data)
+	return encoded, nil
+}
+
 // ReadJCDSPackageTypes returns a reader and size for a package file securely after applying multiple checks.
 func ReadJCDSPackageTypes(filePath string) (io.Reader, int64, error) {
 	allowedExtensions := []string{".pkg", ".dmg", ".zip"} // Define allowed package file extensions
@@ -38,3 +70,11 @@ func ReadJCDSPackageTypes(filePath string) (io.Reader, int64, error) {
 
 	return reader, size, nil
 }
+
+// OpenJCDSPackageTypes opens a package file for streaming uploads and returns it with its size. Unlike
+// ReadJCDSPackageTypes the file is not read into memory. The caller closes the file.
+func OpenJCDSPackageTypes(filePath string) (*os.File, int64, error) {
+	allowedExtensions := []string{".pkg", ".dmg", ".zip"} // Define allowed package file extensions
+
+	return OpenJCDSPackageFile(filePath, allowedExtensions)
+}
@@ -57,6 +57,41 @@ func SafeReadJCDSPackageFile(filePath string, allowedExtensions []string) ([]byt
 	return data, nil
 }
 
+// OpenJCDSPackageFile opens a package file for streaming after applying the same checks as
+// SafeReadJCDSPackageFile, so that large packages are never held in memory. The caller closes the file.
+func OpenJCDSPackageFile(filePath string, allowedExtensions []string) (*os.File, int64, error) {
+	// Clean the file path first to prevent directory traversal
+	cleanedPath := cleanPath(filePath)
+
+	// Check for a valid file extension
+	if !isValidExtension(cleanedPath, allowedExtensions) {
+		return nil, 0, fmt.Errorf("file extension '%s' is not allowed", filepath.Ext(cleanedPath))
+	}
+
+	// Resolve any symbolic links to ensure the path is safe
+	resolvedPath, err := resolveSymlinks(cleanedPath)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Open the file and determine its size
+	file, err := os.Open(resolvedPath)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open Jamf Pro package: %v", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, fmt.Errorf("failed to stat Jamf Pro package: %v", err)
+	}
+	if !info.Mode().IsRegular() {
+		file.Close()
+		return nil, 0, fmt.Errorf("Jamf Pro package '%s' is not a regular file", filePath)
+	}
+
+	return file, info.Size(), nil
+}
+
 // resolveSymlinks resolves symbolic links and returns the absolute path.
 func resolveSymlinks(filePath string) (string, error) {
 	cleanPath := filepath.Clean(filePath)
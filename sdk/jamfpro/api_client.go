@@ -2,12 +2,25 @@ package jamfpro
 
 import (
 	"fmt"
+	"net/http"
+	"sync"
 
 	"github.com/deploymenttheory/go-api-http-client/httpclient"
 )
 
 type Client struct {
 	HTTP *httpclient.Client
+	// Progress receives the progress of package uploads, icon uploads, file attachments and downloads.
+	// Nil reports nothing.
+	Progress ProgressListener
+
+	// options are the http client options the client was built with. Raw requests, which the http client
+	// cannot send, use its timeout, token refresh buffer and retry settings.
+	options       httpclient.ClientOptions
+	rawClientOnce sync.Once
+	rawClient     *http.Client
+	// tokenMu serialises the auth token checks and updates made by the SDK outside the http client.
+	tokenMu sync.Mutex
 }
 
 // ClientConfig combines authentication and environment settings for the client.
@@ -25,7 +38,7 @@ func BuildClient(config httpclient.ClientConfig) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Client{HTTP: httpClient}, nil
+	return &Client{HTTP: httpClient, options: config.ClientOptions}, nil
 }
 
 // BuildClientWithEnv initializes a new Jamf Pro client using configurations
@@ -49,7 +62,7 @@ func BuildClientWithEnv() (*Client, error) {
 	}
 
 	// Create and return the Jamf Pro client with the HTTP client
-	return &Client{HTTP: httpClient}, nil
+	return &Client{HTTP: httpClient, options: loadedConfig.ClientOptions}, nil
 }
 
 // BuildClientWithConfigFile initializes a new Jamf Pro client using a
@@ -69,5 +82,5 @@ func BuildClientWithConfigFile(configFilePath string) (*Client, error) {
 	}
 
 	// Create and return the Jamf Pro client with the HTTP client
-	return &Client{HTTP: httpClient}, nil
+	return &Client{HTTP: httpClient, options: loadedConfig.ClientOptions}, nil
 }
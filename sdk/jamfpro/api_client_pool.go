@@ -0,0 +1,332 @@
+package jamfpro
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+)
+
+// DefaultClientPoolConcurrency is the number of tenants Run works on at the same time when
+// ClientPool.MaxConcurrency is not set.
+const DefaultClientPoolConcurrency = 8
+
+// ClientPool holds the configuration of many Jamf Pro instances and builds their clients on first use.
+// It is safe for concurrent use.
+type ClientPool struct {
+	// MaxConcurrency limits how many tenants Run works on at the same time.
+	MaxConcurrency int
+
+	mu      sync.Mutex
+	tenants []string
+	configs map[string]httpclient.ClientConfig
+	clients map[string]*Client
+}
+
+// ClientPoolFile is the layout of a client pool configuration file. Each instance is either a client
+// configuration, merged over Defaults, or a reference to a single instance configuration file as used by
+// BuildClientWithConfigFile. When EnvPrefix is set, credentials are read from <EnvPrefix>CLIENT_ID,
+// <EnvPrefix>CLIENT_SECRET, <EnvPrefix>USERNAME and <EnvPrefix>PASSWORD so that secrets can stay out of the file.
+//
+//	{
+//	  "Defaults": {"Environment": {"APIType": "jamfpro"}, "ClientOptions": {"LogLevel": "LogLevelWarn"}},
+//	  "Instances": [
+//	    {"Name": "acme", "Environment": {"InstanceName": "acme"}, "EnvPrefix": "ACME_"},
+//	    {"Name": "globex", "ConfigFile": "globex.json"}
+//	  ]
+//	}
+type ClientPoolFile struct {
+	Defaults  json.RawMessage   `json:"Defaults,omitempty"`
+	Instances []json.RawMessage `json:"Instances"`
+}
+
+// clientPoolInstance holds the pool specific fields of an instance entry.
+type clientPoolInstance struct {
+	Name       string `json:"Name"`
+	ConfigFile string `json:"ConfigFile,omitempty"`
+	EnvPrefix  string `json:"EnvPrefix,omitempty"`
+}
+
+// ClientPoolResult is the outcome of a function run against one tenant.
+type ClientPoolResult struct {
+	Tenant   string        `json:"tenant"`
+	Value    interface{}   `json:"value,omitempty"`
+	Err      error         `json:"-"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration"`
+}
+
+// ClientPoolResults are the per tenant outcomes of Run, ordered by tenant name.
+type ClientPoolResults []ClientPoolResult
+
+// NewClientPool creates a pool from client configurations keyed by tenant name.
+func NewClientPool(configs map[string]httpclient.ClientConfig) *ClientPool {
+	pool := &ClientPool{
+		configs: make(map[string]httpclient.ClientConfig, len(configs)),
+		clients: make(map[string]*Client),
+	}
+	for tenant, config := range configs {
+		pool.tenants = append(pool.tenants, tenant)
+		pool.configs[tenant] = config
+	}
+	sort.Strings(pool.tenants)
+	return pool
+}
+
+// LoadClientPoolFromFile creates a pool from a client pool configuration file. Instance configuration files
+// are resolved relative to the pool file. Clients are not built until they are first used.
+func LoadClientPoolFromFile(configFilePath string) (*ClientPool, error) {
+	data, err := os.ReadFile(configFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client pool configuration file: %w", err)
+	}
+
+	var file ClientPoolFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal client pool configuration file: %w", err)
+	}
+
+	var defaults httpclient.ClientConfig
+	if len(file.Defaults) > 0 {
+		if err := json.Unmarshal(file.Defaults, &defaults); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal client pool defaults: %w", err)
+		}
+	}
+
+	configs := make(map[string]httpclient.ClientConfig, len(file.Instances))
+	for i, raw := range file.Instances {
+		var instance clientPoolInstance
+		if err := json.Unmarshal(raw, &instance); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal client pool instance %d: %w", i, err)
+		}
+		if instance.Name == "" {
+			return nil, fmt.Errorf("client pool instance %d has no name", i)
+		}
+		if _, exists := configs[instance.Name]; exists {
+			return nil, fmt.Errorf("client pool instance %s is defined more than once", instance.Name)
+		}
+
+		var config httpclient.ClientConfig
+		if instance.ConfigFile != "" {
+			path := instance.ConfigFile
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(filepath.Dir(configFilePath), path)
+			}
+			loaded, err := httpclient.LoadConfigFromFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load configuration of client pool instance %s: %w", instance.Name, err)
+			}
+			config = *loaded
+		} else {
+			config = defaults
+			if err := json.Unmarshal(raw, &config); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal configuration of client pool instance %s: %w", instance.Name, err)
+			}
+		}
+
+		if instance.EnvPrefix != "" {
+			applyClientPoolEnvCredentials(&config, instance.EnvPrefix)
+		}
+		setClientPoolConfigDefaults(&config)
+		if err := validateClientPoolConfig(config); err != nil {
+			return nil, fmt.Errorf("invalid configuration of client pool instance %s: %w", instance.Name, err)
+		}
+
+		configs[instance.Name] = config
+	}
+
+	return NewClientPool(configs), nil
+}
+
+// Tenants returns the tenant names in the pool in alphabetical order.
+func (p *ClientPool) Tenants() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.tenants...)
+}
+
+// Client returns the client of a tenant, building and caching it on first use. Build failures are not
+// cached, so a later call retries.
+func (p *ClientPool) Client(tenant string) (*Client, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if client, ok := p.clients[tenant]; ok {
+		return client, nil
+	}
+
+	config, ok := p.configs[tenant]
+	if !ok {
+		return nil, fmt.Errorf("client pool has no tenant named %s", tenant)
+	}
+
+	client, err := BuildClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build client for tenant %s: %w", tenant, err)
+	}
+
+	p.clients[tenant] = client
+	return client, nil
+}
+
+// Run calls fn for every tenant in the pool concurrently and collects the results per tenant.
+func (p *ClientPool) Run(fn func(tenant string, client *Client) (interface{}, error)) ClientPoolResults {
+	return p.RunTenants(p.Tenants(), fn)
+}
+
+// RunTenants calls fn for the given tenants concurrently, at most MaxConcurrency at a time, and collects
+// the results per tenant. A panic in fn is recovered and reported as that tenant's error.
+func (p *ClientPool) RunTenants(tenants []string, fn func(tenant string, client *Client) (interface{}, error)) ClientPoolResults {
+	concurrency := p.MaxConcurrency
+	if concurrency <= 0 {
+		concurrency = DefaultClientPoolConcurrency
+	}
+
+	results := make(ClientPoolResults, len(tenants))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, tenant := range tenants {
+		wg.Add(1)
+		go func(i int, tenant string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			results[i] = p.runTenant(tenant, fn)
+		}(i, tenant)
+	}
+	wg.Wait()
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Tenant < results[j].Tenant
+	})
+	return results
+}
+
+func (p *ClientPool) runTenant(tenant string, fn func(tenant string, client *Client) (interface{}, error)) (result ClientPoolResult) {
+	result.Tenant = tenant
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			result.Err = fmt.Errorf("panic: %v", r)
+		}
+		if result.Err != nil {
+			result.Error = result.Err.Error()
+		}
+		result.Duration = time.Since(start)
+	}()
+
+	client, err := p.Client(tenant)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	result.Value, result.Err = fn(tenant, client)
+	return result
+}
+
+// Errors returns the error of every tenant that failed, keyed by tenant name.
+func (r ClientPoolResults) Errors() map[string]error {
+	errs := make(map[string]error)
+	for _, result := range r {
+		if result.Err != nil {
+			errs[result.Tenant] = result.Err
+		}
+	}
+	return errs
+}
+
+// Succeeded returns the results of the tenants that did not fail.
+func (r ClientPoolResults) Succeeded() ClientPoolResults {
+	var out ClientPoolResults
+	for _, result := range r {
+		if result.Err == nil {
+			out = append(out, result)
+		}
+	}
+	return out
+}
+
+// Err combines the errors of every failed tenant into one error, or returns nil when all succeeded.
+func (r ClientPoolResults) Err() error {
+	var messages []string
+	for _, result := range r {
+		if result.Err != nil {
+			messages = append(messages, fmt.Sprintf("%s: %v", result.Tenant, result.Err))
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d tenants failed:\n%s", len(messages), len(r), strings.Join(messages, "\n"))
+}
+
+// applyClientPoolEnvCredentials overrides credentials with those set in prefixed environment variables.
+func applyClientPoolEnvCredentials(config *httpclient.ClientConfig, prefix string) {
+	if value := os.Getenv(prefix + "CLIENT_ID"); value != "" {
+		config.Auth.ClientID = value
+	}
+	if value := os.Getenv(prefix + "CLIENT_SECRET"); value != "" {
+		config.Auth.ClientSecret = value
+	}
+	if value := os.Getenv(prefix + "USERNAME"); value != "" {
+		config.Auth.Username = value
+	}
+	if value := os.Getenv(prefix + "PASSWORD"); value != "" {
+		config.Auth.Password = value
+	}
+}
+
+// setClientPoolConfigDefaults applies the http client defaults to an inline instance configuration.
+func setClientPoolConfigDefaults(config *httpclient.ClientConfig) {
+	if config.Environment.APIType == "" {
+		config.Environment.APIType = "jamfpro"
+	}
+	if config.ClientOptions.LogLevel == "" {
+		config.ClientOptions.LogLevel = "LogLevelWarn"
+	}
+	if config.ClientOptions.LogOutputFormat == "" {
+		config.ClientOptions.LogOutputFormat = "console"
+	}
+	if config.ClientOptions.LogConsoleSeparator == "" {
+		config.ClientOptions.LogConsoleSeparator = ","
+	}
+	if config.ClientOptions.MaxRetryAttempts < 0 {
+		config.ClientOptions.MaxRetryAttempts = httpclient.DefaultMaxRetryAttempts
+	}
+	if config.ClientOptions.MaxConcurrentRequests <= 0 {
+		config.ClientOptions.MaxConcurrentRequests = httpclient.DefaultMaxConcurrentRequests
+	}
+	if config.ClientOptions.TokenRefreshBufferPeriod <= 0 {
+		config.ClientOptions.TokenRefreshBufferPeriod = httpclient.DefaultTokenBufferPeriod
+	}
+	if config.ClientOptions.TotalRetryDuration <= 0 {
+		config.ClientOptions.TotalRetryDuration = httpclient.DefaultTotalRetryDuration
+	}
+	if config.ClientOptions.CustomTimeout <= 0 {
+		config.ClientOptions.CustomTimeout = httpclient.DefaultTimeout
+	}
+}
+
+// validateClientPoolConfig checks that an instance has an instance name and a complete credential pair.
+func validateClientPoolConfig(config httpclient.ClientConfig) error {
+	if config.Environment.InstanceName == "" {
+		return fmt.Errorf("missing Environment.InstanceName")
+	}
+
+	usingOAuth := config.Auth.ClientID != "" && config.Auth.ClientSecret != ""
+	usingBasicAuth := config.Auth.Username != "" && config.Auth.Password != ""
+	if !usingOAuth && !usingBasicAuth {
+		return fmt.Errorf("missing Auth.ClientID and Auth.ClientSecret or Auth.Username and Auth.Password")
+	}
+
+	return nil
+}
@@ -86,7 +86,7 @@ func (c *Client) GetAccounts() (*ResponseAccountsList, error) {
 	var accountsList ResponseAccountsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &accountsList)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "accounts", err)
+		return nil, apiErrorf(errMsgFailedGet, "accounts", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -103,7 +103,7 @@ func (c *Client) GetAccountByID(id int) (*ResourceAccount, error) {
 	var account ResourceAccount
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &account)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "account", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "account", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -120,7 +120,7 @@ func (c *Client) GetAccountByName(name string) (*ResourceAccount, error) {
 	var account ResourceAccount
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &account)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "account", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "account", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -155,7 +155,7 @@ func (c *Client) CreateAccount(account *ResourceAccount) (*ResponseAccountCreate
 	var returnedAccount ResponseAccountCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, &returnedAccount)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "account", err)
+		return nil, apiErrorf(errMsgFailedCreate, "account", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -186,7 +186,7 @@ func (c *Client) UpdateAccountByID(id int, account *ResourceAccount) (*ResponseA
 	var updatedAccount ResponseAccountCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, requestBody, &updatedAccount)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "account", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "account", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -217,7 +217,7 @@ func (c *Client) UpdateAccountByName(name string, account *ResourceAccount) (*Re
 	var updatedAccount ResponseAccountCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, requestBody, &updatedAccount)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "account", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "account", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -233,7 +233,7 @@ func (c *Client) DeleteAccountByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "account", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "account", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -249,7 +249,7 @@ func (c *Client) DeleteAccountByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "account", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "account", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -55,7 +55,7 @@ func (c *Client) GetAccountGroupByID(id int) (*ResourceAccountGroup, error) {
 	var group ResourceAccountGroup
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &group)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "account group", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "account group", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -72,7 +72,7 @@ func (c *Client) GetAccountGroupByName(name string) (*ResourceAccountGroup, erro
 	var account ResourceAccountGroup
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &account)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "account group", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "account group", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -99,7 +99,7 @@ func (c *Client) CreateAccountGroup(accountGroup *ResourceAccountGroup) (*Respon
 	var returnedAccountGroup ResponseAccountGroupCreated
 	resp, err := c.HTTP.DoRequest("POST", endpoint, requestBody, &returnedAccountGroup)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "account group", err)
+		return nil, apiErrorf(errMsgFailedCreate, "account group", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -130,7 +130,7 @@ func (c *Client) UpdateAccountGroupByID(id int, accountGroup *ResourceAccountGro
 	var updatedGroup ResourceAccountGroup
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, requestBody, &updatedGroup)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "account group", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "account group", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -161,7 +161,7 @@ func (c *Client) UpdateAccountGroupByName(name string, accountGroup *ResourceAcc
 	var updatedGroup ResourceAccountGroup
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, requestBody, &updatedGroup)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "account group", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "account group", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -177,7 +177,7 @@ func (c *Client) DeleteAccountGroupByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "account group", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "account group", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -193,7 +193,7 @@ func (c *Client) DeleteAccountGroupByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "account group", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "account group", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -7,7 +7,6 @@ package jamfpro
 
 import (
 	"encoding/xml"
-	"fmt"
 )
 
 const uriAPIActivationCode = "/JSSResource/activationcode"
@@ -29,7 +28,7 @@ func (c *Client) GetActivationCode() (*ResourceActivationCode, error) {
 	var activationCode ResourceActivationCode
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &activationCode)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "activation code", err)
+		return nil, apiErrorf(errMsgFailedGet, "activation code", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -55,7 +54,7 @@ func (c *Client) UpdateActivationCode(organizationName, code string) error {
 
 	_, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedUpdate, "activation code", err)
+		return apiErrorf(errMsgFailedUpdate, "activation code", err)
 	}
 
 	return nil
@@ -8,6 +8,7 @@ Shared Resources in this Endpoint :
 - SharedResourceSite
 - SharedContainerCriteria
 - SharedAdvancedSearchSubsetDisplayField
+- ResponseAdvancedSearchResults
 */
 
 package jamfpro
@@ -79,7 +80,7 @@ func (c *Client) GetAdvancedComputerSearches() (*ResponseAdvancedComputerSearche
 	var searchesList ResponseAdvancedComputerSearchesList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &searchesList)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "advance computer searches", err)
+		return nil, apiErrorf(errMsgFailedGet, "advance computer searches", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -96,7 +97,7 @@ func (c *Client) GetAdvancedComputerSearchByID(id int) (*ResourceAdvancedCompute
 	var search ResourceAdvancedComputerSearch
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &search)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "advance computer search", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "advance computer search", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -113,7 +114,7 @@ func (c *Client) GetAdvancedComputerSearchByName(name string) (*ResourceAdvanced
 	var search ResourceAdvancedComputerSearch
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &search)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "advance computer search", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "advance computer search", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -123,6 +124,31 @@ func (c *Client) GetAdvancedComputerSearchByName(name string) (*ResourceAdvanced
 	return &search, nil
 }
 
+// GetAdvancedComputerSearchResultsByID retrieves the result rows of an advanced computer search by its ID,
+// with each row's columns keyed by display field name.
+func (c *Client) GetAdvancedComputerSearchResultsByID(id int) (*ResponseAdvancedSearchResults, error) {
+	endpoint := fmt.Sprintf("%s/id/%d", uriAPIAdvancedComputerSearches, id)
+
+	results, err := c.getAdvancedSearchResults(endpoint)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "advanced computer search results", id, err)
+	}
+
+	return results, nil
+}
+
+// GetAdvancedComputerSearchResultsByName retrieves the result rows of an advanced computer search by its name.
+func (c *Client) GetAdvancedComputerSearchResultsByName(name string) (*ResponseAdvancedSearchResults, error) {
+	endpoint := fmt.Sprintf("%s/name/%s", uriAPIAdvancedComputerSearches, name)
+
+	results, err := c.getAdvancedSearchResults(endpoint)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByName, "advanced computer search results", name, err)
+	}
+
+	return results, nil
+}
+
 // CreateAdvancedComputerSearch creates a new advanced computer search.
 func (c *Client) CreateAdvancedComputerSearch(search *ResourceAdvancedComputerSearch) (*ResponseAdvancedComputerSearchCreatedAndUpdated, error) {
 	endpoint := uriAPIAdvancedComputerSearches
@@ -137,7 +163,7 @@ func (c *Client) CreateAdvancedComputerSearch(search *ResourceAdvancedComputerSe
 	var createdSearch ResponseAdvancedComputerSearchCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdSearch)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "advance computer search", err)
+		return nil, apiErrorf(errMsgFailedCreate, "advance computer search", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -161,7 +187,7 @@ func (c *Client) UpdateAdvancedComputerSearchByID(id int, search *ResourceAdvanc
 	var updatedSearch ResponseAdvancedComputerSearchCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedSearch)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "advance computer search", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "advance computer search", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -185,7 +211,7 @@ func (c *Client) UpdateAdvancedComputerSearchByName(name string, search *Resourc
 	var updatedSearch ResponseAdvancedComputerSearchCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedSearch)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "advance computer search", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "advance computer search", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -201,7 +227,7 @@ func (c *Client) DeleteAdvancedComputerSearchByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "advance computer search", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "advance computer search", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -217,7 +243,7 @@ func (c *Client) DeleteAdvancedComputerSearchByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "advance computer search", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "advance computer search", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -8,6 +8,7 @@ Shared Resources in this Endpoint:
 - SharedResourceSite
 - SharedContainerCriteria
 - SharedAdvancedSearchSubsetDisplayField
+- ResponseAdvancedSearchResults
 */
 
 package jamfpro
@@ -78,7 +79,7 @@ func (c *Client) GetAdvancedMobileDeviceSearches() (*ResponseAdvancedMobileDevic
 	var searchesList ResponseAdvancedMobileDeviceSearchesList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &searchesList)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "advanced mobile device searches", err)
+		return nil, apiErrorf(errMsgFailedGet, "advanced mobile device searches", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -95,7 +96,7 @@ func (c *Client) GetAdvancedMobileDeviceSearchByID(id int) (*ResourceAdvancedMob
 	var searchDetail ResourceAdvancedMobileDeviceSearch
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &searchDetail)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "advanced mobile device search", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "advanced mobile device search", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -112,7 +113,7 @@ func (c *Client) GetAdvancedMobileDeviceSearchByName(name string) (*ResourceAdva
 	var searchDetail ResourceAdvancedMobileDeviceSearch
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &searchDetail)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "advanced mobile device search", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "advanced mobile device search", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -122,6 +123,31 @@ func (c *Client) GetAdvancedMobileDeviceSearchByName(name string) (*ResourceAdva
 	return &searchDetail, nil
 }
 
+// GetAdvancedMobileDeviceSearchResultsByID retrieves the result rows of an advanced mobile device search by its ID,
+// with each row's columns keyed by display field name.
+func (c *Client) GetAdvancedMobileDeviceSearchResultsByID(id int) (*ResponseAdvancedSearchResults, error) {
+	endpoint := fmt.Sprintf("%s/id/%d", uriAPIAdvancedMobileDeviceSearches, id)
+
+	results, err := c.getAdvancedSearchResults(endpoint)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "advanced mobile device search results", id, err)
+	}
+
+	return results, nil
+}
+
+// GetAdvancedMobileDeviceSearchResultsByName retrieves the result rows of an advanced mobile device search by its name.
+func (c *Client) GetAdvancedMobileDeviceSearchResultsByName(name string) (*ResponseAdvancedSearchResults, error) {
+	endpoint := fmt.Sprintf("%s/name/%s", uriAPIAdvancedMobileDeviceSearches, name)
+
+	results, err := c.getAdvancedSearchResults(endpoint)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByName, "advanced mobile device search results", name, err)
+	}
+
+	return results, nil
+}
+
 // CreateAdvancedMobileDeviceSearch creates a new advanced mobile device search with the given ID.
 func (c *Client) CreateAdvancedMobileDeviceSearch(search *ResourceAdvancedMobileDeviceSearch) (*ResponseAdvancedMobileDeviceSearchCreatedAndUpdated, error) {
 	endpoint := uriAPIAdvancedMobileDeviceSearches
@@ -136,7 +162,7 @@ func (c *Client) CreateAdvancedMobileDeviceSearch(search *ResourceAdvancedMobile
 	var createdSearch ResponseAdvancedMobileDeviceSearchCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdSearch)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "advanced mobile device search", err)
+		return nil, apiErrorf(errMsgFailedCreate, "advanced mobile device search", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -160,7 +186,7 @@ func (c *Client) UpdateAdvancedMobileDeviceSearchByID(id int, search *ResourceAd
 	var updatedSearch ResponseAdvancedMobileDeviceSearchCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedSearch)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "advanced mobile device search", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "advanced mobile device search", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -184,7 +210,7 @@ func (c *Client) UpdateAdvancedMobileDeviceSearchByName(name string, search *Res
 	var updatedSearch ResponseAdvancedMobileDeviceSearchCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedSearch)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "advanced mobile device search", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "advanced mobile device search", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -200,7 +226,7 @@ func (c *Client) DeleteAdvancedMobileDeviceSearchByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "advanced mobile device search", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "advanced mobile device search", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -216,7 +242,7 @@ func (c *Client) DeleteAdvancedMobileDeviceSearchByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "advanced mobile device search", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "advanced mobile device search", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -8,6 +8,7 @@ Shared Resources in this Endpoint
 - SharedResourceSite
 - SharedContainerCriteria
 - SharedAdvancedSearchSubsetDisplayField
+- ResponseAdvancedSearchResults
 */
 
 package jamfpro
@@ -73,7 +74,7 @@ func (c *Client) GetAdvancedUserSearches() (*ResponseAdvancedUserSearchesList, e
 	var advancedUserSearchesList ResponseAdvancedUserSearchesList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &advancedUserSearchesList)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "advanced user searches", err)
+		return nil, apiErrorf(errMsgFailedGet, "advanced user searches", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -90,7 +91,7 @@ func (c *Client) GetAdvancedUserSearchByID(id int) (*ResourceAdvancedUserSearch,
 	var searchDetail ResourceAdvancedUserSearch
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &searchDetail)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "advanced user search", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "advanced user search", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -107,7 +108,7 @@ func (c *Client) GetAdvancedUserSearchByName(name string) (*ResourceAdvancedUser
 	var searchDetail ResourceAdvancedUserSearch
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &searchDetail)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "advanced user search", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "advanced user search", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -117,6 +118,31 @@ func (c *Client) GetAdvancedUserSearchByName(name string) (*ResourceAdvancedUser
 	return &searchDetail, nil
 }
 
+// GetAdvancedUserSearchResultsByID retrieves the result rows of an advanced user search by its ID,
+// with each row's columns keyed by display field name.
+func (c *Client) GetAdvancedUserSearchResultsByID(id int) (*ResponseAdvancedSearchResults, error) {
+	endpoint := fmt.Sprintf("%s/id/%d", uriAPIAdvancedUserSearches, id)
+
+	results, err := c.getAdvancedSearchResults(endpoint)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "advanced user search results", id, err)
+	}
+
+	return results, nil
+}
+
+// GetAdvancedUserSearchResultsByName retrieves the result rows of an advanced user search by its name.
+func (c *Client) GetAdvancedUserSearchResultsByName(name string) (*ResponseAdvancedSearchResults, error) {
+	endpoint := fmt.Sprintf("%s/name/%s", uriAPIAdvancedUserSearches, name)
+
+	results, err := c.getAdvancedSearchResults(endpoint)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByName, "advanced user search results", name, err)
+	}
+
+	return results, nil
+}
+
 // CreateAdvancedUserSearch creates a new advanced user search.
 func (c *Client) CreateAdvancedUserSearch(search *ResourceAdvancedUserSearch) (*ResponseAdvancedUserSearchCreatedAndUpdated, error) {
 	endpoint := uriAPIAdvancedUserSearches
@@ -132,7 +158,7 @@ func (c *Client) CreateAdvancedUserSearch(search *ResourceAdvancedUserSearch) (*
 	var createdSearch ResponseAdvancedUserSearchCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdSearch)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "advanced user search", err)
+		return nil, apiErrorf(errMsgFailedCreate, "advanced user search", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -156,7 +182,7 @@ func (c *Client) UpdateAdvancedUserSearchByID(id int, search *ResourceAdvancedUs
 	var updatedSearch ResponseAdvancedUserSearchCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedSearch)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "advanced user search", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "advanced user search", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -180,7 +206,7 @@ func (c *Client) UpdateAdvancedUserSearchByName(name string, search *ResourceAdv
 	var updatedSearch ResponseAdvancedUserSearchCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedSearch)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "advanced user search", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "advanced user search", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -195,7 +221,7 @@ func (c *Client) DeleteAdvancedUserSearchByID(id int) error {
 	endpoint := fmt.Sprintf("%s/id/%d", uriAPIAdvancedUserSearches, id)
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "advanced user search", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "advanced user search", id, err)
 	}
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
@@ -208,7 +234,7 @@ func (c *Client) DeleteAdvancedUserSearchByName(name string) error {
 	endpoint := fmt.Sprintf("%s/name/%s", uriAPIAdvancedUserSearches, name)
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "advanced user search", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "advanced user search", name, err)
 	}
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
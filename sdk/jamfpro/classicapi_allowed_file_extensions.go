@@ -36,7 +36,7 @@ func (c *Client) GetAllowedFileExtensions() (*ResponseAllowedFileExtensionsList,
 	var allowedExtensionsList ResponseAllowedFileExtensionsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &allowedExtensionsList)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "allowed file extension", err)
+		return nil, apiErrorf(errMsgFailedGet, "allowed file extension", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -53,7 +53,7 @@ func (c *Client) GetAllowedFileExtensionByID(id int) (*ResourceAllowedFileExtens
 	var extension ResourceAllowedFileExtension
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &extension)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "allowed file extension", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "allowed file extension", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -70,7 +70,7 @@ func (c *Client) GetAllowedFileExtensionByName(name string) (*ResourceAllowedFil
 	var extension ResourceAllowedFileExtension
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &extension)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "allowed file extension", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "allowed file extension", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -95,7 +95,7 @@ func (c *Client) CreateAllowedFileExtension(extension *ResourceAllowedFileExtens
 	var responseExtension ResourceAllowedFileExtension
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseExtension)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "allowed file extension", err)
+		return nil, apiErrorf(errMsgFailedCreate, "allowed file extension", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -111,7 +111,7 @@ func (c *Client) DeleteAllowedFileExtensionByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "allowed file extension", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "allowed file extension", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -125,7 +125,7 @@ func (c *Client) DeleteAllowedFileExtensionByID(id int) error {
 func (c *Client) DeleteAllowedFileExtensionByName(name string) error {
 	extensionDetail, err := c.GetAllowedFileExtensionByName(name)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "allowed file extension", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "allowed file extension", name, err)
 	}
 
 	return c.DeleteAllowedFileExtensionByID(extensionDetail.ID)
@@ -137,7 +137,7 @@ func (c *Client) GetClasses() (*ResponseClassesList, error) {
 	var classes ResponseClassesList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &classes)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "classes", err)
+		return nil, apiErrorf(errMsgFailedGet, "classes", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -154,7 +154,7 @@ func (c *Client) GetClassByID(id int) (*ResourceClass, error) {
 	var class ResourceClass
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &class)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "class", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "class", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -171,7 +171,7 @@ func (c *Client) GetClassByName(name string) (*ResourceClass, error) {
 	var class ResourceClass
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &class)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "class", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "class", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -195,7 +195,7 @@ func (c *Client) CreateClass(class *ResourceClass) (*ResourceClass, error) {
 	var createdClass ResourceClass
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdClass)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "class", err)
+		return nil, apiErrorf(errMsgFailedCreate, "class", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -218,7 +218,7 @@ func (c *Client) UpdateClassByID(id int, class *ResourceClass) error {
 
 	_, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedUpdateByID, "class", id, err)
+		return apiErrorf(errMsgFailedUpdateByID, "class", id, err)
 	}
 
 	return nil
@@ -237,7 +237,7 @@ func (c *Client) UpdateClassByName(name string, class *ResourceClass) error {
 
 	_, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedUpdateByName, "class", name, err)
+		return apiErrorf(errMsgFailedUpdateByName, "class", name, err)
 	}
 
 	return nil
@@ -249,7 +249,7 @@ func (c *Client) DeleteClassByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "class", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "class", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -265,7 +265,7 @@ func (c *Client) DeleteClassByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "class", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "class", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -7,7 +7,6 @@ package jamfpro
 
 import (
 	"encoding/xml"
-	"fmt"
 )
 
 const uriComputerCheckin = "/JSSResource/computercheckin"
@@ -41,7 +40,7 @@ func (c *Client) GetComputerCheckinInformation() (*ResourceComputerCheckin, erro
 	var checkinSettings ResourceComputerCheckin
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &checkinSettings)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "computer checkin information", err)
+		return nil, apiErrorf(errMsgFailedGet, "computer checkin information", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -66,7 +65,7 @@ func (c *Client) UpdateComputerCheckinInformation(settings *ResourceComputerChec
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &handleResponse)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedUpdate, "computer checkin information", err)
+		return apiErrorf(errMsgFailedUpdate, "computer checkin information", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -42,6 +42,16 @@ type ResourceComputerGroup struct {
 	Computers []ComputerGroupSubsetComputer `xml:"computers>computer"`
 }
 
+// Static group membership
+
+// requestComputerGroupMembership is the minimal payload used to add or remove
+// computers from a static group without resending the full member list.
+type requestComputerGroupMembership struct {
+	XMLName           xml.Name                      `xml:"computer_group"`
+	ComputerAdditions []ComputerGroupSubsetComputer `xml:"computer_additions>computer,omitempty"`
+	ComputerDeletions []ComputerGroupSubsetComputer `xml:"computer_deletions>computer,omitempty"`
+}
+
 // Subsets & Containers
 
 type ComputerGroupSubsetComputer struct {
@@ -61,7 +71,7 @@ func (c *Client) GetComputerGroups() (*ResponseComputerGroupsList, error) {
 	var computerGroups ResponseComputerGroupsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computerGroups)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "computer groups", err)
+		return nil, apiErrorf(errMsgFailedGet, "computer groups", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -78,7 +88,7 @@ func (c *Client) GetComputerGroupByID(id int) (*ResourceComputerGroup, error) {
 	var group ResourceComputerGroup
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &group)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "computer group", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "computer group", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -95,7 +105,7 @@ func (c *Client) GetComputerGroupByName(name string) (*ResourceComputerGroup, er
 	var group ResourceComputerGroup
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &group)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "computer group", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "computer group", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -119,7 +129,7 @@ func (c *Client) CreateComputerGroup(group *ResourceComputerGroup) (*ResourceCom
 	var createdGroup ResourceComputerGroup
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdGroup)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "computer group", err)
+		return nil, apiErrorf(errMsgFailedCreate, "computer group", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -148,7 +158,7 @@ func (c *Client) UpdateComputerGroupByID(id int, group *ResourceComputerGroup) (
 	var updatedGroup ResourceComputerGroup
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedGroup)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "computer group", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "computer group", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -177,7 +187,7 @@ func (c *Client) UpdateComputerGroupByName(name string, group *ResourceComputerG
 	var updatedGroup ResourceComputerGroup
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedGroup)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "computer group", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "computer group", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -193,7 +203,7 @@ func (c *Client) DeleteComputerGroupByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "computer group", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "computer group", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -209,7 +219,7 @@ func (c *Client) DeleteComputerGroupByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "computer group", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "computer group", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -218,3 +228,72 @@ func (c *Client) DeleteComputerGroupByName(name string) error {
 
 	return nil
 }
+
+// AddComputersToStaticGroup adds computers to a static computer group by computer ID.
+// Members are sent as computer_additions in batches, so existing members are left untouched.
+func (c *Client) AddComputersToStaticGroup(groupID int, computerIDs []int) error {
+	members := make([]ComputerGroupSubsetComputer, 0, len(computerIDs))
+	for _, id := range computerIDs {
+		members = append(members, ComputerGroupSubsetComputer{ID: id})
+	}
+
+	return c.updateStaticComputerGroupMembership(groupID, members, false)
+}
+
+// AddComputersToStaticGroupBySerialNumber adds computers to a static computer group by serial number.
+func (c *Client) AddComputersToStaticGroupBySerialNumber(groupID int, serialNumbers []string) error {
+	members := make([]ComputerGroupSubsetComputer, 0, len(serialNumbers))
+	for _, serialNumber := range serialNumbers {
+		members = append(members, ComputerGroupSubsetComputer{SerialNumber: serialNumber})
+	}
+
+	return c.updateStaticComputerGroupMembership(groupID, members, false)
+}
+
+// RemoveComputersFromStaticGroup removes computers from a static computer group by computer ID.
+func (c *Client) RemoveComputersFromStaticGroup(groupID int, computerIDs []int) error {
+	members := make([]ComputerGroupSubsetComputer, 0, len(computerIDs))
+	for _, id := range computerIDs {
+		members = append(members, ComputerGroupSubsetComputer{ID: id})
+	}
+
+	return c.updateStaticComputerGroupMembership(groupID, members, true)
+}
+
+// RemoveComputersFromStaticGroupBySerialNumber removes computers from a static computer group by serial number.
+func (c *Client) RemoveComputersFromStaticGroupBySerialNumber(groupID int, serialNumbers []string) error {
+	members := make([]ComputerGroupSubsetComputer, 0, len(serialNumbers))
+	for _, serialNumber := range serialNumbers {
+		members = append(members, ComputerGroupSubsetComputer{SerialNumber: serialNumber})
+	}
+
+	return c.updateStaticComputerGroupMembership(groupID, members, true)
+}
+
+// updateStaticComputerGroupMembership sends computer additions or deletions for a static group
+// in batches of staticGroupMembershipBatchSize.
+func (c *Client) updateStaticComputerGroupMembership(groupID int, members []ComputerGroupSubsetComputer, remove bool) error {
+	endpoint := fmt.Sprintf("%s/id/%d", uriComputerGroups, groupID)
+
+	for start := 0; start < len(members); start += staticGroupMembershipBatchSize {
+		end := min(start+staticGroupMembershipBatchSize, len(members))
+
+		var requestBody requestComputerGroupMembership
+		if remove {
+			requestBody.ComputerDeletions = members[start:end]
+		} else {
+			requestBody.ComputerAdditions = members[start:end]
+		}
+
+		var updatedGroup ResourceComputerGroup
+		resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedGroup)
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			return apiErrorf(errMsgFailedUpdateByID, "computer group membership", groupID, fmt.Errorf("members %d-%d of %d: %w", start+1, end, len(members), err))
+		}
+	}
+
+	return nil
+}
@@ -7,7 +7,6 @@ package jamfpro
 
 import (
 	"encoding/xml"
-	"fmt"
 )
 
 const uriComputerInventoryCollection = "/JSSResource/computerinventorycollection"
@@ -70,7 +69,7 @@ func (c *Client) GetComputerInventoryCollectionInformation() (*ResourceComputerI
 	var inventoryCollection ResourceComputerInventoryCollection
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &inventoryCollection)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "computer inventory collection settings", err)
+		return nil, apiErrorf(errMsgFailedGet, "computer inventory collection settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -95,7 +94,7 @@ func (c *Client) UpdateComputerInventoryCollectionInformation(settings *Resource
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &handleResponse)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedUpdate, "computer inventory collection settings", err)
+		return apiErrorf(errMsgFailedUpdate, "computer inventory collection settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
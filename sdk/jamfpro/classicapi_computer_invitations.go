@@ -72,7 +72,7 @@ func (c *Client) GetComputerInvitations() (*ResponseComputerInvitationsList, err
 	var invitations ResponseComputerInvitationsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &invitations)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "computer invitations", err)
+		return nil, apiErrorf(errMsgFailedGet, "computer invitations", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -90,7 +90,7 @@ func (c *Client) GetComputerInvitationByID(id int) (*ResourceComputerInvitation,
 	var invitation ResourceComputerInvitation
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &invitation)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "computer invitation", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "computer invitation", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -107,7 +107,7 @@ func (c *Client) GetComputerInvitationByInvitationID(id int) (*ResourceComputerI
 	var invitation ResourceComputerInvitation
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &invitation)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "computer invitation", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "computer invitation", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -131,7 +131,7 @@ func (c *Client) CreateComputerInvitation(invitation *ResourceComputerInvitation
 	var createdInvitation ResourceComputerInvitation
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdInvitation)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "computer invitation", err)
+		return nil, apiErrorf(errMsgFailedCreate, "computer invitation", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -147,7 +147,7 @@ func (c *Client) DeleteComputerInvitationByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "computer invitation", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "computer invitation", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -392,7 +392,7 @@ func (c *Client) GetComputers() (*ResponseComputersList, error) {
 	var computersList ResponseComputersList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computersList)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "computers", err)
+		return nil, apiErrorf(errMsgFailedGet, "computers", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -409,7 +409,7 @@ func (c *Client) GetComputerByID(id int) (*ResponseComputer, error) {
 	var computer ResponseComputer
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "computer", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "computer", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -426,7 +426,7 @@ func (c *Client) GetComputerByName(name string) (*ResponseComputer, error) {
 	var computer ResponseComputer
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "computer", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "computer", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -450,7 +450,7 @@ func (c *Client) CreateComputer(computer ResponseComputer) (*ResponseComputer, e
 	var response ResponseComputer
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "computer", err)
+		return nil, apiErrorf(errMsgFailedCreate, "computer", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -481,7 +481,7 @@ func (c *Client) UpdateComputerByID(id int, computer ResponseComputer) (*Respons
 	var response ResponseComputer
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "computer", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "computer", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -512,7 +512,7 @@ func (c *Client) UpdateComputerByName(name string, computer ResponseComputer) (*
 	var response ResponseComputer
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "computer", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "computer", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -528,7 +528,7 @@ func (c *Client) DeleteComputerByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "computer", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "computer", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -544,7 +544,7 @@ func (c *Client) DeleteComputerByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "computer", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "computer", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -49,7 +49,7 @@ func (c *Client) GetDirectoryBindings() (*ResponseDirectoryBindingsList, error)
 	var bindings ResponseDirectoryBindingsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &bindings)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "directory bindings", err)
+		return nil, apiErrorf(errMsgFailedGet, "directory bindings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -66,7 +66,7 @@ func (c *Client) GetDirectoryBindingByID(id int) (*ResponseDirectoryBinding, err
 	var binding ResponseDirectoryBinding
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &binding)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "directory binding", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "directory binding", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -83,7 +83,7 @@ func (c *Client) GetDirectoryBindingByName(name string) (*ResponseDirectoryBindi
 	var binding ResponseDirectoryBinding
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &binding)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "directory binding", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "directory binding", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -107,7 +107,7 @@ func (c *Client) CreateDirectoryBinding(binding *ResponseDirectoryBinding) (*Res
 	var createdBinding ResponseDirectoryBinding
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdBinding)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "directory binding", err)
+		return nil, apiErrorf(errMsgFailedCreate, "directory binding", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -131,7 +131,7 @@ func (c *Client) UpdateDirectoryBindingByID(id int, binding *ResponseDirectoryBi
 	var updatedBinding ResponseDirectoryBinding
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedBinding)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "directory binding", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "directory binding", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -155,7 +155,7 @@ func (c *Client) UpdateDirectoryBindingByName(name string, binding *ResponseDire
 	var updatedBinding ResponseDirectoryBinding
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedBinding)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "directory binding", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "directory binding", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -171,7 +171,7 @@ func (c *Client) DeleteDirectoryBindingByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "directory binding", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "directory binding", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -187,7 +187,7 @@ func (c *Client) DeleteDirectoryBindingByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "directory binding", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "directory binding", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
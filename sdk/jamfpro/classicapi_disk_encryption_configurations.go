@@ -59,7 +59,7 @@ func (c *Client) GetDiskEncryptionConfigurations() (*ResponseDiskEncryptionConfi
 	var configurations ResponseDiskEncryptionConfigurationsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &configurations)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "disk encryption configurations", err)
+		return nil, apiErrorf(errMsgFailedGet, "disk encryption configurations", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -76,7 +76,7 @@ func (c *Client) GetDiskEncryptionConfigurationByID(id int) (*ResourceDiskEncryp
 	var configuration ResourceDiskEncryptionConfiguration
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &configuration)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "disk encryption configuration", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "disk encryption configuration", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -93,7 +93,7 @@ func (c *Client) GetDiskEncryptionConfigurationByName(name string) (*ResourceDis
 	var configuration ResourceDiskEncryptionConfiguration
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &configuration)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "disk encryption configuration", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "disk encryption configuration", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -117,7 +117,7 @@ func (c *Client) CreateDiskEncryptionConfiguration(config *ResourceDiskEncryptio
 	var createdConfig ResponseDiskEncryptionConfigurationCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdConfig)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "disk encryption configuration", err)
+		return nil, apiErrorf(errMsgFailedCreate, "disk encryption configuration", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -141,7 +141,7 @@ func (c *Client) UpdateDiskEncryptionConfigurationByID(id int, config *ResourceD
 	var updatedConfig ResponseDiskEncryptionConfigurationCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedConfig)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "disk encryption configuration", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "disk encryption configuration", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -165,7 +165,7 @@ func (c *Client) UpdateDiskEncryptionConfigurationByName(name string, config *Re
 	var updatedConfig ResourceDiskEncryptionConfiguration
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedConfig)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "disk encryption configuration", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "disk encryption configuration", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -181,7 +181,7 @@ func (c *Client) DeleteDiskEncryptionConfigurationByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "disk encryption configuration", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "disk encryption configuration", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -197,7 +197,7 @@ func (c *Client) DeleteDiskEncryptionConfigurationByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "disk encryption configuration", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "disk encryption configuration", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
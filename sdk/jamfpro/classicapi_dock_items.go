@@ -46,7 +46,7 @@ func (c *Client) GetDockItems() (*ResponseDockItemsList, error) {
 	var dockItems ResponseDockItemsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &dockItems)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "dock items", err)
+		return nil, apiErrorf(errMsgFailedGet, "dock items", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -63,7 +63,7 @@ func (c *Client) GetDockItemByID(id int) (*ResourceDockItem, error) {
 	var dockItem ResourceDockItem
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &dockItem)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "dock item", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "dock item", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -80,7 +80,7 @@ func (c *Client) GetDockItemByName(name string) (*ResourceDockItem, error) {
 	var dockItem ResourceDockItem
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &dockItem)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "dock item", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "dock item", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -104,7 +104,7 @@ func (c *Client) CreateDockItem(dockItem *ResourceDockItem) (*ResourceDockItem,
 	var createdDockItem ResourceDockItem
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdDockItem)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "dock item", err)
+		return nil, apiErrorf(errMsgFailedCreate, "dock item", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -128,7 +128,7 @@ func (c *Client) UpdateDockItemByID(id int, dockItem *ResourceDockItem) (*Resour
 	var updatedDockItem ResourceDockItem
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedDockItem)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "dock item", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "dock item", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -152,7 +152,7 @@ func (c *Client) UpdateDockItemByName(name string, dockItem *ResourceDockItem) (
 	var updatedDockItem ResourceDockItem
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedDockItem)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "dock item", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "dock item", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -168,7 +168,7 @@ func (c *Client) DeleteDockItemByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "dock item", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "dock item", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -184,7 +184,7 @@ func (c *Client) DeleteDockItemByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "dock item", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "dock item", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -194,7 +194,7 @@ func (c *Client) GetEbooks() (*ResponseEbooksList, error) {
 	var ebooks ResponseEbooksList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ebooks)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "ebooks", err)
+		return nil, apiErrorf(errMsgFailedGet, "ebooks", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -211,7 +211,7 @@ func (c *Client) GetEbookByID(id int) (*ResourceEbooks, error) {
 	var ebook ResourceEbooks
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ebook)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "ebook", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "ebook", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -228,7 +228,7 @@ func (c *Client) GetEbookByName(name string) (*ResourceEbooks, error) {
 	var ebook ResourceEbooks
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ebook)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "ebook", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "ebook", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -245,7 +245,7 @@ func (c *Client) GetEbookByNameAndDataSubset(name, subset string) (*ResourceEboo
 	var ebook ResourceEbooks
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ebook)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "ebook", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "ebook", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -269,7 +269,7 @@ func (c *Client) CreateEbook(ebook ResourceEbooks) (*ResourceEbooks, error) {
 	var response ResourceEbooks
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "ebook", err)
+		return nil, apiErrorf(errMsgFailedCreate, "ebook", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -293,7 +293,7 @@ func (c *Client) UpdateEbookByID(id int, ebook ResourceEbooks) (*ResourceEbooks,
 	var updatedEbook ResourceEbooks
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedEbook)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "ebook", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "ebook", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -317,7 +317,7 @@ func (c *Client) UpdateEbookByName(name string, ebook ResourceEbooks) (*Resource
 	var updatedEbook ResourceEbooks
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedEbook)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "ebook", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "ebook", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -333,7 +333,7 @@ func (c *Client) DeleteEbookByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "ebook", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "ebook", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -349,7 +349,7 @@ func (c *Client) DeleteEbookByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "ebook", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "ebook", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
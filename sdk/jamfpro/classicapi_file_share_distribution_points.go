@@ -17,8 +17,8 @@ const uriDistributionPoints = "/JSSResource/distributionpoints"
 
 // Struct to capture the XML response for distribution points list
 type ResponseDistributionPointsList struct {
-	Size              int                       `xml:"size"`
-	DistributionPoint DistributionPointListItem `xml:"distribution_point"`
+	Size              int                         `xml:"size"`
+	DistributionPoint []DistributionPointListItem `xml:"distribution_point"`
 }
 
 type DistributionPointListItem struct {
@@ -75,7 +75,7 @@ func (c *Client) GetDistributionPoints() (*ResponseDistributionPointsList, error
 	var distributionPoints ResponseDistributionPointsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &distributionPoints)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "distribution points", err)
+		return nil, apiErrorf(errMsgFailedGet, "distribution points", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -92,7 +92,7 @@ func (c *Client) GetDistributionPointByID(id int) (*ResourceFileShareDistributio
 	var distributionPoint ResourceFileShareDistributionPoint
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &distributionPoint)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "distribution point", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "distribution point", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -109,7 +109,7 @@ func (c *Client) GetDistributionPointByName(name string) (*ResourceFileShareDist
 	var distributionPoint ResourceFileShareDistributionPoint
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &distributionPoint)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "distribution point", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "distribution point", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -134,7 +134,7 @@ func (c *Client) CreateDistributionPoint(dp *ResourceFileShareDistributionPoint)
 	var createdDistributionPoint ResponseFileShareDistributionPointCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdDistributionPoint)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "distribution point", err)
+		return nil, apiErrorf(errMsgFailedCreate, "distribution point", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -158,7 +158,7 @@ func (c *Client) UpdateDistributionPointByID(id int, dp *ResourceFileShareDistri
 	var updatedDistributionPoint ResponseFileShareDistributionPointCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedDistributionPoint)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "distribution point", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "distribution point", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -182,7 +182,7 @@ func (c *Client) UpdateDistributionPointByName(name string, dp *ResourceFileShar
 	var updatedDistributionPoint ResponseFileShareDistributionPointCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedDistributionPoint)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "distribution point", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "distribution point", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -198,7 +198,7 @@ func (c *Client) DeleteDistributionPointByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "distribution point", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "distribution point", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -214,7 +214,7 @@ func (c *Client) DeleteDistributionPointByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "distribution point", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "distribution point", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
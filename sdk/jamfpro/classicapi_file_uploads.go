@@ -25,13 +25,16 @@ func (c *Client) CreateFileAttachments(resource, idType, id string, files map[st
 		endpoint += "?FORCE_IPA_UPLOAD=false"
 	}
 
-	resp, err := c.HTTP.DoMultipartRequest("POST", endpoint, nil, files, nil)
+	// Attachments such as .ipa files can be large, so they are streamed from disk with progress reporting.
+	parts, closeFiles, err := openRawMultipartFiles(files)
 	if err != nil {
 		return nil, fmt.Errorf(errMsgFailedCreate, "attachment", err)
 	}
+	defer closeFiles()
 
-	if resp != nil && resp.Body != nil {
-		defer resp.Body.Close()
+	resp, err := c.doRawMultipartUpload("POST", endpoint, parts, nil)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "attachment", err)
 	}
 
 	return resp, nil
@@ -7,7 +7,6 @@ package jamfpro
 
 import (
 	"encoding/xml"
-	"fmt"
 )
 
 const uriGSXConnection = "/JSSResource/gsxconnection"
@@ -31,7 +30,7 @@ func (c *Client) GetGSXConnectionInformation() (*ResourceGSXConnection, error) {
 	var gsxConnectionSettings ResourceGSXConnection
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &gsxConnectionSettings)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "gsx connection information", err)
+		return nil, apiErrorf(errMsgFailedGet, "gsx connection information", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -56,7 +55,7 @@ func (c *Client) UpdateGSXConnectionInformation(settings *ResourceGSXConnection)
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &handleResponse)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedUpdate, "gsx connection information", err)
+		return apiErrorf(errMsgFailedUpdate, "gsx connection information", err)
 	}
 
 	if resp != nil && resp.Body != nil {
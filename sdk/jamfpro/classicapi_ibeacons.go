@@ -40,7 +40,7 @@ func (c *Client) GetIBeacons() (*ResponseIBeaconsList, error) {
 	var iBeacons ResponseIBeaconsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &iBeacons)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "ibeacons", err)
+		return nil, apiErrorf(errMsgFailedGet, "ibeacons", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -57,7 +57,7 @@ func (c *Client) GetIBeaconByID(id int) (*ResourceIBeacons, error) {
 	var beacon ResourceIBeacons
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &beacon)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "ibeacon", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "ibeacon", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -74,7 +74,7 @@ func (c *Client) GetIBeaconByName(name string) (*ResourceIBeacons, error) {
 	var beacon ResourceIBeacons
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &beacon)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "ibeacon", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "ibeacon", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -98,7 +98,7 @@ func (c *Client) CreateIBeacon(beacon *ResourceIBeacons) (*ResourceIBeacons, err
 	var response ResourceIBeacons
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "ibeacon", err)
+		return nil, apiErrorf(errMsgFailedCreate, "ibeacon", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -122,7 +122,7 @@ func (c *Client) UpdateIBeaconByID(id int, beacon *ResourceIBeacons) (*ResourceI
 	var response ResourceIBeacons
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "ibeacon", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "ibeacon", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -146,7 +146,7 @@ func (c *Client) UpdateIBeaconByName(name string, beacon *ResourceIBeacons) (*Re
 	var response ResourceIBeacons
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "ibeacon", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "ibeacon", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -162,7 +162,7 @@ func (c *Client) DeleteIBeaconByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "ibeacon", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "ibeacon", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -178,7 +178,7 @@ func (c *Client) DeleteIBeaconByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "ibeacon", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "ibeacon", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
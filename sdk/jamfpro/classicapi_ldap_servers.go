@@ -120,7 +120,7 @@ func (c *Client) GetLDAPServers() (*ResponseLDAPServersList, error) {
 	var ldapServers ResponseLDAPServersList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServers)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "ldap servers", err)
+		return nil, apiErrorf(errMsgFailedGet, "ldap servers", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -137,7 +137,7 @@ func (c *Client) GetLDAPServerByID(id int) (*ResourceLDAPServers, error) {
 	var ldapServer ResourceLDAPServers
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "ldap server", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "ldap server", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -154,7 +154,7 @@ func (c *Client) GetLDAPServerByName(name string) (*ResourceLDAPServers, error)
 	var ldapServer ResourceLDAPServers
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "ldap server", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "ldap server", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -171,7 +171,7 @@ func (c *Client) GetLDAPServerByIDAndUserDataSubset(id int, user string) (*Resou
 	var ldapServer ResourceLDAPServers
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "ldap server and user data", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "ldap server and user data", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -188,7 +188,7 @@ func (c *Client) GetLDAPServerByIDAndGroupDataSubset(id int, group string) (*Res
 	var ldapServer ResourceLDAPServers
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "ldap server and group data", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "ldap server and group data", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -205,7 +205,7 @@ func (c *Client) GetLDAPServerByIDAndUserMembershipInGroupDataSubset(id int, gro
 	var ldapServer ResourceLDAPServers
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "ldap server and user membership", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "ldap server and user membership", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -222,7 +222,7 @@ func (c *Client) GetLDAPServerByNameAndUserDataSubset(name, user string) (*Resou
 	var ldapServer ResourceLDAPServers
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "ldap server and user data", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "ldap server and user data", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -239,7 +239,7 @@ func (c *Client) GetLDAPServerByNameAndGroupDataSubset(name, group string) (*Res
 	var ldapServer ResourceLDAPServers
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "ldap server and group data", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "ldap server and group data", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -256,7 +256,7 @@ func (c *Client) GetLDAPServerByNameAndUserMembershipInGroupDataSubset(name, gro
 	var ldapServer ResourceLDAPServers
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ldapServer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "ldap server and user membership data", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "ldap server and user membership data", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -280,7 +280,7 @@ func (c *Client) CreateLDAPServer(ldapServer *ResourceLDAPServers) (*ResourceLDA
 	var responseLDAPServer ResourceLDAPServers
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseLDAPServer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "ldap server", err)
+		return nil, apiErrorf(errMsgFailedCreate, "ldap server", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -304,7 +304,7 @@ func (c *Client) UpdateLDAPServerByID(id int, ldapServer *ResourceLDAPServers) (
 	var responseLDAPServer ResourceLDAPServers
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseLDAPServer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "ldap server", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "ldap server", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -328,7 +328,7 @@ func (c *Client) UpdateLDAPServerByName(name string, ldapServer *ResourceLDAPSer
 	var responseLDAPServer ResourceLDAPServers
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseLDAPServer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "ldap server", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "ldap server", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -344,7 +344,7 @@ func (c *Client) DeleteLDAPServerByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "ldap server", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "ldap server", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -360,7 +360,7 @@ func (c *Client) DeleteLDAPServerByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "ldap server", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "ldap server", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -158,7 +158,7 @@ func (c *Client) GetMacApplications() (*ResponseMacApplicationsList, error) {
 	var macApps ResponseMacApplicationsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macApps)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "mac applications", err)
+		return nil, apiErrorf(errMsgFailedGet, "mac applications", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -177,7 +177,7 @@ func (c *Client) GetMacApplicationByID(id int) (*ResourceMacApplications, error)
 	var macApp ResourceMacApplications
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macApp)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mac application", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mac application", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -194,7 +194,7 @@ func (c *Client) GetMacApplicationByName(name string) (*ResourceMacApplications,
 	var macApp ResourceMacApplications
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macApp)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mac application", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mac application", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -212,7 +212,7 @@ func (c *Client) GetMacApplicationByIDAndDataSubset(id int, subset string) (*Res
 	var macApp ResourceMacApplications
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macApp)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mac application and data subset", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mac application and data subset", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -230,7 +230,7 @@ func (c *Client) GetMacApplicationByNameAndDataSubset(name, subset string) (*Res
 	var macApp ResourceMacApplications
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macApp)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mac application and data subset", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mac application and data subset", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -254,7 +254,7 @@ func (c *Client) CreateMacApplication(macApp ResourceMacApplications) (*Resource
 	var response ResourceMacApplications
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "mac application", err)
+		return nil, apiErrorf(errMsgFailedCreate, "mac application", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -278,7 +278,7 @@ func (c *Client) UpdateMacApplicationByID(id int, macApp ResourceMacApplications
 	var response ResourceMacApplications
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mac application", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "mac application", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -302,7 +302,7 @@ func (c *Client) UpdateMacApplicationByName(name string, macApp ResourceMacAppli
 	var response ResourceMacApplications
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mac application", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "mac application", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -318,7 +318,7 @@ func (c *Client) DeleteMacApplicationByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "mac application", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "mac application", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -334,7 +334,7 @@ func (c *Client) DeleteMacApplicationByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "mac application", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "mac application", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -175,7 +175,7 @@ func (c *Client) GetMacOSConfigurationProfiles() (*ResponseMacOSConfigurationPro
 	var profilesList ResponseMacOSConfigurationProfileList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profilesList)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "mac os config profiles", err)
+		return nil, apiErrorf(errMsgFailedGet, "mac os config profiles", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -192,7 +192,7 @@ func (c *Client) GetMacOSConfigurationProfileByID(id int) (*ResourceMacOSConfigu
 	var profile ResourceMacOSConfigurationProfile
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mac os config profile", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mac os config profile", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -209,7 +209,7 @@ func (c *Client) GetMacOSConfigurationProfileByName(name string) (*ResourceMacOS
 	var profile ResourceMacOSConfigurationProfile
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mac os config profile", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mac os config profile", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -226,7 +226,7 @@ func (c *Client) GetMacOSConfigurationProfileByNameByID(name string) (*ResourceM
 
 	profilesList, err := c.GetMacOSConfigurationProfiles()
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "mac os config profiles", err)
+		return nil, apiErrorf(errMsgFailedGet, "mac os config profiles", err)
 	}
 
 	var profileID int
@@ -238,12 +238,12 @@ func (c *Client) GetMacOSConfigurationProfileByNameByID(name string) (*ResourceM
 	}
 
 	if profileID == 0 {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mac os config profile", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mac os config profile", name, err)
 	}
 
 	detailedProfile, err := c.GetMacOSConfigurationProfileByID(profileID)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mac os config profile", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mac os config profile", name, err)
 	}
 
 	return detailedProfile, nil
@@ -266,7 +266,7 @@ func (c *Client) CreateMacOSConfigurationProfile(profile *ResourceMacOSConfigura
 
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "mac os config profile", err)
+		return nil, apiErrorf(errMsgFailedCreate, "mac os config profile", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -292,7 +292,7 @@ func (c *Client) UpdateMacOSConfigurationProfileByID(id int, profile *ResourceMa
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return 0, fmt.Errorf(errMsgFailedUpdateByID, "mac os config profile", id, err)
+		return 0, apiErrorf(errMsgFailedUpdateByID, "mac os config profile", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -318,7 +318,7 @@ func (c *Client) UpdateMacOSConfigurationProfileByName(name string, profile *Res
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return 0, fmt.Errorf(errMsgFailedUpdateByName, "mac os config profile", name, err)
+		return 0, apiErrorf(errMsgFailedUpdateByName, "mac os config profile", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -334,7 +334,7 @@ func (c *Client) DeleteMacOSConfigurationProfileByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "mac os config profile", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "mac os config profile", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -350,7 +350,7 @@ func (c *Client) DeleteMacOSConfigurationProfileByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "mac os config profile", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "mac os config profile", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
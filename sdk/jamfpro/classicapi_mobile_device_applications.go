@@ -194,7 +194,7 @@ func (c *Client) GetMobileDeviceApplications() (*ResponseMobileDeviceApplication
 	var mobileDeviceApps ResponseMobileDeviceApplicationsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &mobileDeviceApps)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "mobile device applications", err)
+		return nil, apiErrorf(errMsgFailedGet, "mobile device applications", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -213,7 +213,7 @@ func (c *Client) GetMobileDeviceApplicationByID(id int) (*ResourceMobileDeviceAp
 	var app ResourceMobileDeviceApplication
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &app)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device application", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mobile device application", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -230,7 +230,7 @@ func (c *Client) GetMobileDeviceApplicationByName(name string) (*ResourceMobileD
 	var app ResourceMobileDeviceApplication
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &app)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device application", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mobile device application", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -247,7 +247,7 @@ func (c *Client) GetMobileDeviceApplicationByAppBundleID(id string) (*ResourceMo
 	var app ResourceMobileDeviceApplication
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &app)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device application (app bundle id)", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mobile device application (app bundle id)", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -264,7 +264,7 @@ func (c *Client) GetMobileDeviceApplicationByAppBundleIDAndVersion(id string, ve
 	var app ResourceMobileDeviceApplication
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &app)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device application (by bundle id and version)", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mobile device application (by bundle id and version)", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -281,7 +281,7 @@ func (c *Client) GetMobileDeviceApplicationByIDAndDataSubset(id int, subset stri
 	var app ResourceMobileDeviceApplication
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &app)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device application with data subset", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mobile device application with data subset", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -298,7 +298,7 @@ func (c *Client) GetMobileDeviceApplicationByNameAndDataSubset(name string, subs
 	var app ResourceMobileDeviceApplication
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &app)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device application and data subset", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mobile device application and data subset", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -323,7 +323,7 @@ func (c *Client) CreateMobileDeviceApplication(app *ResourceMobileDeviceApplicat
 	var responseApp ResourceMobileDeviceApplication
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseApp)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "mobile device application", err)
+		return nil, apiErrorf(errMsgFailedCreate, "mobile device application", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -348,7 +348,7 @@ func (c *Client) UpdateMobileDeviceApplicationByID(id int, app *ResourceMobileDe
 	var responseApp ResourceMobileDeviceApplication
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseApp)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device application", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "mobile device application", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -373,7 +373,7 @@ func (c *Client) UpdateMobileDeviceApplicationByName(name string, app *ResourceM
 	var responseApp ResourceMobileDeviceApplication
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseApp)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mobile device application", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "mobile device application", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -398,7 +398,7 @@ func (c *Client) UpdateMobileDeviceApplicationByApplicationBundleID(id string, a
 	var responseApp ResourceMobileDeviceApplication
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseApp)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device application (app bundle id)", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "mobile device application (app bundle id)", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -422,7 +422,7 @@ func (c *Client) UpdateMobileDeviceApplicationByIDAndAppVersion(id int, version
 	var responseApp ResourceMobileDeviceApplication
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseApp)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device application and app version", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "mobile device application and app version", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -438,7 +438,7 @@ func (c *Client) DeleteMobileDeviceApplicationpByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device application", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "mobile device application", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -454,7 +454,7 @@ func (c *Client) DeleteMobileDeviceApplicationByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "mobile device application", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "mobile device application", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -470,7 +470,7 @@ func (c *Client) DeleteMobileDeviceApplicationByBundleID(id string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device application (bundle id)", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "mobile device application (bundle id)", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -486,7 +486,7 @@ func (c *Client) DeleteMobileDeviceApplicationByBundleIDAndVersion(id string, ve
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device application (bundle id and version)", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "mobile device application (bundle id and version)", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -143,7 +143,7 @@ func (c *Client) GetMobileDeviceConfigurationProfiles() (*ResponseMobileDeviceCo
 	var profiles ResponseMobileDeviceConfigurationProfilesList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profiles)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "mobile device configuration profiles", err)
+		return nil, apiErrorf(errMsgFailedGet, "mobile device configuration profiles", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -160,7 +160,7 @@ func (c *Client) GetMobileDeviceConfigurationProfileByID(id int) (*ResourceMobil
 	var profile ResourceMobileDeviceConfigurationProfile
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device configuration profile", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mobile device configuration profile", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -177,7 +177,7 @@ func (c *Client) GetMobileDeviceConfigurationProfileByName(name string) (*Resour
 	var profile ResourceMobileDeviceConfigurationProfile
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device configuration profile", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mobile device configuration profile", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -194,7 +194,7 @@ func (c *Client) GetMobileDeviceConfigurationProfileByIDWithSubset(id int, subse
 	var profile ResourceMobileDeviceConfigurationProfile
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device configuration profile with data subset", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mobile device configuration profile with data subset", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -211,7 +211,7 @@ func (c *Client) GetMobileDeviceConfigurationProfileByNameWithSubset(name string
 	var profile ResourceMobileDeviceConfigurationProfile
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device configuration profile with data subset", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mobile device configuration profile with data subset", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -236,7 +236,7 @@ func (c *Client) CreateMobileDeviceConfigurationProfile(profile *ResourceMobileD
 	var responseProfile ResponseMobileDeviceConfigurationProfileCreateAndUpdate
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseProfile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "mobile device configuration profile", err)
+		return nil, apiErrorf(errMsgFailedCreate, "mobile device configuration profile", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -261,7 +261,7 @@ func (c *Client) UpdateMobileDeviceConfigurationProfileByID(id int, profile *Res
 	var responseProfile ResponseMobileDeviceConfigurationProfileCreateAndUpdate
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseProfile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device configuration profile", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "mobile device configuration profile", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -285,7 +285,7 @@ func (c *Client) UpdateMobileDeviceConfigurationProfileByName(name string, profi
 	var responseProfile ResponseMobileDeviceConfigurationProfileCreateAndUpdate
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseProfile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mobile device configuration profile", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "mobile device configuration profile", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -301,7 +301,7 @@ func (c *Client) DeleteMobileDeviceConfigurationProfileByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device configuration profile", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "mobile device configuration profile", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -317,7 +317,7 @@ func (c *Client) DeleteMobileDeviceConfigurationProfileByName(name string) error
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "mobile device configuration profile", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "mobile device configuration profile", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -99,7 +99,7 @@ func (c *Client) GetMobileDeviceEnrollmentProfiles() (*ResponseMobileDeviceEnrol
 	var enrollmentProfiles ResponseMobileDeviceEnrollmentProfilesList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &enrollmentProfiles)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "mobile device enrollment profiles", err)
+		return nil, apiErrorf(errMsgFailedGet, "mobile device enrollment profiles", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -116,7 +116,7 @@ func (c *Client) GetMobileDeviceEnrollmentProfileByID(id int) (*ResourceMobileDe
 	var profile ResourceMobileDeviceEnrollmentProfile
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device enrollment profile", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mobile device enrollment profile", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -133,7 +133,7 @@ func (c *Client) GetMobileDeviceEnrollmentProfileByName(name string) (*ResourceM
 	var profile ResourceMobileDeviceEnrollmentProfile
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device enrollment profile", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mobile device enrollment profile", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -150,7 +150,7 @@ func (c *Client) GetProfileByInvitation(invitation string) (*ResourceMobileDevic
 	var profile ResourceMobileDeviceEnrollmentProfile
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByString, "mobile device enrollment profile", "invitation", invitation, err)
+		return nil, apiErrorf(errMsgFailedGetByString, "mobile device enrollment profile", "invitation", invitation, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -167,7 +167,7 @@ func (c *Client) GetMobileDeviceEnrollmentProfileByIDWithSubset(id int, subset s
 	var profile ResourceMobileDeviceEnrollmentProfile
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device enrollment profile", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mobile device enrollment profile", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -184,7 +184,7 @@ func (c *Client) GetMobileDeviceEnrollmentProfileByNameWithSubset(name string, s
 	var profile ResourceMobileDeviceEnrollmentProfile
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device enrollment profile", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mobile device enrollment profile", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -208,7 +208,7 @@ func (c *Client) CreateMobileDeviceEnrollmentProfile(profile *ResourceMobileDevi
 	var responseProfile ResourceMobileDeviceEnrollmentProfile
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseProfile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "mobile device enrollment profile", err)
+		return nil, apiErrorf(errMsgFailedCreate, "mobile device enrollment profile", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -232,7 +232,7 @@ func (c *Client) UpdateMobileDeviceEnrollmentProfileByID(id int, profile *Resour
 	var responseProfile ResourceMobileDeviceEnrollmentProfile
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseProfile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device enrollment profile", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "mobile device enrollment profile", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -256,7 +256,7 @@ func (c *Client) UpdateMobileDeviceEnrollmentProfileByName(name string, profile
 	var responseProfile ResourceMobileDeviceEnrollmentProfile
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseProfile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mobile device enrollment profile", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "mobile device enrollment profile", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -280,7 +280,7 @@ func (c *Client) UpdateMobileDeviceEnrollmentProfileByInvitation(invitation stri
 	var responseProfile ResourceMobileDeviceEnrollmentProfile
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseProfile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByString, "mobile device enrollment profile", "invitation", invitation, err)
+		return nil, apiErrorf(errMsgFailedUpdateByString, "mobile device enrollment profile", "invitation", invitation, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -296,7 +296,7 @@ func (c *Client) DeleteMobileDeviceEnrollmentProfileByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device enrollment profile", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "mobile device enrollment profile", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -312,7 +312,7 @@ func (c *Client) DeleteMobileDeviceEnrollmentProfileByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "mobile device enrollment profile", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "mobile device enrollment profile", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -328,7 +328,7 @@ func (c *Client) DeleteMobileDeviceEnrollmentProfileByInvitation(invitation stri
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByString, "mobile device enrollment profile", "invitation", invitation, err)
+		return apiErrorf(errMsgFailedDeleteByString, "mobile device enrollment profile", "invitation", invitation, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -38,7 +38,8 @@ type ResourceMobileExtensionAttribute struct {
 // Subsets
 
 type MobileExtensionAttributeSubsetInputType struct {
-	Type string `xml:"type,omitempty"`
+	Type    string   `xml:"type,omitempty"`
+	Choices []string `xml:"popup_choices>choice,omitempty"`
 }
 
 // CRUD
@@ -50,7 +51,7 @@ func (c *Client) GetMobileExtensionAttributes() (*ResponseMobileDeviceExtensionA
 	var extensionAttributes ResponseMobileDeviceExtensionAttributesList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &extensionAttributes)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "mobile device extension attributes", err)
+		return nil, apiErrorf(errMsgFailedGet, "mobile device extension attributes", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -67,7 +68,7 @@ func (c *Client) GetMobileExtensionAttributeByID(id int) (*ResourceMobileExtensi
 	var attribute ResourceMobileExtensionAttribute
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &attribute)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device extension attribute", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mobile device extension attribute", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -84,7 +85,7 @@ func (c *Client) GetMobileExtensionAttributeByName(name string) (*ResourceMobile
 	var attribute ResourceMobileExtensionAttribute
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &attribute)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device extension attribute", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mobile device extension attribute", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -108,7 +109,7 @@ func (c *Client) CreateMobileExtensionAttribute(attribute *ResourceMobileExtensi
 	var responseAttribute ResourceMobileExtensionAttribute
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseAttribute)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "mobile device extension attribute", err)
+		return nil, apiErrorf(errMsgFailedCreate, "mobile device extension attribute", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -132,7 +133,7 @@ func (c *Client) UpdateMobileExtensionAttributeByID(id int, attribute *ResourceM
 	var responseAttribute ResourceMobileExtensionAttribute
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseAttribute)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device extension attribute", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "mobile device extension attribute", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -156,7 +157,7 @@ func (c *Client) UpdateMobileExtensionAttributeByName(name string, attribute *Re
 	var responseAttribute ResourceMobileExtensionAttribute
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseAttribute)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mobile device extension attribute", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "mobile device extension attribute", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -172,7 +173,7 @@ func (c *Client) DeleteMobileExtensionAttributeByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device extension attribute", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "mobile device extension attribute", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -188,7 +189,7 @@ func (c *Client) DeleteMobileExtensionAttributeByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "mobile device extension attribute", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "mobile device extension attribute", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -56,6 +56,22 @@ type MobileDeviceGroupSubsetDeviceItem struct {
 	SerialNumber   string `xml:"serial_number,omitempty"`
 }
 
+// Static group membership
+
+// requestMobileDeviceGroupMembership is the minimal payload used to add or remove
+// mobile devices from a static group without resending the full member list.
+type requestMobileDeviceGroupMembership struct {
+	XMLName               xml.Name                        `xml:"mobile_device_group"`
+	MobileDeviceAdditions []MobileDeviceGroupSubsetMember `xml:"mobile_device_additions>mobile_device,omitempty"`
+	MobileDeviceDeletions []MobileDeviceGroupSubsetMember `xml:"mobile_device_deletions>mobile_device,omitempty"`
+}
+
+// MobileDeviceGroupSubsetMember identifies a mobile device by ID or serial number in a membership change.
+type MobileDeviceGroupSubsetMember struct {
+	ID           int    `xml:"id,omitempty"`
+	SerialNumber string `xml:"serial_number,omitempty"`
+}
+
 // CRUD
 
 // GetMobileDeviceGroups retrieves a serialized list of mobile device groups.
@@ -65,7 +81,7 @@ func (c *Client) GetMobileDeviceGroups() (*ResponseMobileDeviceGroupsList, error
 	var groups ResponseMobileDeviceGroupsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &groups)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "mobile device groups", err)
+		return nil, apiErrorf(errMsgFailedGet, "mobile device groups", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -82,7 +98,7 @@ func (c *Client) GetMobileDeviceGroupByID(id int) (*ResourceMobileDeviceGroup, e
 	var group ResourceMobileDeviceGroup
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &group)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device group", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mobile device group", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -99,7 +115,7 @@ func (c *Client) GetMobileDeviceGroupByName(name string) (*ResourceMobileDeviceG
 	var group ResourceMobileDeviceGroup
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &group)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device group", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mobile device group", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -123,7 +139,7 @@ func (c *Client) CreateMobileDeviceGroup(group *ResourceMobileDeviceGroup) (*Res
 	var responseGroup ResourceMobileDeviceGroup
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseGroup)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "mobile device group", err)
+		return nil, apiErrorf(errMsgFailedCreate, "mobile device group", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -147,7 +163,7 @@ func (c *Client) UpdateMobileDeviceGroupByID(id int, group *ResourceMobileDevice
 	var updatedGroup ResourceMobileDeviceGroup
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedGroup)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device group", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "mobile device group", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -171,7 +187,7 @@ func (c *Client) UpdateMobileDeviceGroupByName(name string, group *ResourceMobil
 	var updatedGroup ResourceMobileDeviceGroup
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedGroup)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mobile device group", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "mobile device group", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -187,7 +203,7 @@ func (c *Client) DeleteMobileDeviceGroupByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device group", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "mobile device group", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -203,7 +219,7 @@ func (c *Client) DeleteMobileDeviceGroupByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "mobile device group", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "mobile device group", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -212,3 +228,72 @@ func (c *Client) DeleteMobileDeviceGroupByName(name string) error {
 
 	return nil
 }
+
+// AddMobileDevicesToStaticGroup adds mobile devices to a static mobile device group by device ID.
+// Members are sent as mobile_device_additions in batches, so existing members are left untouched.
+func (c *Client) AddMobileDevicesToStaticGroup(groupID int, mobileDeviceIDs []int) error {
+	members := make([]MobileDeviceGroupSubsetMember, 0, len(mobileDeviceIDs))
+	for _, id := range mobileDeviceIDs {
+		members = append(members, MobileDeviceGroupSubsetMember{ID: id})
+	}
+
+	return c.updateStaticMobileDeviceGroupMembership(groupID, members, false)
+}
+
+// AddMobileDevicesToStaticGroupBySerialNumber adds mobile devices to a static mobile device group by serial number.
+func (c *Client) AddMobileDevicesToStaticGroupBySerialNumber(groupID int, serialNumbers []string) error {
+	members := make([]MobileDeviceGroupSubsetMember, 0, len(serialNumbers))
+	for _, serialNumber := range serialNumbers {
+		members = append(members, MobileDeviceGroupSubsetMember{SerialNumber: serialNumber})
+	}
+
+	return c.updateStaticMobileDeviceGroupMembership(groupID, members, false)
+}
+
+// RemoveMobileDevicesFromStaticGroup removes mobile devices from a static mobile device group by device ID.
+func (c *Client) RemoveMobileDevicesFromStaticGroup(groupID int, mobileDeviceIDs []int) error {
+	members := make([]MobileDeviceGroupSubsetMember, 0, len(mobileDeviceIDs))
+	for _, id := range mobileDeviceIDs {
+		members = append(members, MobileDeviceGroupSubsetMember{ID: id})
+	}
+
+	return c.updateStaticMobileDeviceGroupMembership(groupID, members, true)
+}
+
+// RemoveMobileDevicesFromStaticGroupBySerialNumber removes mobile devices from a static mobile device group by serial number.
+func (c *Client) RemoveMobileDevicesFromStaticGroupBySerialNumber(groupID int, serialNumbers []string) error {
+	members := make([]MobileDeviceGroupSubsetMember, 0, len(serialNumbers))
+	for _, serialNumber := range serialNumbers {
+		members = append(members, MobileDeviceGroupSubsetMember{SerialNumber: serialNumber})
+	}
+
+	return c.updateStaticMobileDeviceGroupMembership(groupID, members, true)
+}
+
+// updateStaticMobileDeviceGroupMembership sends mobile device additions or deletions for a static group
+// in batches of staticGroupMembershipBatchSize.
+func (c *Client) updateStaticMobileDeviceGroupMembership(groupID int, members []MobileDeviceGroupSubsetMember, remove bool) error {
+	endpoint := fmt.Sprintf("%s/id/%d", uriMobileDeviceGroups, groupID)
+
+	for start := 0; start < len(members); start += staticGroupMembershipBatchSize {
+		end := min(start+staticGroupMembershipBatchSize, len(members))
+
+		var requestBody requestMobileDeviceGroupMembership
+		if remove {
+			requestBody.MobileDeviceDeletions = members[start:end]
+		} else {
+			requestBody.MobileDeviceAdditions = members[start:end]
+		}
+
+		var updatedGroup ResourceMobileDeviceGroup
+		resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedGroup)
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			return apiErrorf(errMsgFailedUpdateByID, "mobile device group membership", groupID, fmt.Errorf("members %d-%d of %d: %w", start+1, end, len(members), err))
+		}
+	}
+
+	return nil
+}
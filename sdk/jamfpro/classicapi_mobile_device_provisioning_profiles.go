@@ -52,7 +52,7 @@ func (c *Client) GetMobileDeviceProvisioningProfiles() (*ResponseMobileDevicePro
 	var profiles ResponseMobileDeviceProvisioningProfilesList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profiles)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "mobile device provisioning profiles", err)
+		return nil, apiErrorf(errMsgFailedGet, "mobile device provisioning profiles", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -69,7 +69,7 @@ func (c *Client) GetMobileDeviceProvisioningProfileByID(id int) (*ResourceMobile
 	var profile ResourceMobileDeviceProvisioningProfile
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device provisioning profile", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mobile device provisioning profile", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -86,7 +86,7 @@ func (c *Client) GetMobileDeviceProvisioningProfileByName(name string) (*Resourc
 	var profile ResourceMobileDeviceProvisioningProfile
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device provisioning profile", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mobile device provisioning profile", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -103,7 +103,7 @@ func (c *Client) GetMobileDeviceProvisioningProfileByUUID(uuid string) (*Resourc
 	var profile ResourceMobileDeviceProvisioningProfile
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &profile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByString, "mobile device provisioning profile", "uuid", uuid, err)
+		return nil, apiErrorf(errMsgFailedGetByString, "mobile device provisioning profile", "uuid", uuid, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -127,7 +127,7 @@ func (c *Client) CreateMobileDeviceProvisioningProfile(id int, profile *Resource
 	var responseProfile ResourceMobileDeviceProvisioningProfile
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseProfile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreateWithValue, "mobile device provisioning profile", "id", id, err)
+		return nil, apiErrorf(errMsgFailedCreateWithValue, "mobile device provisioning profile", "id", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -151,7 +151,7 @@ func (c *Client) CreateMobileDeviceProvisioningProfileByName(name string, profil
 	var responseProfile ResourceMobileDeviceProvisioningProfile
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseProfile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreateWithValue, "mobile device provisioning profile", "name", name, err)
+		return nil, apiErrorf(errMsgFailedCreateWithValue, "mobile device provisioning profile", "name", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -177,7 +177,7 @@ func (c *Client) CreateMobileDeviceProvisioningProfileByUUID(uuid string, profil
 	var responseProfile ResourceMobileDeviceProvisioningProfile
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseProfile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreateWithValue, "mobile device provisioning profile", "uuid", uuid, err)
+		return nil, apiErrorf(errMsgFailedCreateWithValue, "mobile device provisioning profile", "uuid", uuid, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -201,7 +201,7 @@ func (c *Client) UpdateMobileDeviceProvisioningProfileByID(id int, profile *Reso
 	var updatedProfile ResourceMobileDeviceProvisioningProfile
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedProfile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device provisioning profile", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "mobile device provisioning profile", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -225,7 +225,7 @@ func (c *Client) UpdateMobileDeviceProvisioningProfileByName(name string, profil
 	var updatedProfile ResourceMobileDeviceProvisioningProfile
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedProfile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mobile device provisioning profile", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "mobile device provisioning profile", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -249,7 +249,7 @@ func (c *Client) UpdateMobileDeviceProvisioningProfileByUUID(uuid string, profil
 	var updatedProfile ResourceMobileDeviceProvisioningProfile
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedProfile)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByString, "mobile device provisioning profile", "uuid", uuid, err)
+		return nil, apiErrorf(errMsgFailedUpdateByString, "mobile device provisioning profile", "uuid", uuid, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -265,7 +265,7 @@ func (c *Client) DeleteMobileDeviceProvisioningProfileByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device provisioning profile", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "mobile device provisioning profile", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -281,7 +281,7 @@ func (c *Client) DeleteMobileDeviceProvisioningProfileByName(name string) error
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "mobile device provisioning profile", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "mobile device provisioning profile", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -297,7 +297,7 @@ func (c *Client) DeleteMobileDeviceProvisioningProfileByUUID(uuid string) error
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByString, "mobile device provisioning profile", "uuid", uuid, err)
+		return apiErrorf(errMsgFailedDeleteByString, "mobile device provisioning profile", "uuid", uuid, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -230,7 +230,7 @@ func (c *Client) GetMobileDevices() (*ResponseMobileDeviceList, error) {
 	var mobileDevices ResponseMobileDeviceList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &mobileDevices)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "mobile devices", err)
+		return nil, apiErrorf(errMsgFailedGet, "mobile devices", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -247,7 +247,7 @@ func (c *Client) GetMobileDeviceByID(id int) (*ResourceMobileDevice, error) {
 	var device ResourceMobileDevice
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &device)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mobile device", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -264,7 +264,7 @@ func (c *Client) GetMobileDeviceByName(name string) (*ResourceMobileDevice, erro
 	var device ResourceMobileDevice
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &device)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mobile device", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -281,7 +281,7 @@ func (c *Client) GetMobileDeviceByIDAndDataSubset(id int, subset string) (*Resou
 	var deviceSubset ResourceMobileDevice
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &deviceSubset)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device with data subset", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mobile device with data subset", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -298,7 +298,7 @@ func (c *Client) GetMobileDeviceByNameAndDataSubset(name, subset string) (*Resou
 	var deviceSubset ResourceMobileDevice
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &deviceSubset)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "mobile device with data subset", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "mobile device with data subset", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -322,7 +322,7 @@ func (c *Client) CreateMobileDevice(attribute *ResourceMobileDevice) (*ResourceM
 	var responseAttribute ResourceMobileDevice
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseAttribute)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "mobile device", err)
+		return nil, apiErrorf(errMsgFailedCreate, "mobile device", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -346,7 +346,7 @@ func (c *Client) UpdateMobileDeviceByID(id int, attribute *ResourceMobileDevice)
 	var responseAttribute ResourceMobileDevice
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseAttribute)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "mobile device", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "mobile device", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -370,7 +370,7 @@ func (c *Client) UpdateMobileDeviceByName(name string, attribute *ResourceMobile
 	var responseAttribute ResourceMobileDevice
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseAttribute)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "mobile device", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "mobile device", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -386,7 +386,7 @@ func (c *Client) DeleteMobileDeviceByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "mobile device", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -402,7 +402,7 @@ func (c *Client) DeleteMobileDeviceByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "mobile device", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "mobile device", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -56,7 +56,7 @@ func (c *Client) GetNetworkSegments() (*ResponseNetworkSegmentList, error) {
 	var segments ResponseNetworkSegmentList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &segments)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "network segments", err)
+		return nil, apiErrorf(errMsgFailedGet, "network segments", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -73,7 +73,7 @@ func (c *Client) GetNetworkSegmentByID(id int) (*ResourceNetworkSegment, error)
 	var segment ResourceNetworkSegment
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &segment)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "network segment", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "network segment", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -90,7 +90,7 @@ func (c *Client) GetNetworkSegmentByName(name string) (*ResourceNetworkSegment,
 	var segment ResourceNetworkSegment
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &segment)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "network segment", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "network segment", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -115,7 +115,7 @@ func (c *Client) CreateNetworkSegment(segment *ResourceNetworkSegment) (*Respons
 	var responseSegment ResponseNetworkSegmentCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseSegment)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "network segment", err)
+		return nil, apiErrorf(errMsgFailedCreate, "network segment", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -139,7 +139,7 @@ func (c *Client) UpdateNetworkSegmentByID(id int, segment *ResourceNetworkSegmen
 	var responseSegment ResponseNetworkSegmentCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseSegment)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "network segment", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "network segment", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -163,7 +163,7 @@ func (c *Client) UpdateNetworkSegmentByName(name string, segment *ResourceNetwor
 	var responseSegment ResponseNetworkSegmentCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseSegment)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "network segment", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "network segment", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -178,7 +178,7 @@ func (c *Client) DeleteNetworkSegmentByID(id int) error {
 	endpoint := fmt.Sprintf("%s/id/%d", uriNetworkSegments, id)
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "network segment", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "network segment", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -193,7 +193,7 @@ func (c *Client) DeleteNetworkSegmentByName(name string) error {
 	endpoint := fmt.Sprintf("%s/name/%s", uriNetworkSegments, name)
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "network segment", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "network segment", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
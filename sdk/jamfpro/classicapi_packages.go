@@ -52,6 +52,8 @@ type ResourcePackage struct {
 	AllowUninstalled           bool   `xml:"allow_uninstalled"`
 	OSRequirements             string `xml:"os_requirements,omitempty"`
 	RequiredProcessor          string `xml:"required_processor,omitempty"`
+	HashType                   string `xml:"hash_type,omitempty"`
+	HashValue                  string `xml:"hash_value,omitempty"`
 	SwitchWithPackage          string `xml:"switch_with_package,omitempty"`
 	InstallIfReportedAvailable bool   `xml:"install_if_reported_available"`
 	ReinstallOption            string `xml:"reinstall_option,omitempty"`
@@ -68,7 +70,7 @@ func (c *Client) GetPackages() (*ResponsePackagesList, error) {
 	var response ResponsePackagesList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "package", err)
+		return nil, apiErrorf(errMsgFailedGet, "package", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -85,7 +87,7 @@ func (c *Client) GetPackageByID(id int) (*ResourcePackage, error) {
 	var response ResourcePackage
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "package", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "package", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -102,7 +104,7 @@ func (c *Client) GetPackageByName(name string) (*ResourcePackage, error) {
 	var response ResourcePackage
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "package", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "package", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -126,7 +128,7 @@ func (c *Client) CreatePackage(pkg ResourcePackage) (*ResponsePackageCreatedAndU
 	var response ResponsePackageCreatedAndUpdated
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "package", err)
+		return nil, apiErrorf(errMsgFailedCreate, "package", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -153,7 +155,7 @@ func (c *Client) UpdatePackageByID(id int, pkg *ResourcePackage) (*ResponsePacka
 	// Use PUT method for updating the package
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "package", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "package", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -179,7 +181,7 @@ func (c *Client) UpdatePackageByName(name string, pkg *ResourcePackage) (*Respon
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "package", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "package", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -195,7 +197,7 @@ func (c *Client) DeletePackageByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "package", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "package", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -211,7 +213,7 @@ func (c *Client) DeletePackageByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "package", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "package", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -49,7 +49,7 @@ func (c *Client) GetPatchExternalSources() (*ResponsePatchExternalSourcesList, e
 	var externalSources ResponsePatchExternalSourcesList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &externalSources)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "patch external sources", err)
+		return nil, apiErrorf(errMsgFailedGet, "patch external sources", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -66,7 +66,7 @@ func (c *Client) GetPatchExternalSourceByID(id int) (*ResourcePatchExternalSourc
 	var externalSource ResourcePatchExternalSource
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &externalSource)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "patch external source", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "patch external source", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -83,7 +83,7 @@ func (c *Client) GetPatchExternalSourceByName(name string) (*ResourcePatchExtern
 	var externalSource ResourcePatchExternalSource
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &externalSource)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "patch external source", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "patch external source", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -107,7 +107,7 @@ func (c *Client) CreateExternalPatchSource(patchSource *ResourcePatchExternalSou
 	var responseSource ResourcePatchExternalSource
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseSource)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "patch external source", err)
+		return nil, apiErrorf(errMsgFailedCreate, "patch external source", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -131,7 +131,7 @@ func (c *Client) UpdateExternalPatchSourceByID(id int, patchSource *ResourcePatc
 	var responseSource ResourcePatchExternalSource
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseSource)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "patch external source", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "patch external source", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -155,7 +155,7 @@ func (c *Client) UpdateExternalPatchSourceByName(name string, patchSource *Resou
 	var responseSource ResourcePatchExternalSource
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseSource)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "patch external source", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "patch external source", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -171,7 +171,7 @@ func (c *Client) DeleteExternalPatchSourceByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "patch external source", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "patch external source", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
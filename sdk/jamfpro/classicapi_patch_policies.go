@@ -167,7 +167,7 @@ func (c *Client) GetPatchPoliciesByID(id int) (*ResourcePatchPolicies, error) {
 	var patchPolicyDetails ResourcePatchPolicies
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &patchPolicyDetails)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "patch policy", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "patch policy", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -184,7 +184,7 @@ func (c *Client) GetPatchPolicyByIDAndDataSubset(id int, subset string) (*Resour
 	var patchPolicySubset ResourcePatchPolicies
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &patchPolicySubset)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "patch policy", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "patch policy", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -208,7 +208,7 @@ func (c *Client) CreatePatchPolicy(policy *ResourcePatchPolicies, softwareTitleC
 	var responsePolicy ResourcePatchPolicies
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responsePolicy)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "patch policy", err)
+		return nil, apiErrorf(errMsgFailedCreate, "patch policy", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -232,7 +232,7 @@ func (c *Client) UpdatePatchPolicy(policy *ResourcePatchPolicies, softwareTitleC
 	var responsePolicy ResourcePatchPolicies
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responsePolicy)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdate, "patch policy", err)
+		return nil, apiErrorf(errMsgFailedUpdate, "patch policy", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -248,7 +248,7 @@ func (c *Client) DeletePatchPolicyByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "patch policy", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "patch policy", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
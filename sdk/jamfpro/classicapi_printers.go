@@ -58,7 +58,7 @@ func (c *Client) GetPrinters() (*ResponsePrintersList, error) {
 	var printers ResponsePrintersList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &printers)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "printers", err)
+		return nil, apiErrorf(errMsgFailedGet, "printers", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -75,7 +75,7 @@ func (c *Client) GetPrinterByID(id int) (*ResourcePrinter, error) {
 	var printer ResourcePrinter
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &printer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "printer", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "printer", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -92,7 +92,7 @@ func (c *Client) GetPrinterByName(name string) (*ResourcePrinter, error) {
 	var printer ResourcePrinter
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &printer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "printer", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "printer", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -117,7 +117,7 @@ func (c *Client) CreatePrinter(printer *ResourcePrinter) (*ResponsePrinterCreate
 	var responsePrinter ResponsePrinterCreateAndUpdate
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responsePrinter)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "printer", err)
+		return nil, apiErrorf(errMsgFailedCreate, "printer", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -142,7 +142,7 @@ func (c *Client) UpdatePrinterByID(id int, printer *ResourcePrinter) (*ResponseP
 	var responsePrinter ResponsePrinterCreateAndUpdate
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responsePrinter)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "printer", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "printer", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -167,7 +167,7 @@ func (c *Client) UpdatePrinterByName(name string, printer *ResourcePrinter) (*Re
 	var responsePrinter ResponsePrinterCreateAndUpdate
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responsePrinter)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "printer", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "printer", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -183,7 +183,7 @@ func (c *Client) DeletePrinterByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "printer", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "printer", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -199,7 +199,7 @@ func (c *Client) DeletePrinterByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "printer", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "printer", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
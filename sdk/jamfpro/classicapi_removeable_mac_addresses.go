@@ -32,7 +32,7 @@ func (c *Client) GetRemovableMACAddresses() (*ResponseRemovableMacAddressesList,
 	var macAddressesList ResponseRemovableMacAddressesList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macAddressesList)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "removeable macaddresses", err)
+		return nil, apiErrorf(errMsgFailedGet, "removeable macaddresses", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -49,7 +49,7 @@ func (c *Client) GetRemovableMACAddressByID(id int) (*ResourceRemovableMacAddres
 	var macAddressDetails ResourceRemovableMacAddress
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macAddressDetails)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "removeable macaddress", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "removeable macaddress", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -66,7 +66,7 @@ func (c *Client) GetRemovableMACAddressByName(name string) (*ResourceRemovableMa
 	var macAddressDetails ResourceRemovableMacAddress
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &macAddressDetails)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "removeable macaddress", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "removeable macaddress", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -91,7 +91,7 @@ func (c *Client) CreateRemovableMACAddress(macAddress *ResourceRemovableMacAddre
 	var responseMacAddress ResourceRemovableMacAddress
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &responseMacAddress)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "removeable macaddress", err)
+		return nil, apiErrorf(errMsgFailedCreate, "removeable macaddress", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -115,7 +115,7 @@ func (c *Client) UpdateRemovableMACAddressByID(id int, macAddress *ResourceRemov
 	var responseMacAddress ResourceRemovableMacAddress
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseMacAddress)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "removeable macaddress", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "removeable macaddress", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -139,7 +139,7 @@ func (c *Client) UpdateRemovableMACAddressByName(name string, macAddress *Resour
 	var responseMacAddress ResourceRemovableMacAddress
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &responseMacAddress)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "removeable macaddress", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "removeable macaddress", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -155,7 +155,7 @@ func (c *Client) DeleteRemovableMACAddressByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "removeable macaddress", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "removeable macaddress", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -171,7 +171,7 @@ func (c *Client) DeleteRemovableMACAddressByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "removeable macaddress", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "removeable macaddress", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
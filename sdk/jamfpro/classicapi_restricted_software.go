@@ -109,7 +109,7 @@ func (c *Client) GetRestrictedSoftwares() (*ResponseRestrictedSoftwaresList, err
 	var restrictedSoftwaresList ResponseRestrictedSoftwaresList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &restrictedSoftwaresList)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "restricted softwares", err)
+		return nil, apiErrorf(errMsgFailedGet, "restricted softwares", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -126,7 +126,7 @@ func (c *Client) GetRestrictedSoftwareByID(id int) (*ResourceRestrictedSoftware,
 	var restrictedSoftware ResourceRestrictedSoftware
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &restrictedSoftware)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "restricted software", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "restricted software", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -143,7 +143,7 @@ func (c *Client) GetRestrictedSoftwareByName(name string) (*ResourceRestrictedSo
 	var restrictedSoftware ResourceRestrictedSoftware
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &restrictedSoftware)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "restricted software", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "restricted software", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -167,7 +167,7 @@ func (c *Client) CreateRestrictedSoftware(restrictedSoftware *ResourceRestricted
 	var ResourceRestrictedSoftware ResourceRestrictedSoftware
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &ResourceRestrictedSoftware)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "restricted software", err)
+		return nil, apiErrorf(errMsgFailedCreate, "restricted software", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -192,7 +192,7 @@ func (c *Client) UpdateRestrictedSoftwareByID(id int, restrictedSoftware *Resour
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedUpdateByID, "restricted software", id, err)
+		return apiErrorf(errMsgFailedUpdateByID, "restricted software", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -217,7 +217,7 @@ func (c *Client) UpdateRestrictedSoftwareByName(name string, restrictedSoftware
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedUpdateByName, "restricted software", name, err)
+		return apiErrorf(errMsgFailedUpdateByName, "restricted software", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -233,7 +233,7 @@ func (c *Client) DeleteRestrictedSoftwareByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "restricted software", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "restricted software", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -249,7 +249,7 @@ func (c *Client) DeleteRestrictedSoftwareByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "restricted software", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "restricted software", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
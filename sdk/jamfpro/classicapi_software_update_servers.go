@@ -46,7 +46,7 @@ func (c *Client) GetSoftwareUpdateServers() (*ResponseSoftwareUpdateServersList,
 	var response ResponseSoftwareUpdateServersList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "software update servers", err)
+		return nil, apiErrorf(errMsgFailedGet, "software update servers", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -63,7 +63,7 @@ func (c *Client) GetSoftwareUpdateServerByID(id int) (*ResourceSoftwareUpdateSer
 	var response ResourceSoftwareUpdateServer
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "software update server", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "software update server", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -80,7 +80,7 @@ func (c *Client) GetSoftwareUpdateServerByName(name string) (*ResourceSoftwareUp
 	var response ResourceSoftwareUpdateServer
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "software update server", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "software update server", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -104,7 +104,7 @@ func (c *Client) CreateSoftwareUpdateServer(server *ResourceSoftwareUpdateServer
 	var response ResourceSoftwareUpdateServer
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "software update server", err)
+		return nil, apiErrorf(errMsgFailedCreate, "software update server", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -128,7 +128,7 @@ func (c *Client) UpdateSoftwareUpdateServerByID(id int, server *ResourceSoftware
 	var response ResourceSoftwareUpdateServer
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "software update server", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "software update server", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -152,7 +152,7 @@ func (c *Client) UpdateSoftwareUpdateServerByName(name string, server *ResourceS
 	var response ResourceSoftwareUpdateServer
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "software update server", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "software update server", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -168,7 +168,7 @@ func (c *Client) DeleteSoftwareUpdateServerByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "software update server", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "software update server", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -184,7 +184,7 @@ func (c *Client) DeleteSoftwareUpdateServerByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "software update server", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "software update server", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
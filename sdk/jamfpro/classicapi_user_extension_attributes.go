@@ -42,7 +42,8 @@ type ResourceUserExtensionAttribute struct {
 // Subsets
 
 type ResourceUserExtensionAttributeSubsetInputType struct {
-	Type string `xml:"type"`
+	Type    string   `xml:"type"`
+	Choices []string `xml:"popup_choices>choice,omitempty"`
 }
 
 // CRUD
@@ -54,7 +55,7 @@ func (c *Client) GetUserExtensionAttributes() (*ResponseUserExtensionAttributesL
 	var extAttributes ResponseUserExtensionAttributesList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &extAttributes)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "user extension attributes", err)
+		return nil, apiErrorf(errMsgFailedGet, "user extension attributes", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -71,7 +72,7 @@ func (c *Client) GetUserExtensionAttributeByID(id int) (*ResourceUserExtensionAt
 	var userExtAttr ResourceUserExtensionAttribute
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userExtAttr)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "user extension attribute", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "user extension attribute", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -88,7 +89,7 @@ func (c *Client) GetUserExtensionAttributeByName(name string) (*ResourceUserExte
 	var userExtAttr ResourceUserExtensionAttribute
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userExtAttr)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "user extension attribute", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "user extension attribute", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -112,7 +113,7 @@ func (c *Client) CreateUserExtensionAttribute(attribute *ResourceUserExtensionAt
 	var createdAttribute ResourceUserExtensionAttribute
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdAttribute)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "user extension attribute", err)
+		return nil, apiErrorf(errMsgFailedCreate, "user extension attribute", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -136,7 +137,7 @@ func (c *Client) UpdateUserExtensionAttributeByID(id int, attribute *ResourceUse
 	var updatedAttribute ResourceUserExtensionAttribute
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedAttribute)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "user extension attribute", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "user extension attribute", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -160,7 +161,7 @@ func (c *Client) UpdateUserExtensionAttributeByName(name string, attribute *Reso
 	var updatedAttribute ResourceUserExtensionAttribute
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedAttribute)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "user extension attribute", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "user extension attribute", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -176,7 +177,7 @@ func (c *Client) DeleteUserExtensionAttributeByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "user extension attribute", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "user extension attribute", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -192,7 +193,7 @@ func (c *Client) DeleteUserExtensionAttributeByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "user extension attribute", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "user extension attribute", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
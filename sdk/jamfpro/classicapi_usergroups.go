@@ -63,6 +63,16 @@ type UserGroupSubsetUserItem struct {
 	EmailAddress string `xml:"email_address,omitempty"`
 }
 
+// Static group membership
+
+// requestUserGroupMembership is the minimal payload used to add or remove
+// users from a static group without resending the full member list.
+type requestUserGroupMembership struct {
+	XMLName       xml.Name                  `xml:"user_group"`
+	UserAdditions []UserGroupSubsetUserItem `xml:"user_additions>user,omitempty"`
+	UserDeletions []UserGroupSubsetUserItem `xml:"user_deletions>user,omitempty"`
+}
+
 // CRUD
 
 // GetUserGroups retrieves a list of all user groups.
@@ -72,7 +82,7 @@ func (c *Client) GetUserGroups() (*ResponseUserGroupsList, error) {
 	var userGroupsList ResponseUserGroupsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userGroupsList)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "user groups", err)
+		return nil, apiErrorf(errMsgFailedGet, "user groups", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -89,7 +99,7 @@ func (c *Client) GetUserGroupByID(id int) (*ResourceUserGroup, error) {
 	var userGroupDetail ResourceUserGroup
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userGroupDetail)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "user group", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "user group", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -106,7 +116,7 @@ func (c *Client) GetUserGroupByName(name string) (*ResourceUserGroup, error) {
 	var userGroupDetail ResourceUserGroup
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userGroupDetail)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "user group", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "user group", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -130,7 +140,7 @@ func (c *Client) CreateUserGroup(userGroup *ResourceUserGroup) (*ResponseUserGro
 	var createdUserGroup ResponseUserGroupCreateAndUpdate
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdUserGroup)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "user group", err)
+		return nil, apiErrorf(errMsgFailedCreate, "user group", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -154,7 +164,7 @@ func (c *Client) UpdateUserGroupByID(id int, userGroup *ResourceUserGroup) (*Res
 	var updatedUserGroup ResponseUserGroupCreateAndUpdate
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedUserGroup)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "user group", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "user group", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -178,7 +188,7 @@ func (c *Client) UpdateUserGroupByName(name string, userGroup *ResourceUserGroup
 	var updatedUserGroup ResponseUserGroupCreateAndUpdate
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedUserGroup)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "user group", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "user group", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -193,7 +203,7 @@ func (c *Client) DeleteUserGroupByID(id int) error {
 	endpoint := fmt.Sprintf("%s/id/%d", uriUserGroups, id)
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "user group", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "user group", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -208,7 +218,7 @@ func (c *Client) DeleteUserGroupByName(name string) error {
 	endpoint := fmt.Sprintf("%s/name/%s", uriUserGroups, name)
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "user group", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "user group", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -217,3 +227,72 @@ func (c *Client) DeleteUserGroupByName(name string) error {
 
 	return nil
 }
+
+// AddUsersToStaticGroup adds users to a static user group by user ID.
+// Members are sent as user_additions in batches, so existing members are left untouched.
+func (c *Client) AddUsersToStaticGroup(groupID int, userIDs []int) error {
+	members := make([]UserGroupSubsetUserItem, 0, len(userIDs))
+	for _, id := range userIDs {
+		members = append(members, UserGroupSubsetUserItem{ID: id})
+	}
+
+	return c.updateStaticUserGroupMembership(groupID, members, false)
+}
+
+// AddUsersToStaticGroupByUsername adds users to a static user group by username.
+func (c *Client) AddUsersToStaticGroupByUsername(groupID int, usernames []string) error {
+	members := make([]UserGroupSubsetUserItem, 0, len(usernames))
+	for _, username := range usernames {
+		members = append(members, UserGroupSubsetUserItem{Username: username})
+	}
+
+	return c.updateStaticUserGroupMembership(groupID, members, false)
+}
+
+// RemoveUsersFromStaticGroup removes users from a static user group by user ID.
+func (c *Client) RemoveUsersFromStaticGroup(groupID int, userIDs []int) error {
+	members := make([]UserGroupSubsetUserItem, 0, len(userIDs))
+	for _, id := range userIDs {
+		members = append(members, UserGroupSubsetUserItem{ID: id})
+	}
+
+	return c.updateStaticUserGroupMembership(groupID, members, true)
+}
+
+// RemoveUsersFromStaticGroupByUsername removes users from a static user group by username.
+func (c *Client) RemoveUsersFromStaticGroupByUsername(groupID int, usernames []string) error {
+	members := make([]UserGroupSubsetUserItem, 0, len(usernames))
+	for _, username := range usernames {
+		members = append(members, UserGroupSubsetUserItem{Username: username})
+	}
+
+	return c.updateStaticUserGroupMembership(groupID, members, true)
+}
+
+// updateStaticUserGroupMembership sends user additions or deletions for a static group
+// in batches of staticGroupMembershipBatchSize.
+func (c *Client) updateStaticUserGroupMembership(groupID int, members []UserGroupSubsetUserItem, remove bool) error {
+	endpoint := fmt.Sprintf("%s/id/%d", uriUserGroups, groupID)
+
+	for start := 0; start < len(members); start += staticGroupMembershipBatchSize {
+		end := min(start+staticGroupMembershipBatchSize, len(members))
+
+		var requestBody requestUserGroupMembership
+		if remove {
+			requestBody.UserDeletions = members[start:end]
+		} else {
+			requestBody.UserAdditions = members[start:end]
+		}
+
+		var updatedGroup ResponseUserGroupCreateAndUpdate
+		resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &updatedGroup)
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			return apiErrorf(errMsgFailedUpdateByID, "user group membership", groupID, fmt.Errorf("members %d-%d of %d: %w", start+1, end, len(members), err))
+		}
+	}
+
+	return nil
+}
@@ -93,7 +93,7 @@ func (c *Client) GetUsers() (*ResponseUsersList, error) {
 	var usersList ResponseUsersList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &usersList)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "users", err)
+		return nil, apiErrorf(errMsgFailedGet, "users", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -110,7 +110,7 @@ func (c *Client) GetUserByID(id int) (*ResourceUser, error) {
 	var userDetail ResourceUser
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userDetail)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "user", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "user", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -127,7 +127,7 @@ func (c *Client) GetUserByName(name string) (*ResourceUser, error) {
 	var userDetail ResourceUser
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userDetail)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "user", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "user", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -144,7 +144,7 @@ func (c *Client) GetUserByEmail(email string) (*ResourceUser, error) {
 	var userDetail ResourceUser
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &userDetail)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByEmail, "user", email, err)
+		return nil, apiErrorf(errMsgFailedGetByEmail, "user", email, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -168,7 +168,7 @@ func (c *Client) CreateUser(newUser *ResourceUser) (*ResourceUser, error) {
 	var createdUser ResourceUser
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &createdUser)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "user", err)
+		return nil, apiErrorf(errMsgFailedCreate, "user", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -192,7 +192,7 @@ func (c *Client) UpdateUserByID(id int, updatedUser *ResourceUser) (*ResourceUse
 	var user ResourceUser
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &user)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "user", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "user", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -216,7 +216,7 @@ func (c *Client) UpdateUserByName(name string, updatedUser *ResourceUser) (*Reso
 	var user ResourceUser
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &user)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "user", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "user", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -240,7 +240,7 @@ func (c *Client) UpdateUserByEmail(email string, updatedUser *ResourceUser) (*Re
 	var user ResourceUser
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &user)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByEmail, "user", email, err)
+		return nil, apiErrorf(errMsgFailedUpdateByEmail, "user", email, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -255,7 +255,7 @@ func (c *Client) DeleteUserByID(id int) error {
 	endpoint := fmt.Sprintf("%s/id/%d", uriUsers, id)
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "user", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "user", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -270,7 +270,7 @@ func (c *Client) DeleteUserByName(name string) error {
 	endpoint := fmt.Sprintf("%s/name/%s", uriUsers, name)
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "user", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "user", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -285,7 +285,7 @@ func (c *Client) DeleteUserByEmail(email string) error {
 	endpoint := fmt.Sprintf("%s/email/%s", uriUsers, email)
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByEmail, "user", email, err)
+		return apiErrorf(errMsgFailedDeleteByEmail, "user", email, err)
 	}
 
 	if resp != nil && resp.Body != nil {
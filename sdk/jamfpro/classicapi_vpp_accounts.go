@@ -57,7 +57,7 @@ func (c *Client) GetVPPAccounts() (*ResponseVPPAccountsList, error) {
 	var response ResponseVPPAccountsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "vpp accounts", err)
+		return nil, apiErrorf(errMsgFailedGet, "vpp accounts", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -74,7 +74,7 @@ func (c *Client) GetVPPAccountByID(id int) (*ResourceVPPAccount, error) {
 	var response ResourceVPPAccount
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "vpp account", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "vpp account", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -98,7 +98,7 @@ func (c *Client) CreateVPPAccount(account *ResourceVPPAccount) (*ResourceVPPAcco
 	var response ResourceVPPAccount
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "vpp account", err)
+		return nil, apiErrorf(errMsgFailedCreate, "vpp account", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -122,7 +122,7 @@ func (c *Client) UpdateVPPAccountByID(id int, account *ResourceVPPAccount) (*Res
 	var response ResourceVPPAccount
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "vpp account", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "vpp account", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -138,7 +138,7 @@ func (c *Client) DeleteVPPAccountByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "vpp account", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "vpp account", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
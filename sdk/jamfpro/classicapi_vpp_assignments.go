@@ -96,7 +96,7 @@ func (c *Client) GetVPPAssignments() (*ResponseVPPAssignmentsList, error) {
 	var assignments ResponseVPPAssignmentsList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &assignments)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "vpp assignments", err)
+		return nil, apiErrorf(errMsgFailedGet, "vpp assignments", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -113,7 +113,7 @@ func (c *Client) GetVPPAssignmentByID(id int) (*ResourceVPPAssignment, error) {
 	var assignment ResourceVPPAssignment
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &assignment)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "vpp assignment", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "vpp assignment", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -138,7 +138,7 @@ func (c *Client) CreateVPPAssignment(assignment *ResourceVPPAssignment) error {
 
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &handleResponse)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedCreate, "vpp assignment", err)
+		return apiErrorf(errMsgFailedCreate, "vpp assignment", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -163,7 +163,7 @@ func (c *Client) UpdateVPPAssignmentByID(id int, assignment *ResourceVPPAssignme
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &handleResponse)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedUpdateByID, "vpp assignment", id, err)
+		return apiErrorf(errMsgFailedUpdateByID, "vpp assignment", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -179,7 +179,7 @@ func (c *Client) DeleteVPPAssignmentByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "vpp assignment", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "vpp assignment", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
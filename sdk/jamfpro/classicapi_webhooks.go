@@ -61,7 +61,7 @@ func (c *Client) GetWebhooks() (*ResponseWebhooksList, error) {
 	var response ResponseWebhooksList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "webhooks", err)
+		return nil, apiErrorf(errMsgFailedGet, "webhooks", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -78,7 +78,7 @@ func (c *Client) GetWebhookByID(id int) (*ResourceWebhook, error) {
 	var response ResourceWebhook
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "webhook", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "webhook", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -95,7 +95,7 @@ func (c *Client) GetWebhookByName(name string) (*ResourceWebhook, error) {
 	var response ResourceWebhook
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "webhook", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "webhook", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -119,7 +119,7 @@ func (c *Client) CreateWebhook(webhook *ResourceWebhook) (*ResourceWebhook, erro
 	var response ResourceWebhook
 	resp, err := c.HTTP.DoRequest("POST", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "webhook", err)
+		return nil, apiErrorf(errMsgFailedCreate, "webhook", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -143,7 +143,7 @@ func (c *Client) UpdateWebhookByID(id int, webhook *ResourceWebhook) (*ResourceW
 	var response ResourceWebhook
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "webhook", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "webhook", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -167,7 +167,7 @@ func (c *Client) UpdateWebhookByName(name string, webhook *ResourceWebhook) (*Re
 	var response ResourceWebhook
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "webhook", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "webhook", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -182,7 +182,7 @@ func (c *Client) DeleteWebhookByID(id int) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "webhook", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "webhook", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -190,7 +190,7 @@ func (c *Client) DeleteWebhookByID(id int) error {
 	}
 
 	if resp.StatusCode != 204 {
-		return fmt.Errorf(errMsgFailedDeleteByID, "webhook", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "webhook", id, err)
 	}
 
 	return nil
@@ -201,7 +201,7 @@ func (c *Client) DeleteWebhookByName(name string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "webhook", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "webhook", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -209,7 +209,7 @@ func (c *Client) DeleteWebhookByName(name string) error {
 	}
 
 	if resp.StatusCode != 204 {
-		return fmt.Errorf(errMsgFailedDeleteByName, "webhook", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "webhook", name, err)
 	}
 
 	return nil
@@ -5,10 +5,6 @@
 
 package jamfpro
 
-import (
-	"fmt"
-)
-
 // Responses
 
 const uriAccountPreferences = "/api/v2/account-preferences"
@@ -53,7 +49,7 @@ func (c *Client) GetAccountPreferences() (*ResourceAccountPreferences, error) {
 	var accountPreferences ResourceAccountPreferences
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &accountPreferences)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "Account Preferences", err)
+		return nil, apiErrorf(errMsgFailedGet, "Account Preferences", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -70,7 +66,7 @@ func (c *Client) UpdateAccountPreferences(updatedSettings ResourceAccountPrefere
 
 	resp, err := c.HTTP.DoRequest("PATCH", endpoint, updatedSettings, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdate, "Account Preferences", err)
+		return nil, apiErrorf(errMsgFailedUpdate, "Account Preferences", err)
 	}
 
 	if resp != nil && resp.Body != nil {
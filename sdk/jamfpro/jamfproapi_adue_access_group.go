@@ -48,7 +48,7 @@ func (c *Client) GetAccountDrivenUserEnrollmentAccessGroups(sort_filter string)
 	endpoint := uriAccountDrivenUserEnrollment
 	resp, err := c.DoPaginatedGet(endpoint, standardPageSize, 0, sort_filter)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "ADUE Access Group List", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "ADUE Access Group List", err)
 	}
 
 	var OutStruct ResponseAccountDrivenUserEnrollmentAccessGroupsList
@@ -73,7 +73,7 @@ func (c *Client) GetAccountDrivenUserEnrollmentAccessGroupByID(id string) (*Reso
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ADUEGroup)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "ADUE Access Group", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "ADUE Access Group", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -87,7 +87,7 @@ func (c *Client) GetAccountDrivenUserEnrollmentAccessGroupByID(id string) (*Reso
 func (c *Client) GetAccountDrivenUserEnrollmentAccessGroupByName(name string) (*ResourceAccountDrivenUserEnrollmentAccessGroup, error) {
 	accessGroupsList, err := c.GetAccountDrivenUserEnrollmentAccessGroups("")
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "ADUE access group", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "ADUE access group", err)
 	}
 
 	for _, group := range accessGroupsList.Results {
@@ -106,7 +106,7 @@ func (c *Client) CreateAccountDrivenUserEnrollmentAccessGroup(script *ResourceAc
 
 	resp, err := c.HTTP.DoRequest("POST", endpoint, script, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "ADUE access group", err)
+		return nil, apiErrorf(errMsgFailedCreate, "ADUE access group", err)
 	}
 
 	if resp != nil {
@@ -123,7 +123,7 @@ func (c *Client) UpdateAccountDrivenUserEnrollmentAccessGroupByID(id string, gro
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, groupUpdate, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "ADUE Access Group", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "ADUE Access Group", id, err)
 	}
 
 	if resp != nil {
@@ -138,12 +138,12 @@ func (c *Client) UpdateAccountDrivenUserEnrollmentAccessGroupByName(targetName s
 	target, err := c.GetAccountDrivenUserEnrollmentAccessGroupByName(targetName)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "ADUE access group", targetName, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "ADUE access group", targetName, err)
 	}
 
 	resp, err := c.UpdateAccountDrivenUserEnrollmentAccessGroupByID(target.ID, groupUpdate)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "ADUE access group", targetName, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "ADUE access group", targetName, err)
 	}
 
 	return resp, nil
@@ -155,7 +155,7 @@ func (c *Client) DeleteAccountDrivenUserEnrollmentAccessGroupByID(id string) err
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 
 	if err != nil || resp.StatusCode != 204 {
-		return fmt.Errorf(errMsgFailedDeleteByID, "ADUE access group", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "ADUE access group", id, err)
 	}
 
 	if resp != nil {
@@ -169,13 +169,13 @@ func (c *Client) DeleteAccountDrivenUserEnrollmentAccessGroupByID(id string) err
 func (c *Client) DeleteAccountDrivenUserEnrollmentAccessGroupByName(targetName string) error {
 	target, err := c.GetAccountDrivenUserEnrollmentAccessGroupByName(targetName)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedGetByName, "ADUE access group", targetName, err)
+		return apiErrorf(errMsgFailedGetByName, "ADUE access group", targetName, err)
 	}
 
 	err = c.DeleteAccountDrivenUserEnrollmentAccessGroupByID(target.ID)
 
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "ADUE access group", targetName, err)
+		return apiErrorf(errMsgFailedDeleteByName, "ADUE access group", targetName, err)
 	}
 
 	return nil
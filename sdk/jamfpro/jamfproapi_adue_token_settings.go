@@ -5,8 +5,6 @@
 
 package jamfpro
 
-import "fmt"
-
 const uriUserEnrollmentTokenSettings = "/api/v1/adue-session-token-settings"
 
 // structs
@@ -25,7 +23,7 @@ func (c *Client) GetADUESessionTokenSettings() (*ResourceADUETokenSettings, erro
 
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "ADUE token settings", err)
+		return nil, apiErrorf(errMsgFailedGet, "ADUE token settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -42,7 +40,7 @@ func (c *Client) UpdateADUESessionTokenSettings(updatedSettings ResourceADUEToke
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, updatedSettings, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdate, "ADUE token settings", err)
+		return nil, apiErrorf(errMsgFailedUpdate, "ADUE token settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
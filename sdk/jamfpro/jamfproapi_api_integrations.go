@@ -47,7 +47,7 @@ func (c *Client) GetApiIntegrations(sort_filter string) (*ResponseApiIntegration
 	endpoint := uriApiIntegrations
 	resp, err := c.DoPaginatedGet(endpoint, standardPageSize, 0, sort_filter)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "api integrations", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "api integrations", err)
 	}
 
 	var OutStruct ResponseApiIntegrationsList
@@ -71,7 +71,7 @@ func (c *Client) GetApiIntegrationByID(id int) (*ResourceApiIntegration, error)
 	var integration ResourceApiIntegration
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &integration)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "api integration", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "api integration", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -85,7 +85,7 @@ func (c *Client) GetApiIntegrationByID(id int) (*ResourceApiIntegration, error)
 func (c *Client) GetApiIntegrationByName(name string) (*ResourceApiIntegration, error) {
 	integrations, err := c.GetApiIntegrations("")
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "api integration", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "api integration", err)
 	}
 
 	for _, integration := range integrations.Results {
@@ -104,7 +104,7 @@ func (c *Client) CreateApiIntegration(integration *ResourceApiIntegration) (*Res
 	var response ResourceApiIntegration
 	resp, err := c.HTTP.DoRequest("POST", endpoint, integration, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "api integration", err)
+		return nil, apiErrorf(errMsgFailedCreate, "api integration", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -122,7 +122,7 @@ func (c *Client) UpdateApiIntegrationByID(id int, integrationUpdate *ResourceApi
 	var updatedIntegration ResourceApiIntegration
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, integrationUpdate, &updatedIntegration)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "api integration", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "api integration", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -136,13 +136,13 @@ func (c *Client) UpdateApiIntegrationByID(id int, integrationUpdate *ResourceApi
 func (c *Client) UpdateApiIntegrationByName(name string, integrationUpdate *ResourceApiIntegration) (*ResourceApiIntegration, error) {
 	target, err := c.GetApiIntegrationByName(name)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "api integration", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "api integration", name, err)
 	}
 
 	target_id := target.ID
 	resp, err := c.UpdateApiIntegrationByID(target_id, integrationUpdate)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "api integration", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "api integration", name, err)
 	}
 
 	return resp, nil
@@ -156,7 +156,7 @@ func (c *Client) DeleteApiIntegrationByID(id int) error {
 	// Perform the DELETE request
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "api integration", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "api integration", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -170,14 +170,14 @@ func (c *Client) DeleteApiIntegrationByID(id int) error {
 func (c *Client) DeleteApiIntegrationByName(name string) error {
 	target, err := c.GetApiIntegrationByName(name)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedGetByName, "api integration", name, err)
+		return apiErrorf(errMsgFailedGetByName, "api integration", name, err)
 	}
 
 	target_id := target.ID
 
 	err = c.DeleteApiIntegrationByID(target_id)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "api integration", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "api integration", name, err)
 	}
 
 	return nil
@@ -192,7 +192,7 @@ func (c *Client) RefreshClientCredentialsByApiRoleID(id string) (*ResourceClient
 	var response ResourceClientCredentials
 	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedRefreshClientCreds, id, err)
+		return nil, apiErrorf(errMsgFailedRefreshClientCreds, id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
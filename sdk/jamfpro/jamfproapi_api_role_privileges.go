@@ -26,7 +26,7 @@ func (c *Client) GetJamfAPIPrivileges() (*ResourceApiRolePrivilegesList, error)
 	var privilegesList ResourceApiRolePrivilegesList
 	resp, err := c.HTTP.DoRequest("GET", uriApiRolePrivileges, nil, &privilegesList)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "API Privileges", err)
+		return nil, apiErrorf(errMsgFailedGet, "API Privileges", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -47,7 +47,7 @@ func (c *Client) GetJamfAPIPrivilegesByName(name string, limit int) (*ResourceAp
 	var privilegesList ResourceApiRolePrivilegesList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &privilegesList)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "API Privilege", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "API Privilege", name, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -38,7 +38,7 @@ func (c *Client) GetJamfAPIRoles(sort_filter string) (*ResponseApiRolesList, err
 
 	resp, err := c.DoPaginatedGet(endpoint, standardPageSize, 0, sort_filter)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "api roles", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "api roles", err)
 	}
 
 	var outStruct ResponseApiRolesList
@@ -62,7 +62,7 @@ func (c *Client) GetJamfApiRoleByID(id string) (*ResourceAPIRole, error) {
 	var ApiRole ResourceAPIRole
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &ApiRole)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "api role", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "api role", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -76,7 +76,7 @@ func (c *Client) GetJamfApiRoleByID(id string) (*ResourceAPIRole, error) {
 func (c *Client) GetJamfApiRoleByName(name string) (*ResourceAPIRole, error) {
 	roles, err := c.GetJamfAPIRoles("")
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "api role", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "api role", err)
 	}
 
 	for _, value := range roles.Results {
@@ -95,7 +95,7 @@ func (c *Client) CreateJamfApiRole(role *ResourceAPIRole) (*ResourceAPIRole, err
 
 	resp, err := c.HTTP.DoRequest("POST", endpoint, role, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "api role", err)
+		return nil, apiErrorf(errMsgFailedCreate, "api role", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -112,7 +112,7 @@ func (c *Client) UpdateJamfApiRoleByID(id string, roleUpdate *ResourceAPIRole) (
 	var updatedRole ResourceAPIRole
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, roleUpdate, &updatedRole)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "api role", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "api role", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -127,14 +127,14 @@ func (c *Client) UpdateJamfApiRoleByName(name string, roleUpdate *ResourceAPIRol
 	target, err := c.GetJamfApiRoleByName(name)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "api role", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "api role", name, err)
 	}
 
 	target_id := target.ID
 	resp, err := c.UpdateJamfApiRoleByID(target_id, roleUpdate)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "api role", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "api role", name, err)
 	}
 
 	return resp, nil
@@ -146,7 +146,7 @@ func (c *Client) DeleteJamfApiRoleByID(id string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "api role", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "api role", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -160,7 +160,7 @@ func (c *Client) DeleteJamfApiRoleByID(id string) error {
 func (c *Client) DeleteJamfApiRoleByName(name string) error {
 	target, err := c.GetJamfApiRoleByName(name)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedGetByName, "api role", name, err)
+		return apiErrorf(errMsgFailedGetByName, "api role", name, err)
 	}
 
 	target_id := target.ID
@@ -168,7 +168,7 @@ func (c *Client) DeleteJamfApiRoleByName(name string) error {
 	err = c.DeleteJamfApiRoleByID(target_id)
 
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "api role", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "api role", name, err)
 	}
 
 	return nil
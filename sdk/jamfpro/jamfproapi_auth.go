@@ -0,0 +1,180 @@
+// jamfproapi_auth.go
+// Jamf Pro Api - Auth
+// api reference: https://developer.jamf.com/jamf-pro/reference/get_v1-auth
+// Jamf Pro API requires the structs to support a JSON data structure.
+// Token acquisition and refresh are handled by the http client; these endpoints let long running services
+// inspect the privileges of their token and revoke it explicitly on shutdown.
+
+package jamfpro
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const uriAuth = "/api/v1/auth"
+
+// Responses
+
+// ResponseAuthDetails represents the account, privileges and sites of the current auth token.
+type ResponseAuthDetails struct {
+	Account            AuthSubsetAccount `json:"account"`
+	Sites              []AuthSubsetSite  `json:"sites"`
+	AuthenticationType string            `json:"authenticationType"`
+}
+
+// ResponseAuthToken represents a token issued by Jamf Pro.
+type ResponseAuthToken struct {
+	Token   string    `json:"token"`
+	Expires time.Time `json:"expires"`
+}
+
+// Subsets & Containers
+
+// AuthSubsetAccount represents the account the current auth token was issued to.
+type AuthSubsetAccount struct {
+	ID               string                `json:"id"`
+	Username         string                `json:"username"`
+	RealName         string                `json:"realName"`
+	Email            string                `json:"email"`
+	Preferences      AuthSubsetPreferences `json:"preferences"`
+	MultiSiteAdmin   bool                  `json:"multiSiteAdmin"`
+	AccessLevel      string                `json:"accessLevel"`
+	PrivilegeSet     string                `json:"privilegeSet"`
+	PrivilegesBySite map[string][]string   `json:"privilegesBySite"`
+	GroupIds         []int                 `json:"groupIds"`
+	CurrentSiteID    int                   `json:"currentSiteId"`
+}
+
+// AuthSubsetPreferences represents the user interface preferences of the account.
+type AuthSubsetPreferences struct {
+	Language             string `json:"language"`
+	DateFormat           string `json:"dateFormat"`
+	Region               string `json:"region"`
+	Timezone             string `json:"timezone"`
+	DisableRelativeDates bool   `json:"disableRelativeDates"`
+}
+
+// AuthSubsetSite represents a site the account has access to.
+type AuthSubsetSite struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Privileges returns the privileges of the account in its current site. When Jamf Pro does not report
+// privileges for the current site, the privileges of every site are returned.
+func (d *ResponseAuthDetails) Privileges() []string {
+	if privileges, ok := d.Account.PrivilegesBySite[strconv.Itoa(d.Account.CurrentSiteID)]; ok {
+		return privileges
+	}
+
+	var all []string
+	for _, privileges := range d.Account.PrivilegesBySite {
+		all = append(all, privileges...)
+	}
+	return mergePrivileges(all, nil)
+}
+
+// MissingPrivileges returns the required privileges the account does not hold.
+func (d *ResponseAuthDetails) MissingPrivileges(required ...string) []string {
+	return subtractPrivileges(mergePrivileges(required, nil), d.Privileges())
+}
+
+// CRUD
+
+// GetAuthDetails retrieves the account, privileges and sites of the auth token used by the client.
+func (c *Client) GetAuthDetails() (*ResponseAuthDetails, error) {
+	endpoint := uriAuth
+
+	var out ResponseAuthDetails
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGet, "auth details", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// GetMissingPrivilegesForMethods checks the auth token against the privileges needed to call the given
+// Client methods and returns the privileges it lacks. Services can call it at startup to fail fast.
+func (c *Client) GetMissingPrivilegesForMethods(methods ...string) ([]string, error) {
+	required, unknown := RequiredPrivilegesForMethods(methods...)
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("no privilege mapping for methods: %v", unknown)
+	}
+
+	details, err := c.GetAuthDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	return details.MissingPrivileges(required...), nil
+}
+
+// KeepAliveAuthToken exchanges the current auth token for a new one with a fresh expiry. Jamf Pro invalidates
+// the previous token, so the client switches to the new token. Only tokens issued for a username and
+// password can be kept alive; clients using API client credentials get an error, their tokens are renewed by
+// the http client requesting a new one.
+//
+// The http client reads its token without synchronisation, so KeepAliveAuthToken must not be called while
+// other requests are running on the same client.
+func (c *Client) KeepAliveAuthToken() (*ResponseAuthToken, error) {
+	handler := c.HTTP.AuthTokenHandler
+	if handler == nil {
+		return nil, fmt.Errorf("http client has no auth token handler")
+	}
+	if handler.AuthMethod == "oauth" {
+		return nil, fmt.Errorf("auth token keep-alive is only available for username and password authentication, api client tokens are renewed by requesting a new token")
+	}
+
+	// Obtain a token first when there is none, as the http client would before a request
+	if _, err := c.rawRequestToken(); err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "auth token keep-alive", err)
+	}
+
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	// The auth token handler requests the keep-alive endpoint and swaps the token under its own lock
+	if err := handler.RefreshToken(c.HTTP.APIHandler, c.rawHTTPClient()); err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "auth token keep-alive", err)
+	}
+
+	return &ResponseAuthToken{Token: handler.Token, Expires: handler.Expires}, nil
+}
+
+// InvalidateAuthToken revokes the auth token used by the client. The client forgets the token, so a later
+// request obtains a new one with the configured credentials.
+//
+// The auth token handler offers no synchronised way to drop a token and the http client reads its token
+// without synchronisation, so InvalidateAuthToken must not be called while other requests are running on the
+// same client, e.g. only once a service has stopped issuing requests during shutdown.
+func (c *Client) InvalidateAuthToken() error {
+	endpoint := uriAuth + "/invalidate-token"
+
+	// The invalidate endpoint returns no body, so only the status code is checked.
+	var out interface{}
+	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, &out)
+	if resp == nil || resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return apiErrorf(errMsgFailedDelete, "auth token", err)
+	}
+
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	// Cleared under the lock of the SDK's own token checks; the next check obtains a new token
+	if c.HTTP.AuthTokenHandler != nil {
+		c.tokenMu.Lock()
+		c.HTTP.AuthTokenHandler.Token = ""
+		c.HTTP.AuthTokenHandler.Expires = time.Time{}
+		c.tokenMu.Unlock()
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+// jamfproapi_branding_images.go
+// Jamf Pro Api - Branding Images
+// api reference: https://developer.jamf.com/jamf-pro/reference/get_v1-branding-images-download-id
+// The download endpoint returns raw image data rather than a JSON data structure.
+
+package jamfpro
+
+import (
+	"bytes"
+	"fmt"
+)
+
+const uriBrandingImages = "/api/v1/branding-images"
+
+// CRUD
+
+// DownloadSelfServiceBrandingImage downloads a Self Service branding image by its ID, e.g. the
+// BrandingHeaderImageId of a macOS Self Service branding configuration.
+func (c *Client) DownloadSelfServiceBrandingImage(id int) ([]byte, error) {
+	endpoint := fmt.Sprintf("%s/download/%d", uriBrandingImages, id)
+
+	var out bytes.Buffer
+	resp, err := c.doRawDownload(endpoint, map[string]string{"Accept": "image/*"}, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "self service branding image", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return out.Bytes(), nil
+}
@@ -70,7 +70,7 @@ func (c *Client) GetBuildings(sort_filter string) (*ResponseBuildingsList, error
 	)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "buildings", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "buildings", err)
 	}
 
 	var out ResponseBuildingsList
@@ -95,7 +95,7 @@ func (c *Client) GetBuildingByID(id string) (*ResourceBuilding, error) {
 	var building ResourceBuilding
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &building)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "building", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "building", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -109,7 +109,7 @@ func (c *Client) GetBuildingByID(id string) (*ResourceBuilding, error) {
 func (c *Client) GetBuildingByName(name string) (*ResourceBuilding, error) {
 	buildings, err := c.GetBuildings("")
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "building", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "building", err)
 	}
 
 	for _, value := range buildings.Results {
@@ -128,7 +128,7 @@ func (c *Client) CreateBuilding(building *ResourceBuilding) (*ResponseBuildingCr
 	var responseBuildingCreate ResponseBuildingCreate
 	resp, err := c.HTTP.DoRequest("POST", endpoint, building, &responseBuildingCreate)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "building", err)
+		return nil, apiErrorf(errMsgFailedCreate, "building", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -145,7 +145,7 @@ func (c *Client) UpdateBuildingByID(id string, buildingUpdate *ResourceBuilding)
 	var updatedBuilding ResourceBuilding
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, buildingUpdate, &updatedBuilding)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "building", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "building", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -160,14 +160,14 @@ func (c *Client) UpdateBuildingByName(name string, buildingUpdate *ResourceBuild
 	target, err := c.GetBuildingByName(name)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "building", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "building", name, err)
 	}
 
 	target_id := target.ID
 	resp, err := c.UpdateBuildingByID(target_id, buildingUpdate)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "building", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "building", name, err)
 	}
 
 	return resp, nil
@@ -180,7 +180,7 @@ func (c *Client) DeleteBuildingByID(id string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "buidling", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "buidling", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -194,13 +194,13 @@ func (c *Client) DeleteBuildingByID(id string) error {
 func (c *Client) DeleteBuildingByName(name string) error {
 	target, err := c.GetBuildingByName(name)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedGetByName, "building", name, err)
+		return apiErrorf(errMsgFailedGetByName, "building", name, err)
 	}
 
 	target_id := target.ID
 	err = c.DeleteBuildingByID(target_id)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "building", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "building", name, err)
 	}
 
 	return nil
@@ -218,7 +218,7 @@ func (c *Client) DeleteMultipleBuildingsByID(ids []string) error {
 
 	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteMultiple, "buildings", ids, err)
+		return apiErrorf(errMsgFailedDeleteMultiple, "buildings", ids, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -235,7 +235,7 @@ func (c *Client) GetBuildingResourceHistoryByID(id, sort_filter string) (*Respon
 
 	resp, err := c.DoPaginatedGet(endpoint, standardPageSize, startingPageNumber, sort_filter)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "building histories", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "building histories", err)
 	}
 
 	var out ResponseBuildingResourceHistoryList
@@ -261,7 +261,7 @@ func (c *Client) CreateBuildingResourceHistoryByID(id string, historyUpdate *Res
 	var updatedHistory ResourceBuildingResourceHistory
 	resp, err := c.HTTP.DoRequest("POST", endpoint, historyUpdate, &updatedHistory)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "building histories", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "building histories", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
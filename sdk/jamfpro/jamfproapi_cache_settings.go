@@ -48,7 +48,7 @@ func (c *Client) GetCacheSettings() (*ResourceCacheSettings, error) {
 	var cacheSettings ResourceCacheSettings
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &cacheSettings)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "cache settings", err)
+		return nil, apiErrorf(errMsgFailedGet, "cache settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -70,7 +70,7 @@ func (c *Client) UpdateCacheSettings(cacheSettingsUpdate *ResourceCacheSettings)
 	var updatedSettings ResourceCacheSettings
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, requestBody, &updatedSettings)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdate, "cache settings", err)
+		return nil, apiErrorf(errMsgFailedUpdate, "cache settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
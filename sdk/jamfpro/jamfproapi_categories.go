@@ -46,7 +46,7 @@ type ResponseCategoryCreateAndUpdate struct {
 func (c *Client) GetCategories(sort_filter string) (*ResponseCategoriesList, error) {
 	resp, err := c.DoPaginatedGet(uriCategories, standardPageSize, startingPageNumber, sort_filter)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "categories", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "categories", err)
 	}
 
 	var out ResponseCategoriesList
@@ -71,7 +71,7 @@ func (c *Client) GetCategoryByID(id string) (*ResourceCategory, error) {
 	var category ResourceCategory
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &category)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "categories", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "categories", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -85,7 +85,7 @@ func (c *Client) GetCategoryByID(id string) (*ResourceCategory, error) {
 func (c *Client) GetCategoryByName(name string) (*ResourceCategory, error) {
 	categories, err := c.GetCategories("")
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "categories", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "categories", err)
 	}
 
 	for _, value := range categories.Results {
@@ -104,7 +104,7 @@ func (c *Client) CreateCategory(category *ResourceCategory) (*ResponseCategoryCr
 	var response ResponseCategoryCreateAndUpdate
 	resp, err := c.HTTP.DoRequest("POST", endpoint, category, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "category", err)
+		return nil, apiErrorf(errMsgFailedCreate, "category", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -121,7 +121,7 @@ func (c *Client) UpdateCategoryByID(id string, categoryUpdate *ResourceCategory)
 	var response ResponseCategoryCreateAndUpdate
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, categoryUpdate, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "category", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "category", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -135,14 +135,14 @@ func (c *Client) UpdateCategoryByID(id string, categoryUpdate *ResourceCategory)
 func (c *Client) UpdateCategoryByName(name string, categoryUpdate *ResourceCategory) (*ResponseCategoryCreateAndUpdate, error) {
 	category, err := c.GetCategoryByName(name)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "category", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "category", name, err)
 	}
 
 	target_id := category.Id
 	resp, err := c.UpdateCategoryByID(target_id, categoryUpdate)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "category", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "category", name, err)
 	}
 
 	return resp, nil
@@ -154,7 +154,7 @@ func (c *Client) DeleteCategoryByID(id string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "category", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "category", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -168,14 +168,14 @@ func (c *Client) DeleteCategoryByID(id string) error {
 func (c *Client) DeleteCategoryByName(name string) error {
 	category, err := c.GetCategoryByName(name)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedGetByName, "category", name, err)
+		return apiErrorf(errMsgFailedGetByName, "category", name, err)
 	}
 
 	target_id := category.Id
 	err = c.DeleteCategoryByID(target_id)
 
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "category", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "category", name, err)
 	}
 
 	return nil
@@ -194,7 +194,7 @@ func (c *Client) DeleteMultipleCategoriesByID(ids []string) error {
 
 	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteMultiple, "categories", ids, err)
+		return apiErrorf(errMsgFailedDeleteMultiple, "categories", ids, err)
 	}
 
 	if resp != nil && resp.Body != nil {
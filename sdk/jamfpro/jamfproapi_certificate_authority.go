@@ -45,7 +45,7 @@ func (c *Client) GetActiveCertificateAuthority() (*ResponseActiveCertificateAuth
 	var certAuth ResponseActiveCertificateAuthority
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &certAuth)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "certificate authority", err)
+		return nil, apiErrorf(errMsgFailedGet, "certificate authority", err)
 	}
 
 	if resp != nil && resp.Body != nil {
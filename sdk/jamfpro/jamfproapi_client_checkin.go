@@ -5,8 +5,6 @@
 
 package jamfpro
 
-import "fmt"
-
 const UriClientCheckinSettings = "/api/v3/check-in"
 
 // Structs
@@ -33,7 +31,7 @@ func (c *Client) GetClientCheckinSettings() (*ResourceClientCheckinSettings, err
 	var out ResourceClientCheckinSettings
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "client checkin settings", err)
+		return nil, apiErrorf(errMsgFailedGet, "client checkin settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -49,7 +47,7 @@ func (c *Client) UpdateClientCheckinSettings(settingsUpdate ResourceClientChecki
 	var out ResourceClientCheckinSettings
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, settingsUpdate, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdate, "client checkin settings", err)
+		return nil, apiErrorf(errMsgFailedUpdate, "client checkin settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
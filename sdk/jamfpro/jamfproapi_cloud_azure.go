@@ -66,7 +66,7 @@ func (c *Client) GetDefaultCloudIdentityProvider() (*ResourceCloudIdpServer, err
 	var defaultCloudIdPServer ResourceCloudIdpServer
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &defaultCloudIdPServer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "Azure Cloud IDP", err)
+		return nil, apiErrorf(errMsgFailedGet, "Azure Cloud IDP", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -83,7 +83,7 @@ func (c *Client) GetCloudIdentityProviderByID(id string) (*ResourceCloudIdp, err
 	var cloudIDP ResourceCloudIdp
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &cloudIDP)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "Azure Cloud IDP", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "Azure Cloud IDP", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -100,7 +100,7 @@ func (c *Client) CreateCloudIdentityProvider(cloudIdP *ResourceCloudIdp) (*Respo
 	var responseCreateCloudIDP ResponseCloudIdpCreate
 	resp, err := c.HTTP.DoRequest("POST", endpoint, cloudIdP, &responseCreateCloudIDP)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "Azure Cloud IDP", err)
+		return nil, apiErrorf(errMsgFailedCreate, "Azure Cloud IDP", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -117,7 +117,7 @@ func (c *Client) UpdateCloudIdentityProviderByID(id string, cloudIdPUpdate *Reso
 	var updatedCloudIDP ResourceCloudIdp
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, cloudIdPUpdate, &updatedCloudIDP)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "Azure Cloud IDP", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "Azure Cloud IDP", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -133,7 +133,7 @@ func (c *Client) DeleteCloudIdentityProviderByID(id string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "Azure Cloud IDP", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "Azure Cloud IDP", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -151,7 +151,7 @@ func (c *Client) GetCloudIdentityProviderDefaultServerMappings() (*CloudIdpServe
 	var defaultMappings CloudIdpServerSubsetCloudIdpServerMappings
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &defaultMappings)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "Azure Cloud IDP Server Mappings", err)
+		return nil, apiErrorf(errMsgFailedGet, "Azure Cloud IDP Server Mappings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
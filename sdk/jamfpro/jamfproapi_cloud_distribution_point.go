@@ -5,10 +5,6 @@
 
 package jamfpro
 
-import (
-	"fmt"
-)
-
 // Responses
 
 const uriCloudDistributionPoint = "/api/v1/cloud-distribution-point"
@@ -20,19 +16,58 @@ type ResponseCloudDistributionPointCreate struct {
 
 // Resource
 
+// ResourceCloudDistributionPoint represents the cloud distribution point of Jamf Pro. CdnType is one of
+// NONE, JAMF_CLOUD, RACKSPACE, AMAZON_S3 or AKAMAI.
+type ResourceCloudDistributionPoint struct {
+	CdnType                 string `json:"cdnType"`
+	Master                  bool   `json:"master"`
+	Username                string `json:"username"`
+	Directory               string `json:"directory"`
+	CdnURL                  string `json:"cdnUrl"`
+	UploadURL               string `json:"uploadUrl"`
+	DownloadURL             string `json:"downloadUrl"`
+	SecondaryAuthRequired   bool   `json:"secondaryAuthRequired"`
+	SecondaryAuthStatusCode int    `json:"secondaryAuthStatusCode"`
+	SecondaryAuthTimeToLive int    `json:"secondaryAuthTimeToLive"`
+	RequireSignedURLs       bool   `json:"requireSignedUrls"`
+	KeyPairID               string `json:"keyPairId"`
+	ExpirationSeconds       int    `json:"expirationSeconds"`
+	HasConnectionSucceeded  bool   `json:"hasConnectionSucceeded"`
+	Message                 string `json:"message"`
+	HasPrivateKey           bool   `json:"hasPrivateKey"`
+}
+
 type ResourceCloudDistributionPointUploadCapability struct {
-	ID   bool `json:"principalDistributionTechnology"`
-	Name bool `json:"directUploadCapable"`
+	PrincipalDistributionTechnology bool `json:"principalDistributionTechnology"`
+	DirectUploadCapable             bool `json:"directUploadCapable"`
+}
+
+// GetCloudDistributionPoint retrieves the cloud distribution point configuration.
+func (c *Client) GetCloudDistributionPoint() (*ResourceCloudDistributionPoint, error) {
+	endpoint := uriCloudDistributionPoint
+
+	var cloudDistributionPoint ResourceCloudDistributionPoint
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &cloudDistributionPoint)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGet, "Cloud Distribution Point", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &cloudDistributionPoint, nil
 }
 
-// GetCloudDistributionPointUploadCapability retrieves the default server configuration for the Cloud Identity Provider.
+// GetCloudDistributionPointUploadCapability retrieves whether packages can be uploaded directly to the cloud
+// distribution point and whether it is the principal distribution technology.
 func (c *Client) GetCloudDistributionPointUploadCapability() (*ResourceCloudDistributionPointUploadCapability, error) {
 	endpoint := uriCloudDistributionPoint + "/upload-capability"
 
 	var cloudDistributionPointUploadCapability ResourceCloudDistributionPointUploadCapability
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &cloudDistributionPointUploadCapability)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "Cloud Distribution Point", err)
+		return nil, apiErrorf(errMsgFailedGet, "Cloud Distribution Point", err)
 	}
 
 	if resp != nil && resp.Body != nil {
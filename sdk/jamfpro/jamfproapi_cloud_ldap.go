@@ -61,7 +61,7 @@ func (c *Client) GetDefaultCloudIdentityProviderDefaultMappings(providerName str
 
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "cloud identity provider default mappings", err)
+		return nil, apiErrorf(errMsgFailedGet, "cloud identity provider default mappings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -5,8 +5,6 @@
 
 package jamfpro
 
-import "fmt"
-
 const uriCloudLdapKeystore = "/api/v1/ldap-keystore/verify"
 
 // Payload
@@ -33,7 +31,7 @@ func (c *Client) ValidateCloudLdapKeystore(payload PayloadCloudLdapVerifyKeystor
 	var out ResponseCloudLdapVerifyKeystore
 	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedValidateCloudLdapKeystore, err)
+		return nil, apiErrorf(errMsgFailedValidateCloudLdapKeystore, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -62,7 +62,7 @@ func (c *Client) GetComputerInventoryCollectionSettings() (*ResourceComputerInve
 	var settings ResourceComputerInventoryCollectionSettings
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &settings)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "computer inventory collection settings", err)
+		return nil, apiErrorf(errMsgFailedGet, "computer inventory collection settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -80,7 +80,7 @@ func (c *Client) UpdateComputerInventoryCollectionSettings(settingsUpdate *Resou
 	var updatedSettings ResourceComputerInventoryCollectionSettings
 	resp, err := c.HTTP.DoRequest("PATCH", endpoint, settingsUpdate, &updatedSettings)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdate, "computer inventory collection settings", err)
+		return nil, apiErrorf(errMsgFailedUpdate, "computer inventory collection settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -97,7 +97,7 @@ func (c *Client) CreateComputerInventoryCollectionSettingsCustomPath(customPath
 	var response ResourceComputerInventoryCollectionSettingsCustomPath
 	resp, err := c.HTTP.DoRequest("POST", endpoint, customPath, &response)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "computer inventory collection settings custom path", err)
+		return nil, apiErrorf(errMsgFailedCreate, "computer inventory collection settings custom path", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -113,7 +113,7 @@ func (c *Client) DeleteComputerInventoryCollectionSettingsCustomPathByID(id stri
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "computer inventory collection settings custom path", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "computer inventory collection settings custom path", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -541,7 +541,7 @@ func (c *Client) GetComputersInventory(sort_filter string) (*ResponseComputerInv
 		"",
 	)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "computers-inventories", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "computers-inventories", err)
 	}
 
 	var out ResponseComputerInventoryList
@@ -567,7 +567,7 @@ func (c *Client) GetComputerInventoryByID(id string) (*ResourceComputerInventory
 	var responseInventory ResourceComputerInventory
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &responseInventory)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "computer inventory", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "computer inventory", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -581,7 +581,7 @@ func (c *Client) GetComputerInventoryByID(id string) (*ResourceComputerInventory
 func (c *Client) GetComputerInventoryByName(name string) (*ResourceComputerInventory, error) {
 	inventories, err := c.GetComputersInventory("")
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "computer inventory", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "computer inventory", err)
 	}
 
 	for _, inventory := range inventories.Results {
@@ -590,7 +590,7 @@ func (c *Client) GetComputerInventoryByName(name string) (*ResourceComputerInven
 		}
 	}
 
-	return nil, fmt.Errorf(errMsgFailedGetByName, "computer inventory", name, err)
+	return nil, apiErrorf(errMsgFailedGetByName, "computer inventory", name, err)
 }
 
 // UpdateComputerInventoryByID updates a specific computer's inventory information by its ID.
@@ -600,7 +600,7 @@ func (c *Client) UpdateComputerInventoryByID(id string, inventoryUpdate *Resourc
 	var updatedInventory ResourceComputerInventory
 	resp, err := c.HTTP.DoRequest("PATCH", endpoint, inventoryUpdate, &updatedInventory)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "computer inventory", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "computer inventory", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -616,7 +616,7 @@ func (c *Client) DeleteComputerInventoryByID(id string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "computer-iventory", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "computer-iventory", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -636,7 +636,7 @@ func (c *Client) GetComputersFileVaultInventory(sort_filter string) (*FileVaultI
 		sort_filter,
 	)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "filevault inventories", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "filevault inventories", err)
 	}
 
 	var out FileVaultInventoryList
@@ -660,7 +660,7 @@ func (c *Client) GetComputerFileVaultInventoryByID(id string) (*FileVaultInvento
 	var fileVaultInventory FileVaultInventory
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &fileVaultInventory)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "file value inventory", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "file value inventory", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -677,7 +677,7 @@ func (c *Client) GetComputerRecoveryLockPasswordByID(id string) (*ResponseRecove
 	var recoveryLockPasswordResponse ResponseRecoveryLockPassword
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &recoveryLockPasswordResponse)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "recovery lock password", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "recovery lock password", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -166,7 +166,7 @@ func (c *Client) GetComputerPrestages(sort_filter string) (*ResponseComputerPres
 	)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "computer prestages", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "computer prestages", err)
 	}
 
 	var out ResponseComputerPrestagesList
@@ -190,7 +190,7 @@ func (c *Client) GetComputerPrestageByID(id string) (*ResourceComputerPrestage,
 	var prestage ResourceComputerPrestage
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &prestage)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "computer prestage", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "computer prestage", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -204,7 +204,7 @@ func (c *Client) GetComputerPrestageByID(id string) (*ResourceComputerPrestage,
 func (c *Client) GetComputerPrestageByName(name string) (*ResourceComputerPrestage, error) {
 	prestages, err := c.GetComputerPrestages("")
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "computer prestages", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "computer prestages", err)
 	}
 
 	for _, value := range prestages.Results {
@@ -223,7 +223,7 @@ func (c *Client) CreateComputerPrestage(prestage *ResourceComputerPrestage) (*Re
 	var creationResponse ResponseComputerPrestageCreate
 	resp, err := c.HTTP.DoRequest("POST", endpoint, prestage, &creationResponse)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "computer prestage", err)
+		return nil, apiErrorf(errMsgFailedCreate, "computer prestage", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -254,14 +254,14 @@ func (c *Client) UpdateComputerPrestageByID(id string, prestageUpdate *ResourceC
 func (c *Client) UpdateComputerPrestageByName(name string, prestageUpdate *ResourceComputerPrestage) (*ResourceComputerPrestage, error) {
 	target, err := c.GetComputerPrestageByName(name)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "computer prestage", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "computer prestage", name, err)
 	}
 
 	target_id := target.ID
 	resp, err := c.UpdateComputerPrestageByID(target_id, prestageUpdate)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "computer prestage", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "computer prestage", name, err)
 	}
 
 	return resp, nil
@@ -273,7 +273,7 @@ func (c *Client) DeleteComputerPrestageByID(id string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "computer prestage", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "computer prestage", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -287,14 +287,14 @@ func (c *Client) DeleteComputerPrestageByID(id string) error {
 func (c *Client) DeleteComputerPrestageByName(name string) error {
 	target, err := c.GetComputerPrestageByName(name)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedPaginatedGet, "computer prestages", err)
+		return apiErrorf(errMsgFailedPaginatedGet, "computer prestages", err)
 	}
 
 	target_id := target.ID
 
 	err = c.DeleteComputerPrestageByID(target_id)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "computer prestage", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "computer prestage", name, err)
 	}
 
 	return nil
@@ -307,7 +307,7 @@ func (c *Client) GetDeviceScopeForComputerPrestageByID(id string) (*ResponseDevi
 	var deviceScope ResponseDeviceScope
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &deviceScope)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "computer prestage scope", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "computer prestage scope", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -5,10 +5,6 @@
 
 package jamfpro
 
-import (
-	"fmt"
-)
-
 const uriConditionalAccess = "/api/v1/conditional-access"
 
 // ResourceConditionalAccessDeviceComplianceStatus represents the resource object.
@@ -23,7 +19,7 @@ func (c *Client) GetConditionalAccessDeviceComplianceFeatureEnablement() (*Resou
 	var defaultCloudIdPServer ResourceConditionalAccessDeviceComplianceStatus
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &defaultCloudIdPServer)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "Conditional Access Device Compliance", err)
+		return nil, apiErrorf(errMsgFailedGet, "Conditional Access Device Compliance", err)
 	}
 
 	if resp != nil && resp.Body != nil {
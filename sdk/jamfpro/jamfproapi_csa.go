@@ -5,8 +5,6 @@
 
 package jamfpro
 
-import "fmt"
-
 const uriCSATokenExchange = "/api/v1/csa/token"
 
 // Structs
@@ -18,13 +16,14 @@ type ResourceCSATokenExchange struct {
 
 // CRUD
 
+// GetCSATokenExchangeInfo retrieves the details of the Cloud Services Connection token exchange.
 func (c *Client) GetCSATokenExchangeInfo() (*ResourceCSATokenExchange, error) {
 	endpoint := uriCSATokenExchange
 	var out ResourceCSATokenExchange
 
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "csa token exchange info", err)
+		return nil, apiErrorf(errMsgFailedGet, "csa token exchange info", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -34,21 +33,22 @@ func (c *Client) GetCSATokenExchangeInfo() (*ResourceCSATokenExchange, error) {
 	return &out, nil
 }
 
-func (c *Client) RefreshCSATokenExchange(username, password string) (*ResourceCSATokenExchange, error) {
+// RefreshCSATokenExchange re-initializes the Cloud Services Connection token exchange with the Jamf Account credentials.
+func (c *Client) RefreshCSATokenExchange(emailAddress, password string) (*ResourceCSATokenExchange, error) {
 	endpoint := uriCSATokenExchange
 	var out ResourceCSATokenExchange
 
 	payload := struct {
-		Username string
-		Password string
+		EmailAddress string `json:"emailAddress"`
+		Password     string `json:"password"`
 	}{
-		Username: username,
-		Password: password,
+		EmailAddress: emailAddress,
+		Password:     password,
 	}
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, payload, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdate, "csa token exchange", err)
+		return nil, apiErrorf(errMsgFailedUpdate, "csa token exchange", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -58,21 +58,22 @@ func (c *Client) RefreshCSATokenExchange(username, password string) (*ResourceCS
 	return &out, nil
 }
 
-func (c *Client) InitializeCSATokenExchange(username, password string) (*ResourceCSATokenExchange, error) {
+// InitializeCSATokenExchange initializes the Cloud Services Connection token exchange with the Jamf Account credentials.
+func (c *Client) InitializeCSATokenExchange(emailAddress, password string) (*ResourceCSATokenExchange, error) {
 	endpoint := uriCSATokenExchange
 	var out ResourceCSATokenExchange
 
 	payload := struct {
-		Username string
-		Password string
+		EmailAddress string `json:"emailAddress"`
+		Password     string `json:"password"`
 	}{
-		Username: username,
-		Password: password,
+		EmailAddress: emailAddress,
+		Password:     password,
 	}
 
 	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdate, "csa token exchange", err)
+		return nil, apiErrorf(errMsgFailedCreate, "csa token exchange", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -82,16 +83,17 @@ func (c *Client) InitializeCSATokenExchange(username, password string) (*Resourc
 	return &out, nil
 }
 
+// DeleteCSATokenExchange deletes the Cloud Services Connection token exchange.
 func (c *Client) DeleteCSATokenExchange() error {
 	endpoint := uriCSATokenExchange
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDelete, "csa token exchange", err)
+		return apiErrorf(errMsgFailedDelete, "csa token exchange", err)
 	}
 
 	if resp.StatusCode != 204 {
-		return fmt.Errorf(errMsgFailedDelete, "csa token exchange", err)
+		return apiErrorf(errMsgFailedDelete, "csa token exchange", err)
 	}
 
 	return nil
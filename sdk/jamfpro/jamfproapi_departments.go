@@ -42,7 +42,7 @@ func (c *Client) GetDepartments(sort_filter string) (*ResponseDepartmentsList, e
 		sort_filter,
 	)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "departments", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "departments", err)
 	}
 
 	var out ResponseDepartmentsList
@@ -67,7 +67,7 @@ func (c *Client) GetDepartmentByID(id string) (*ResourceDepartment, error) {
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "department", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "department", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -81,7 +81,7 @@ func (c *Client) GetDepartmentByID(id string) (*ResourceDepartment, error) {
 func (c *Client) GetDepartmentByName(name string) (*ResourceDepartment, error) {
 	depts, err := c.GetDepartments("")
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "departments", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "departments", err)
 	}
 
 	for _, value := range depts.Results {
@@ -100,7 +100,7 @@ func (c *Client) CreateDepartment(department *ResourceDepartment) (*ResponseDepa
 
 	resp, err := c.HTTP.DoRequest("POST", endpoint, department, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "department", err)
+		return nil, apiErrorf(errMsgFailedCreate, "department", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -118,7 +118,7 @@ func (c *Client) UpdateDepartmentByID(id string, departmentUpdate *ResourceDepar
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, departmentUpdate, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "department", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "department", id, err)
 	}
 
 	if resp != nil {
@@ -133,14 +133,14 @@ func (c *Client) UpdateDepartmentByName(targetName string, departmentUpdate *Res
 	target, err := c.GetDepartmentByName(targetName)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "department", targetName, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "department", targetName, err)
 	}
 
 	target_id := target.ID
 
 	resp, err := c.UpdateDepartmentByID(target_id, departmentUpdate)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "department", targetName, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "department", targetName, err)
 	}
 
 	return resp, nil
@@ -153,7 +153,7 @@ func (c *Client) DeleteDepartmentByID(id string) error {
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 
 	if err != nil || resp.StatusCode != 204 {
-		return fmt.Errorf(errMsgFailedDeleteByID, "department", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "department", id, err)
 	}
 
 	if resp != nil {
@@ -167,14 +167,14 @@ func (c *Client) DeleteDepartmentByID(id string) error {
 func (c *Client) DeleteDepartmentByName(targetName string) error {
 	target, err := c.GetDepartmentByName(targetName)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedGetByName, "department", targetName, err)
+		return apiErrorf(errMsgFailedGetByName, "department", targetName, err)
 	}
 
 	target_id := target.ID
 	err = c.DeleteDepartmentByID(target_id)
 
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "department", targetName, err)
+		return apiErrorf(errMsgFailedDeleteByName, "department", targetName, err)
 	}
 
 	return nil
@@ -5,8 +5,6 @@
 
 package jamfpro
 
-import "fmt"
-
 const uriDeviceCommunicationSettings = "/api/v1/device-communication-settings"
 
 // structs
@@ -28,7 +26,7 @@ func (c *Client) GetDeviceCommunicationSettings() (*ResourceDeviceCommunicationS
 	var out ResourceDeviceCommunicationSettings
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "device communication settings", err)
+		return nil, apiErrorf(errMsgFailedGet, "device communication settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -44,7 +42,7 @@ func (c *Client) UpdateDeviceCommunicationSettings(updatedSettings ResourceDevic
 	var out ResourceDeviceCommunicationSettings
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, updatedSettings, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdate, "device communication settings", err)
+		return nil, apiErrorf(errMsgFailedUpdate, "device communication settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
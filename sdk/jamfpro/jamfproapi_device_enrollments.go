@@ -6,13 +6,21 @@
 package jamfpro
 
 import (
+	"bytes"
 	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
 
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/helpers"
 	"github.com/mitchellh/mapstructure"
 )
 
 const uriDeviceEnrollments = "/api/v1/device-enrollments"
 
+// deviceEnrollmentTokenExpiryLayout is the date format of a device enrollment token expiration date.
+const deviceEnrollmentTokenExpiryLayout = "2006-01-02"
+
 // List
 
 // ResponseDeviceEnrollmentList represents the response for device enrollments list.
@@ -21,6 +29,31 @@ type ResponseDeviceEnrollmentsList struct {
 	Results    []ResourceDeviceEnrollment `json:"results"`
 }
 
+// ResponseDeviceEnrollmentDevicesList represents the devices assigned to a device enrollment instance.
+type ResponseDeviceEnrollmentDevicesList struct {
+	TotalCount int                            `json:"totalCount"`
+	Results    []DeviceEnrollmentSubsetDevice `json:"results"`
+}
+
+// ResponseDeviceEnrollmentHistoryList represents the history entries of a device enrollment instance.
+type ResponseDeviceEnrollmentHistoryList struct {
+	Size    int                               `json:"totalCount"`
+	Results []ResourceDeviceEnrollmentHistory `json:"results"`
+}
+
+// Responses
+
+// ResponseDeviceEnrollmentCreate represents the response structure for creating a device enrollment instance.
+type ResponseDeviceEnrollmentCreate struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+// ResponseDeviceEnrollmentDisown represents the outcome of disowning devices, keyed by serial number.
+type ResponseDeviceEnrollmentDisown struct {
+	Devices map[string]string `json:"devices"`
+}
+
 // Resource
 
 // DeviceEnrollment represents a single device enrollment instance.
@@ -39,6 +72,86 @@ type ResourceDeviceEnrollment struct {
 	TokenExpirationDate   string `json:"tokenExpirationDate"`
 }
 
+// ResourceDeviceEnrollmentToken represents a server token downloaded from Apple Business or School Manager.
+// EncodedToken is the base64 encoded content of the .p7m token file.
+type ResourceDeviceEnrollmentToken struct {
+	TokenFileName string `json:"tokenFileName"`
+	EncodedToken  string `json:"encodedToken"`
+}
+
+// ResourceDeviceEnrollmentHistory represents a single history entry of a device enrollment instance.
+type ResourceDeviceEnrollmentHistory struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Date     string `json:"date"`
+	Note     string `json:"note"`
+	Details  string `json:"details"`
+}
+
+// DeviceEnrollmentTokenExpiry describes when the server token of a device enrollment instance expires.
+type DeviceEnrollmentTokenExpiry struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	ExpirationDate time.Time `json:"expirationDate"`
+	DaysRemaining  int       `json:"daysRemaining"`
+	Expired        bool      `json:"expired"`
+}
+
+// Subsets & Containers
+
+// DeviceEnrollmentSubsetSyncState represents a sync between Jamf Pro and Apple for a device enrollment instance.
+type DeviceEnrollmentSubsetSyncState struct {
+	SyncState  string `json:"syncState"`
+	InstanceID string `json:"instanceId"`
+	Timestamp  string `json:"timestamp"`
+}
+
+// DeviceEnrollmentSubsetDevice represents a device assigned to a device enrollment instance.
+type DeviceEnrollmentSubsetDevice struct {
+	ID                                string                                `json:"id"`
+	DeviceEnrollmentProgramInstanceID string                                `json:"deviceEnrollmentProgramInstanceId"`
+	PrestageID                        string                                `json:"prestageId"`
+	SerialNumber                      string                                `json:"serialNumber"`
+	Description                       string                                `json:"description"`
+	Model                             string                                `json:"model"`
+	Color                             string                                `json:"color"`
+	AssetTag                          string                                `json:"assetTag"`
+	ProfileStatus                     string                                `json:"profileStatus"`
+	SyncState                         DeviceEnrollmentSubsetDeviceSyncState `json:"syncState"`
+	ProfileAssignTime                 string                                `json:"profileAssignTime"`
+	ProfilePushTime                   string                                `json:"profilePushTime"`
+	DeviceAssignedDate                string                                `json:"deviceAssignedDate"`
+}
+
+// DeviceEnrollmentSubsetDeviceSyncState represents the profile sync state of a device assigned to a device enrollment instance.
+type DeviceEnrollmentSubsetDeviceSyncState struct {
+	ID           int    `json:"id"`
+	SerialNumber string `json:"serialNumber"`
+	ProfileUUID  string `json:"profileUUID"`
+	SyncStatus   string `json:"syncStatus"`
+	FailureCount int    `json:"failureCount"`
+	Timestamp    int    `json:"timestamp"`
+}
+
+// TokenExpirationTime parses the server token expiration date returned by Jamf Pro.
+func (d ResourceDeviceEnrollment) TokenExpirationTime() (time.Time, error) {
+	for _, layout := range []string{deviceEnrollmentTokenExpiryLayout, time.RFC3339} {
+		if expiration, err := time.Parse(layout, d.TokenExpirationDate); err == nil {
+			return expiration, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("failed to parse device enrollment token expiration: %s", d.TokenExpirationDate)
+}
+
+// TokenExpiresWithin reports whether the server token expires within the given window from now.
+func (d ResourceDeviceEnrollment) TokenExpiresWithin(window time.Duration) (bool, error) {
+	expiration, err := d.TokenExpirationTime()
+	if err != nil {
+		return false, err
+	}
+	return time.Until(expiration) <= window, nil
+}
+
 // CRUD
 
 // GetDeviceEnrollments retrieves a paginated list of device enrollments.
@@ -50,7 +163,7 @@ func (c *Client) GetDeviceEnrollments(sort_filter string) (*ResponseDeviceEnroll
 		sort_filter,
 	)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "device enrollments", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "device enrollments", err)
 	}
 
 	var out ResponseDeviceEnrollmentsList
@@ -67,3 +180,331 @@ func (c *Client) GetDeviceEnrollments(sort_filter string) (*ResponseDeviceEnroll
 
 	return &out, nil
 }
+
+// GetDeviceEnrollmentByID retrieves a device enrollment instance by its ID.
+func (c *Client) GetDeviceEnrollmentByID(id string) (*ResourceDeviceEnrollment, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriDeviceEnrollments, id)
+
+	var out ResourceDeviceEnrollment
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "device enrollment", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// GetDeviceEnrollmentByName retrieves a device enrollment instance by its name.
+func (c *Client) GetDeviceEnrollmentByName(name string) (*ResourceDeviceEnrollment, error) {
+	enrollments, err := c.GetDeviceEnrollments("")
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "device enrollments", err)
+	}
+
+	for _, value := range enrollments.Results {
+		if value.Name == name {
+			return &value, nil
+		}
+	}
+
+	return nil, fmt.Errorf(errMsgFailedGetByName, "device enrollment", name, errMsgNoName)
+}
+
+// GetDeviceEnrollmentPublicKey retrieves the Jamf Pro public key (PEM) to upload to Apple Business or School Manager
+// when creating a device enrollment server token.
+func (c *Client) GetDeviceEnrollmentPublicKey() ([]byte, error) {
+	endpoint := fmt.Sprintf("%s/public-key", uriDeviceEnrollments)
+
+	var out bytes.Buffer
+	resp, err := c.doRawDownload(endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGet, "device enrollment public key", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return out.Bytes(), nil
+}
+
+// GetDeviceEnrollmentDevicesByID retrieves the devices assigned to a device enrollment instance.
+func (c *Client) GetDeviceEnrollmentDevicesByID(id string) (*ResponseDeviceEnrollmentDevicesList, error) {
+	endpoint := fmt.Sprintf("%s/%s/devices", uriDeviceEnrollments, id)
+
+	var out ResponseDeviceEnrollmentDevicesList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "device enrollment devices", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// GetDeviceEnrollmentsSyncStates retrieves the sync states of all device enrollment instances.
+func (c *Client) GetDeviceEnrollmentsSyncStates() ([]DeviceEnrollmentSubsetSyncState, error) {
+	endpoint := fmt.Sprintf("%s/syncs", uriDeviceEnrollments)
+
+	var out []DeviceEnrollmentSubsetSyncState
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGet, "device enrollment sync states", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return out, nil
+}
+
+// GetDeviceEnrollmentSyncStatesByID retrieves the sync states of a device enrollment instance.
+func (c *Client) GetDeviceEnrollmentSyncStatesByID(id string) ([]DeviceEnrollmentSubsetSyncState, error) {
+	endpoint := fmt.Sprintf("%s/%s/syncs", uriDeviceEnrollments, id)
+
+	var out []DeviceEnrollmentSubsetSyncState
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "device enrollment sync states", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return out, nil
+}
+
+// GetDeviceEnrollmentLatestSyncStateByID retrieves the latest sync state of a device enrollment instance.
+func (c *Client) GetDeviceEnrollmentLatestSyncStateByID(id string) (*DeviceEnrollmentSubsetSyncState, error) {
+	endpoint := fmt.Sprintf("%s/%s/syncs/latest", uriDeviceEnrollments, id)
+
+	var out DeviceEnrollmentSubsetSyncState
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "device enrollment latest sync state", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// CreateDeviceEnrollmentWithToken creates a device enrollment instance from a base64 encoded server token.
+func (c *Client) CreateDeviceEnrollmentWithToken(token ResourceDeviceEnrollmentToken) (*ResponseDeviceEnrollmentCreate, error) {
+	endpoint := fmt.Sprintf("%s/upload-token", uriDeviceEnrollments)
+
+	var out ResponseDeviceEnrollmentCreate
+	resp, err := c.HTTP.DoRequest("POST", endpoint, token, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "device enrollment", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// UpdateDeviceEnrollmentByID updates the metadata of a device enrollment instance by its ID.
+func (c *Client) UpdateDeviceEnrollmentByID(id string, enrollmentUpdate ResourceDeviceEnrollment) (*ResourceDeviceEnrollment, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriDeviceEnrollments, id)
+
+	var out ResourceDeviceEnrollment
+	resp, err := c.HTTP.DoRequest("PUT", endpoint, enrollmentUpdate, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedUpdateByID, "device enrollment", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// UpdateDeviceEnrollmentTokenByID replaces the server token of a device enrollment instance by its ID.
+func (c *Client) UpdateDeviceEnrollmentTokenByID(id string, token ResourceDeviceEnrollmentToken) (*ResourceDeviceEnrollment, error) {
+	endpoint := fmt.Sprintf("%s/%s/upload-token", uriDeviceEnrollments, id)
+
+	var out ResourceDeviceEnrollment
+	resp, err := c.HTTP.DoRequest("PUT", endpoint, token, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedUpdateByID, "device enrollment token", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// DeleteDeviceEnrollmentByID deletes a device enrollment instance by its ID.
+func (c *Client) DeleteDeviceEnrollmentByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriDeviceEnrollments, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return apiErrorf(errMsgFailedDeleteByID, "device enrollment", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// CreateDeviceEnrollmentWithTokenFile creates a device enrollment instance from a .p7m server token file
+// downloaded from Apple Business or School Manager.
+func (c *Client) CreateDeviceEnrollmentWithTokenFile(tokenPath string) (*ResponseDeviceEnrollmentCreate, error) {
+	token, err := newDeviceEnrollmentTokenFromFile(tokenPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateDeviceEnrollmentWithToken(*token)
+}
+
+// RenewDeviceEnrollmentTokenByID renews a device enrollment instance with a new .p7m server token file. The
+// token must be issued for the same MDM server in Apple Business or School Manager.
+func (c *Client) RenewDeviceEnrollmentTokenByID(id string, tokenPath string) (*ResourceDeviceEnrollment, error) {
+	token, err := newDeviceEnrollmentTokenFromFile(tokenPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.UpdateDeviceEnrollmentTokenByID(id, *token)
+}
+
+// GetDeviceEnrollmentHistoryByID retrieves the history of a device enrollment instance with optional sorting.
+func (c *Client) GetDeviceEnrollmentHistoryByID(id, sort_filter string) (*ResponseDeviceEnrollmentHistoryList, error) {
+	endpoint := fmt.Sprintf("%s/%s/history", uriDeviceEnrollments, id)
+
+	resp, err := c.DoPaginatedGet(endpoint, standardPageSize, startingPageNumber, sort_filter)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "device enrollment history", err)
+	}
+
+	var out ResponseDeviceEnrollmentHistoryList
+	out.Size = resp.Size
+
+	for _, value := range resp.Results {
+		var newObj ResourceDeviceEnrollmentHistory
+		err := mapstructure.Decode(value, &newObj)
+		if err != nil {
+			return nil, fmt.Errorf(errMsgFailedMapstruct, "device enrollment history", err)
+		}
+		out.Results = append(out.Results, newObj)
+	}
+
+	return &out, nil
+}
+
+// CreateDeviceEnrollmentHistoryNoteByID adds a note to the history of a device enrollment instance.
+func (c *Client) CreateDeviceEnrollmentHistoryNoteByID(id string, note string) (*ResourceDeviceEnrollmentHistory, error) {
+	endpoint := fmt.Sprintf("%s/%s/history", uriDeviceEnrollments, id)
+
+	payload := struct {
+		Note string `json:"note"`
+	}{
+		Note: note,
+	}
+
+	var out ResourceDeviceEnrollmentHistory
+	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "device enrollment history note", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// DisownDeviceEnrollmentDevicesByID releases devices, by serial number, from the organization in Apple Business
+// or School Manager. This cannot be undone from Jamf Pro. The response reports the outcome for each serial number.
+func (c *Client) DisownDeviceEnrollmentDevicesByID(id string, serialNumbers []string) (*ResponseDeviceEnrollmentDisown, error) {
+	endpoint := fmt.Sprintf("%s/%s/disown", uriDeviceEnrollments, id)
+
+	payload := struct {
+		Devices []string `json:"devices"`
+	}{
+		Devices: serialNumbers,
+	}
+
+	var out ResponseDeviceEnrollmentDisown
+	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedUpdateByID, "device enrollment disown", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// GetDeviceEnrollmentTokensExpiringWithin returns the device enrollment instances whose server token has
+// expired or expires within the given window, soonest first. Apple issues server tokens for one year.
+func (c *Client) GetDeviceEnrollmentTokensExpiringWithin(window time.Duration) ([]DeviceEnrollmentTokenExpiry, error) {
+	enrollments, err := c.GetDeviceEnrollments("")
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "device enrollments", err)
+	}
+
+	var out []DeviceEnrollmentTokenExpiry
+	for _, enrollment := range enrollments.Results {
+		expiring, err := enrollment.TokenExpiresWithin(window)
+		if err != nil {
+			return nil, fmt.Errorf("device enrollment %s: %v", enrollment.ID, err)
+		}
+		if !expiring {
+			continue
+		}
+
+		expiration, _ := enrollment.TokenExpirationTime()
+		remaining := time.Until(expiration)
+		out = append(out, DeviceEnrollmentTokenExpiry{
+			ID:             enrollment.ID,
+			Name:           enrollment.Name,
+			ExpirationDate: expiration,
+			DaysRemaining:  int(remaining.Hours() / 24),
+			Expired:        remaining <= 0,
+		})
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].ExpirationDate.Before(out[j].ExpirationDate)
+	})
+
+	return out, nil
+}
+
+// newDeviceEnrollmentTokenFromFile reads and encodes a .p7m server token file.
+func newDeviceEnrollmentTokenFromFile(tokenPath string) (*ResourceDeviceEnrollmentToken, error) {
+	encodedToken, err := helpers.Base64EncodeServerToken(tokenPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceDeviceEnrollmentToken{
+		TokenFileName: filepath.Base(tokenPath),
+		EncodedToken:  encodedToken,
+	}, nil
+}
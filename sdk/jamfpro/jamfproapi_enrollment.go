@@ -0,0 +1,85 @@
+// jamfproapi_enrollment.go
+// Jamf Pro Api - Enrollment
+// api reference: https://developer.jamf.com/jamf-pro/reference/get_v3-enrollment
+// Jamf Pro API requires the structs to support a JSON data structure.
+// The settings share uriAccountDrivenUserEnrollment with the ADUE access groups.
+
+package jamfpro
+
+// Resource
+
+// ResourceEnrollmentSettings represents the user-initiated enrollment and re-enrollment settings.
+type ResourceEnrollmentSettings struct {
+	InstallSingleProfile                bool                                 `json:"installSingleProfile"`
+	SigningMdmProfileEnabled            bool                                 `json:"signingMdmProfileEnabled"`
+	MdmSigningCertificate               *EnrollmentSettingsSubsetCertificate `json:"mdmSigningCertificate,omitempty"`
+	RestrictReenrollment                bool                                 `json:"restrictReenrollment"`
+	FlushLocationInformation            bool                                 `json:"flushLocationInformation"`
+	FlushLocationHistoryInformation     bool                                 `json:"flushLocationHistoryInformation"`
+	FlushPolicyHistory                  bool                                 `json:"flushPolicyHistory"`
+	FlushExtensionAttributes            bool                                 `json:"flushExtensionAttributes"`
+	FlushMdmCommandsOnReenroll          string                               `json:"flushMdmCommandsOnReenroll"`
+	MacOsEnterpriseEnrollmentEnabled    bool                                 `json:"macOsEnterpriseEnrollmentEnabled"`
+	ManagementUsername                  string                               `json:"managementUsername"`
+	CreateManagementAccount             bool                                 `json:"createManagementAccount"`
+	HideManagementAccount               bool                                 `json:"hideManagementAccount"`
+	AllowSshOnlyManagementAccount       bool                                 `json:"allowSshOnlyManagementAccount"`
+	EnsureSshRunning                    bool                                 `json:"ensureSshRunning"`
+	LaunchSelfService                   bool                                 `json:"launchSelfService"`
+	SignQuickAdd                        bool                                 `json:"signQuickAdd"`
+	DeveloperCertificateIdentity        *EnrollmentSettingsSubsetCertificate `json:"developerCertificateIdentity,omitempty"`
+	DeveloperCertificateIdentityDetails EnrollmentSettingsSubsetCertificate  `json:"developerCertificateIdentityDetails"`
+	MdmSigningCertificateDetails        EnrollmentSettingsSubsetCertificate  `json:"mdmSigningCertificateDetails"`
+	IosEnterpriseEnrollmentEnabled      bool                                 `json:"iosEnterpriseEnrollmentEnabled"`
+	IosPersonalEnrollmentEnabled        bool                                 `json:"iosPersonalEnrollmentEnabled"`
+	PersonalDeviceEnrollmentType        string                               `json:"personalDeviceEnrollmentType"`
+	AccountDrivenUserEnrollmentEnabled  bool                                 `json:"accountDrivenUserEnrollmentEnabled"`
+}
+
+// Subsets & Containers
+
+// EnrollmentSettingsSubsetCertificate represents a signing certificate used during enrollment.
+type EnrollmentSettingsSubsetCertificate struct {
+	Filename         string `json:"filename"`
+	Md5Sum           string `json:"md5Sum"`
+	Subject          string `json:"subject,omitempty"`
+	SerialNumber     string `json:"serialNumber,omitempty"`
+	IdentityKeystore string `json:"identityKeystore,omitempty"`
+	KeystorePassword string `json:"keystorePassword,omitempty"`
+}
+
+// CRUD
+
+// GetEnrollmentSettings retrieves the enrollment and re-enrollment settings.
+func (c *Client) GetEnrollmentSettings() (*ResourceEnrollmentSettings, error) {
+	endpoint := uriAccountDrivenUserEnrollment
+
+	var out ResourceEnrollmentSettings
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGet, "enrollment settings", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// UpdateEnrollmentSettings updates the enrollment and re-enrollment settings.
+func (c *Client) UpdateEnrollmentSettings(settingsUpdate *ResourceEnrollmentSettings) (*ResourceEnrollmentSettings, error) {
+	endpoint := uriAccountDrivenUserEnrollment
+
+	var out ResourceEnrollmentSettings
+	resp, err := c.HTTP.DoRequest("PUT", endpoint, settingsUpdate, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedUpdate, "enrollment settings", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
@@ -65,7 +65,7 @@ func (c *Client) GetEnrollmentCustomizations(sort_filter string) (*ResponseEnrol
 	)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "enrollment customization", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "enrollment customization", err)
 	}
 
 	var out ResponseEnrollmentCustomizationList
@@ -90,7 +90,7 @@ func (c *Client) GetEnrollmentCustomizationByID(id string) (*ResourceEnrollmentC
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "enrollment customization", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "enrollment customization", id, err)
 
 	}
 
@@ -108,7 +108,7 @@ func (c *Client) CreateEnrollmentCustomization(enrollmentCustomization ResourceE
 	resp, err := c.HTTP.DoRequest("POST", endpoint, enrollmentCustomization, &out)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "enrollment customization", err)
+		return nil, apiErrorf(errMsgFailedCreate, "enrollment customization", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -125,7 +125,7 @@ func (c *Client) UpdateEnrollmentCustomizationByID(id string, updatedCustomizati
 	var out ResourceEnrollmentCustomization
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, updatedCustomization, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "enrollment customization settings", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "enrollment customization settings", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -142,7 +142,7 @@ func (c *Client) DeleteEnrollmentCustomizationByID(id string) error {
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "enrollment customization settings", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "enrollment customization settings", id, err)
 	}
 
 	if resp != nil {
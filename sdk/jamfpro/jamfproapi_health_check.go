@@ -1,26 +1,53 @@
 // jamfproapi_health_check.go
 // Jamf Pro Api - Health Check
-// api reference: N/A
+// api reference: https://developer.jamf.com/jamf-pro/reference/get_v1-health-check
 // Jamf Pro API requires the structs to support an JSON data structure.
 
 package jamfpro
 
-import "fmt"
+import (
+	"fmt"
+	"net/http"
+)
 
 const uriHealthCheck = "/api/v1/health-check"
 
-// GetHealthCheck fetches a file list from Jamf Cloud Distribution Service
-func (c *Client) GetHealthCheck() ([]ResponseJCDS2List, error) {
+// Response
+
+// ResponseHealthCheck represents the result of the Jamf Pro health check. Jamf Pro reports health through
+// the status code alone, a 200 means the server is healthy and a 5xx, typically 503, that it is not.
+type ResponseHealthCheck struct {
+	Healthy    bool `json:"healthy"`
+	StatusCode int  `json:"statusCode"`
+}
+
+// CRUD
+
+// GetHealthCheck checks whether the Jamf Pro server reports itself as healthy. Other failures, such as a 401
+// or 403 for the client's credentials, are returned as errors rather than as an unhealthy server.
+func (c *Client) GetHealthCheck() (*ResponseHealthCheck, error) {
 	endpoint := uriHealthCheck
-	var out []ResponseJCDS2List
+
+	// The health check returns no body, so decode errors are ignored when the status code is available.
+	var out interface{}
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
-	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "Health Check", err)
+	if resp == nil {
+		return nil, apiErrorf(errMsgFailedGet, "health check", err)
 	}
 
-	if resp != nil && resp.Body != nil {
+	if resp.Body != nil {
 		defer resp.Body.Close()
 	}
 
-	return out, nil
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return &ResponseHealthCheck{Healthy: true, StatusCode: resp.StatusCode}, nil
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return &ResponseHealthCheck{Healthy: false, StatusCode: resp.StatusCode}, nil
+	}
+
+	if err == nil {
+		err = fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil, apiErrorf(errMsgFailedGet, "health check", err)
 }
@@ -6,14 +6,31 @@
 package jamfpro
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"mime"
+	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 )
 
 const uriUploadIcon = "/api/v1/icon"
 
+// maxIconSize bounds how much of an icon is read into memory for upload.
+const maxIconSize = 10 << 20
+
+// iconSniffLength is the number of bytes content type detection looks at.
+const iconSniffLength = 512
+
+// iconContentTypes are the image types Jamf Pro accepts as icons, with the file extension used for each.
+var iconContentTypes = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+}
+
 // Response
 
 // ResponseUploadIcon is the response structure for uploading icons.
@@ -26,28 +43,87 @@ type ResponseUploadIcon struct {
 
 // UploadIcon uploads an icon file to Jamf Pro and returns the icon URL and ID.
 func (c *Client) UploadIcon(filePath string) (*ResponseUploadIcon, error) {
+	file, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "icon", err)
+	}
+	defer file.Close()
+
+	return c.UploadIconFromReader(filepath.Base(filePath), file)
+}
+
+// UploadIconFromReader uploads an icon read from r to Jamf Pro under the given file name and returns the
+// icon URL and ID. The content must be a PNG, JPEG or GIF image of at most 10 MiB; its type is detected from
+// the content, falling back to the type of the name's extension as described for DetectIconContentType.
+func (c *Client) UploadIconFromReader(name string, r io.Reader) (*ResponseUploadIcon, error) {
 	endpoint := uriUploadIcon
 
-	files := map[string]string{
-		"file": filePath,
+	data, err := io.ReadAll(io.LimitReader(r, maxIconSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read icon %s: %v", name, err)
 	}
-	var uploadResponse ResponseUploadIcon
-
-	resp, err := c.HTTP.DoMultipartRequest("POST", endpoint, nil, files, &uploadResponse)
+	if len(data) > maxIconSize {
+		return nil, fmt.Errorf("icon %s is larger than %d bytes", name, maxIconSize)
+	}
+	contentType, err := iconContentType(data, mime.TypeByExtension(filepath.Ext(name)))
 	if err != nil {
-		return nil, fmt.Errorf("failed to upload icon: %v", err)
+		return nil, fmt.Errorf("icon %s: %v", name, err)
 	}
 
-	if resp != nil && resp.Body != nil {
-		defer resp.Body.Close()
+	files := []rawMultipartFile{{
+		FieldName:   "file",
+		FileName:    name,
+		ContentType: contentType,
+		Reader:      bytes.NewReader(data),
+		Size:        int64(len(data)),
+	}}
+
+	var uploadResponse ResponseUploadIcon
+	_, err = c.doRawMultipartUpload("POST", endpoint, files, &uploadResponse)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "icon", err)
 	}
 
 	return &uploadResponse, nil
 }
 
 // DownloadIcon downloads an icon by its ID from Jamf Pro and saves it to the specified file path.
-// The icon is saved to the path provided in the 'savePath' parameter.
+// The icon is saved to the path provided in the 'savePath' parameter. It is written to savePath with a .part
+// suffix and renamed once complete, so a failed download, or content that is not a supported icon type,
+// leaves no file at savePath.
 func (c *Client) DownloadIcon(iconID int, savePath string, res string, scale string) error {
+	partPath := savePath + ".part"
+	file, err := os.Create(partPath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %v", err)
+	}
+	defer os.Remove(partPath)
+	defer file.Close()
+
+	if _, err := c.DownloadIconTo(iconID, file, res, scale); err != nil {
+		return err
+	}
+
+	err = file.Sync()
+	if err != nil {
+		return fmt.Errorf("failed to sync file: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write file: %v", err)
+	}
+	if err := os.Rename(partPath, savePath); err != nil {
+		return fmt.Errorf("failed to move download to %s: %v", savePath, err)
+	}
+
+	return nil
+}
+
+// DownloadIconTo streams an icon by its ID from Jamf Pro to w and returns its content type. res and scale
+// are optional; res is e.g. "original" or "300", and scale "0" keeps the original size. The content type
+// is detected from the icon data, falling back to the Content-Type of the response as described for
+// DetectIconContentType. An error is returned when it is not a PNG, JPEG or GIF image, after the data has
+// been written to w.
+func (c *Client) DownloadIconTo(iconID int, w io.Writer, res string, scale string) (string, error) {
 	params := url.Values{}
 	if res != "" {
 		params.Add("res", res)
@@ -58,33 +134,70 @@ func (c *Client) DownloadIcon(iconID int, savePath string, res string, scale str
 	queryString := params.Encode()
 	endpoint := fmt.Sprintf("%s/download/%d?%s", uriUploadIcon, iconID, queryString)
 
-	var placeholder struct{}
-
-	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &placeholder)
+	sniffer := &contentSniffer{writer: w}
+	resp, err := c.doRawDownload(endpoint, map[string]string{"Accept": "image/*"}, sniffer)
 	if err != nil {
-		return fmt.Errorf("failed to download icon: %v", err)
+		return "", apiErrorf(errMsgFailedGetByID, "icon", iconID, err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("received non-success status code: %d", resp.StatusCode)
-	}
-
-	file, err := os.Create(savePath)
+	contentType, err := iconContentType(sniffer.head, resp.Header.Get("Content-Type"))
 	if err != nil {
-		return fmt.Errorf("failed to create file: %v", err)
+		return "", fmt.Errorf("icon %d: %v", iconID, err)
 	}
-	defer file.Close()
 
-	_, err = io.Copy(file, resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to write file: %v", err)
+	return contentType, nil
+}
+
+// DetectIconContentType returns the content type of icon data, which must be a PNG, JPEG or GIF image. Only
+// the first 512 bytes are looked at. Uploads and downloads use the same rule: when the data is not recognised,
+// they fall back to the type declared by the extension of the uploaded file name or the Content-Type of the
+// download, as long as that is PNG, JPEG or GIF too.
+func DetectIconContentType(data []byte) (string, error) {
+	return iconContentType(data, "")
+}
+
+// iconContentType detects the content type of icon data, falling back to declared when that is a supported
+// icon type.
+func iconContentType(data []byte, declared string) (string, error) {
+	if len(data) == 0 {
+		return "", fmt.Errorf("icon is empty")
+	}
+	contentType := http.DetectContentType(data)
+	if _, ok := iconContentTypes[contentType]; ok {
+		return contentType, nil
+	}
+	if declared, _, err := mime.ParseMediaType(declared); err == nil {
+		if _, ok := iconContentTypes[declared]; ok {
+			return declared, nil
+		}
 	}
+	return "", fmt.Errorf("content type %s is not a supported icon type, use PNG, JPEG or GIF", contentType)
+}
 
-	err = file.Sync()
-	if err != nil {
-		return fmt.Errorf("failed to sync file: %v", err)
+// IconFileExtension returns the file extension for an icon content type, or ".img" for unknown types.
+func IconFileExtension(contentType string) string {
+	if ext, ok := iconContentTypes[contentType]; ok {
+		return ext
+	}
+	if exts, _ := mime.ExtensionsByType(contentType); len(exts) > 0 {
+		return exts[0]
 	}
+	return ".img"
+}
 
-	return nil
+// contentSniffer passes writes through while keeping the first bytes for content type detection.
+type contentSniffer struct {
+	writer io.Writer
+	head   []byte
+}
+
+// Write implements the io.Writer interface.
+func (s *contentSniffer) Write(p []byte) (int, error) {
+	if remaining := iconSniffLength - len(s.head); remaining > 0 {
+		if remaining > len(p) {
+			remaining = len(p)
+		}
+		s.head = append(s.head, p[:remaining]...)
+	}
+	return s.writer.Write(p)
 }
@@ -0,0 +1,45 @@
+package jamfpro
+
+import "testing"
+
+func TestIconContentType(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	gif := []byte("GIF89a\x01\x00\x01\x00")
+	unknown := []byte("not an image")
+
+	tests := []struct {
+		name     string
+		data     []byte
+		declared string
+		want     string
+		wantErr  bool
+	}{
+		{name: "png", data: png, want: "image/png"},
+		{name: "gif", data: gif, want: "image/gif"},
+		{name: "detected type wins over declared", data: png, declared: "image/jpeg", want: "image/png"},
+		{name: "declared supported type", data: unknown, declared: "image/jpeg", want: "image/jpeg"},
+		{name: "declared type with parameters", data: unknown, declared: "image/png; charset=binary", want: "image/png"},
+		{name: "declared unsupported image type", data: unknown, declared: "image/x-icns", wantErr: true},
+		{name: "declared non image type", data: unknown, declared: "text/html", wantErr: true},
+		{name: "nothing declared", data: unknown, wantErr: true},
+		{name: "empty", declared: "image/png", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := iconContentType(tt.data, tt.declared)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("content type = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,8 +5,6 @@
 
 package jamfpro
 
-import "fmt"
-
 const uriJamfProServerUrl = "/api/v1/jamf-pro-server-url"
 
 // Structs
@@ -25,7 +23,7 @@ func (c *Client) GetJamfProServerUrlSettings() (*ResourceJamfProServerURL, error
 
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "jamf pro server url settings", err)
+		return nil, apiErrorf(errMsgFailedGet, "jamf pro server url settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -42,7 +40,7 @@ func (c *Client) UpdateJamfProServerUrlSettings(updatedSettings ResourceJamfProS
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, updatedSettings, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "jamf pro server url settings", err)
+		return nil, apiErrorf(errMsgFailedGet, "jamf pro server url settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -5,10 +5,6 @@
 
 package jamfpro
 
-import (
-	"fmt"
-)
-
 const uriJamfProtect = "/api/v1/jamf-protect"
 
 // Structs
@@ -32,7 +28,7 @@ func (c *Client) GetJamfProtectIntegrationSettings() (*ResourceJamfProtectIntegr
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "jamf protect integration settings", err)
+		return nil, apiErrorf(errMsgFailedGet, "jamf protect integration settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -49,7 +45,7 @@ func (c *Client) UpdateJamfProtectIntegrationSettings(updatedSettings ResourceJa
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, updatedSettings, &out)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdate, "jamf protect integration settings", err)
+		return nil, apiErrorf(errMsgFailedUpdate, "jamf protect integration settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -65,7 +61,7 @@ func (c *Client) DeleteJamfProtectIntegration() error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDelete, "jamf protect integration", err)
+		return apiErrorf(errMsgFailedDelete, "jamf protect integration", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -15,9 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
-	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/helpers"
 )
 
 const uriJCDS2 = "/api/v1/jcds"
@@ -62,7 +60,7 @@ func (c *Client) GetJCDS2Packages() ([]ResponseJCDS2List, error) {
 	var out []ResponseJCDS2List
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "JCDS 2.0", err)
+		return nil, apiErrorf(errMsgFailedGet, "JCDS 2.0", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -78,7 +76,7 @@ func (c *Client) GetJCDS2Properties() (*JCDS2Properties, error) {
 	var out JCDS2Properties
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "JCDS 2.0", err)
+		return nil, apiErrorf(errMsgFailedGet, "JCDS 2.0", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -95,7 +93,7 @@ func (c *Client) GetJCDS2PackageURIByName(id string) (*ResponseJCDS2File, error)
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "JCDS 2.0", id, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "JCDS 2.0", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -111,7 +109,7 @@ func (c *Client) RenewJCDS2Credentials() (*ResponseJCDS2UploadCredentials, error
 	var out ResponseJCDS2UploadCredentials
 	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "JCDS 2.0", err)
+		return nil, apiErrorf(errMsgFailedGet, "JCDS 2.0", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -122,73 +120,15 @@ func (c *Client) RenewJCDS2Credentials() (*ResponseJCDS2UploadCredentials, error
 }
 
 // CreateJCDS2PackageV2 creates a new file in JCDS 2.0 using AWS SDK v2 without creating package metadata in Jamf Pro.
+// The file is streamed with a multipart upload and always uploaded; use UploadJCDS2PackageFromFile to skip
+// content JCDS 2.0 already holds.
 func (c *Client) CreateJCDS2PackageV2(filePath string) (*ResponseJCDS2File, error) {
-	// Step 1: Obtain AWS credentials for the package upload endpoint
-	var uploadCredentials ResponseJCDS2UploadCredentials
-	resp, err := c.HTTP.DoRequest("POST", uriJCDS2+"/files", nil, &uploadCredentials)
-	if err != nil {
-		return nil, fmt.Errorf("failed to obtain upload credentials: %v", err)
-	}
-	if resp != nil && resp.Body != nil {
-		defer resp.Body.Close()
-	}
-
-	// Validate if we received necessary details
-	if uploadCredentials.Region == "" || uploadCredentials.BucketName == "" || uploadCredentials.Path == "" {
-		return nil, fmt.Errorf("incomplete upload credentials received")
-	}
-
-	// Step 2: Use the obtained credentials to configure AWS SDK v2
-	cfg, err := config.LoadDefaultConfig(context.TODO(),
-		config.WithRegion(uploadCredentials.Region),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(uploadCredentials.AccessKeyID, uploadCredentials.SecretAccessKey, uploadCredentials.SessionToken)),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create AWS config: %v", err)
-	}
-
-	// Create S3 service client
-	s3Client := s3.NewFromConfig(cfg)
-
-	// Step 3: Create an Uploader with the configuration and default options
-	uploader := manager.NewUploader(s3Client)
-
-	// Step 3: Use the secure file reading helper
-	fileReader, fileSize, err := helpers.ReadJCDSPackageTypes(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read package file securely: %v", err)
-	}
-
-	// Create a progress reader
-	progressReader := &ProgressReader{
-		reader:     fileReader,
-		totalBytes: fileSize,
-		progressFn: func(read, total int64, unit string) {
-			fmt.Printf("\rUploaded %d / %d %s (%.2f%%)", read, total, unit, float64(read)/float64(total)*100)
-		},
-	}
-
-	// Create the upload input
-	uploadInput := &s3.PutObjectInput{
-		Bucket: aws.String(uploadCredentials.BucketName),
-		Key:    aws.String(uploadCredentials.Path + filepath.Base(filePath)),
-		Body:   progressReader,
-	}
-
-	// Step 4. Perform the upload
-	_, err = uploader.Upload(context.TODO(), uploadInput)
+	result, err := c.UploadJCDS2PackageFromFile(filePath, &JCDS2UploadOptions{ForceUpload: true})
 	if err != nil {
-		return nil, fmt.Errorf("failed to upload file: %v", err)
-	}
-
-	fmt.Println("\nUpload completed Successfully")
-
-	// Construct the final file upload response
-	finalResponse := &ResponseJCDS2File{
-		URI: fmt.Sprintf("s3://%s/%s%s", uploadCredentials.BucketName, uploadCredentials.Path, filepath.Base(filePath)),
+		return nil, err
 	}
 
-	return finalResponse, nil
+	return &result.File, nil
 }
 
 // DeleteJCDS2PackageV2 deletes an existing file from JCDS 2.0 using AWS SDK v2.
@@ -232,6 +172,5 @@ func (c *Client) DeleteJCDS2PackageV2(filePath string) error {
 		return fmt.Errorf("failed to delete file: %v", err)
 	}
 
-	fmt.Printf("File '%s' successfully deleted from JCDS 2.0.\n", filepath.Base(filePath))
 	return nil
 }
@@ -5,8 +5,6 @@
 
 package jamfpro
 
-import "fmt"
-
 const uriLocalAdminPassword = "/api/v2/local-admin-password"
 
 // Resource
@@ -23,7 +21,7 @@ func (c *Client) GetLocalAdminPasswordSettings() (*ResourceLocalAdminPasswordSet
 	var out ResourceLocalAdminPasswordSettings
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "LAPS settings", err)
+		return nil, apiErrorf(errMsgFailedGet, "LAPS settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -41,7 +39,7 @@ func (c *Client) UpdateLocalAdminPasswordSettings(settings *ResourceLocalAdminPa
 
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, &settings, &handleResponse)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedUpdate, "LAPS settings", err)
+		return apiErrorf(errMsgFailedUpdate, "LAPS settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -118,7 +118,7 @@ func (c *Client) GetManagedSoftwareUpdates() (*ResponseManagedSoftwareUpdateList
 	var updateList ResponseManagedSoftwareUpdateList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &updateList)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "self service settings", err)
+		return nil, apiErrorf(errMsgFailedGet, "self service settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -138,7 +138,7 @@ func (c *Client) GetManagedSoftwareUpdatePlans(sort_filter string) (*ResponseMan
 	)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "managed software update plans", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "managed software update plans", err)
 	}
 
 	var out ResponseManagedSoftwareUpdatePlanList
@@ -181,7 +181,7 @@ func (c *Client) GetManagedSoftwareUpdateFeatureToggle() (*ResourceManagedSoftwa
 	var featureToggle ResourceManagedSoftwareUpdateFeatureToggle
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &featureToggle)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "managed software update feature toggle", err)
+		return nil, apiErrorf(errMsgFailedGet, "managed software update feature toggle", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -0,0 +1,170 @@
+// jamfproapi_mdm_commands.go
+// Jamf Pro Api - MDM Commands
+// api reference: https://developer.jamf.com/jamf-pro/reference/post_v2-mdm-commands
+// Jamf Pro API requires the structs to support a JSON data structure.
+
+package jamfpro
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+)
+
+const (
+	uriMDMCommands        = "/api/v2/mdm/commands"
+	uriMobileDevicesProV2 = "/api/v2/mobile-devices"
+)
+
+// MDM command types
+const (
+	MDMCommandTypeEraseDevice = "ERASE_DEVICE"
+)
+
+// Responses
+
+// ResponseMDMCommandCreate represents a command queued by Jamf Pro.
+type ResponseMDMCommandCreate struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+// Resource
+
+// ResourceMDMCommand represents an MDM command sent to one or more devices, identified by management ID.
+type ResourceMDMCommand struct {
+	ClientData  []MDMCommandSubsetClientData `json:"clientData"`
+	CommandData MDMCommandSubsetCommandData  `json:"commandData"`
+}
+
+// Subsets & Containers
+
+// MDMCommandSubsetClientData identifies a device the command is sent to.
+type MDMCommandSubsetClientData struct {
+	ManagementID string `json:"managementId"`
+}
+
+// MDMCommandSubsetCommandData holds the command type and its options.
+type MDMCommandSubsetCommandData struct {
+	CommandType            string                           `json:"commandType"`
+	PreserveDataPlan       bool                             `json:"preserveDataPlan,omitempty"`
+	DisallowProximitySetup bool                             `json:"disallowProximitySetup,omitempty"`
+	PIN                    string                           `json:"pin,omitempty"`
+	ObliterationBehavior   string                           `json:"obliterationBehavior,omitempty"`
+	ReturnToService        *MDMCommandSubsetReturnToService `json:"returnToService,omitempty"`
+}
+
+// MDMCommandSubsetReturnToService holds the return to service options of an erase command. Profile data is
+// the base64 encoded configuration profile.
+type MDMCommandSubsetReturnToService struct {
+	Enabled         bool   `json:"enabled"`
+	MDMProfileData  string `json:"mdmProfileData,omitempty"`
+	WifiProfileData string `json:"wifiProfileData,omitempty"`
+}
+
+// MobileDeviceEraseOptions are the options of EraseMobileDeviceByID. When ReturnToServiceConfigurationID
+// is set, the Wi-Fi profile of that return to service configuration is sent with the erase command so the
+// device reconnects and re-enrolls after the wipe.
+type MobileDeviceEraseOptions struct {
+	PreserveDataPlan               bool
+	DisallowProximitySetup         bool
+	ReturnToServiceConfigurationID string
+	MDMProfileData                 string
+}
+
+// CRUD
+
+// SendMDMCommand sends an MDM command to the devices in the command's client data.
+func (c *Client) SendMDMCommand(command ResourceMDMCommand) ([]ResponseMDMCommandCreate, error) {
+	endpoint := uriMDMCommands
+
+	var out []ResponseMDMCommandCreate
+	resp, err := c.HTTP.DoRequest("POST", endpoint, command, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "mdm command "+command.CommandData.CommandType, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return out, nil
+}
+
+// EraseMobileDeviceByID sends an erase command to a mobile device, optionally using a return to service configuration.
+func (c *Client) EraseMobileDeviceByID(id int, options MobileDeviceEraseOptions) ([]ResponseMDMCommandCreate, error) {
+	managementID, err := c.getMobileDeviceManagementID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	commandData := MDMCommandSubsetCommandData{
+		CommandType:            MDMCommandTypeEraseDevice,
+		PreserveDataPlan:       options.PreserveDataPlan,
+		DisallowProximitySetup: options.DisallowProximitySetup,
+	}
+
+	if options.ReturnToServiceConfigurationID != "" {
+		returnToService, err := c.buildReturnToServiceCommandData(options.ReturnToServiceConfigurationID)
+		if err != nil {
+			return nil, err
+		}
+		returnToService.MDMProfileData = options.MDMProfileData
+		commandData.ReturnToService = returnToService
+	}
+
+	return c.SendMDMCommand(ResourceMDMCommand{
+		ClientData:  []MDMCommandSubsetClientData{{ManagementID: managementID}},
+		CommandData: commandData,
+	})
+}
+
+// buildReturnToServiceCommandData resolves a return to service configuration into the erase command options,
+// embedding the configured Wi-Fi configuration profile.
+func (c *Client) buildReturnToServiceCommandData(configurationID string) (*MDMCommandSubsetReturnToService, error) {
+	configuration, err := c.GetReturnToServiceConfigurationByID(configurationID)
+	if err != nil {
+		return nil, err
+	}
+
+	returnToService := &MDMCommandSubsetReturnToService{Enabled: true}
+	if configuration.WifiProfileID == "" {
+		return returnToService, nil
+	}
+
+	profileID, err := strconv.Atoi(configuration.WifiProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("return to service configuration %s has invalid wifi profile id: %s", configurationID, configuration.WifiProfileID)
+	}
+
+	profile, err := c.GetMobileDeviceConfigurationProfileByID(profileID)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "return to service wifi profile", profileID, err)
+	}
+
+	returnToService.WifiProfileData = base64.StdEncoding.EncodeToString([]byte(profile.General.Payloads))
+	return returnToService, nil
+}
+
+// getMobileDeviceManagementID retrieves the management ID used to address MDM commands to a mobile device.
+func (c *Client) getMobileDeviceManagementID(id int) (string, error) {
+	endpoint := fmt.Sprintf("%s/%d", uriMobileDevicesProV2, id)
+
+	var out struct {
+		ManagementID string `json:"managementId"`
+	}
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return "", apiErrorf(errMsgFailedGetByID, "mobile device management id", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	if out.ManagementID == "" {
+		return "", fmt.Errorf("mobile device %d has no management id", id)
+	}
+
+	return out.ManagementID, nil
+}
@@ -134,7 +134,7 @@ func (c *Client) GetMobileDevicePrestages(sort_filter string) (*ResponseMobileDe
 	endpoint := uriMobileDevicePrestages
 	resp, err := c.DoPaginatedGet(endpoint, standardPageSize, startingPageNumber, sort_filter)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "mobile device prestages", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "mobile device prestages", err)
 	}
 
 	var out ResponseMobileDevicePrestagesList
@@ -159,7 +159,7 @@ func (c *Client) GetMobileDevicePrestageByID(id string) (*ResourceMobileDevicePr
 
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "mobile device prestage", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "mobile device prestage", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -175,7 +175,7 @@ func (c *Client) CreateMobileDevicePrestage(newPrestage ResourceMobileDevicePres
 	var out ResponseMobileDevicePrestageCreate
 	resp, err := c.HTTP.DoRequest("POST", endpoint, newPrestage, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "mobile device prestage", err)
+		return nil, apiErrorf(errMsgFailedCreate, "mobile device prestage", err)
 	}
 
 	if resp != nil {
@@ -190,7 +190,7 @@ func (c *Client) DeleteMobileDevicePrestageByID(id string) error {
 	endpoint := fmt.Sprintf("%s/%s", uriMobileDevicePrestages, id)
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "mobile device prestage", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "mobile device prestage", id, err)
 	}
 
 	if resp != nil {
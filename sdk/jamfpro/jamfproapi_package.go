@@ -46,7 +46,7 @@ func (c *Client) GetPackageInfoByApplicationV1(application string) (*ResponsePac
 	var out ResponsePackageListV1
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByString, "jamf package", "application name", application, err)
+		return nil, apiErrorf(errMsgFailedGetByString, "jamf package", "application name", application, err)
 	}
 
 	if resp != nil {
@@ -63,7 +63,7 @@ func (c *Client) GetPackageInfoByApplicationV2(application string) (*ResponsePac
 	var out ResponsePackageV2
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByString, "jamf package", "application name", application, err)
+		return nil, apiErrorf(errMsgFailedGetByString, "jamf package", "application name", application, err)
 	}
 
 	if resp != nil {
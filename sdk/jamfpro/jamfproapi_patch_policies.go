@@ -56,7 +56,7 @@ func (c *Client) GetPatchPolicies(sortFilter string) (*ResponsePatchPoliciesList
 	)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "patch policies", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "patch policies", err)
 	}
 
 	var out ResponsePatchPoliciesList
@@ -5,7 +5,10 @@
 
 package jamfpro
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 const uriPatchSoftwareTitleConfigurations = "/api/v2/patch-software-title-configurations/"
 
@@ -17,6 +20,24 @@ type ResponsePatchSoftwareTitleConfigurationList struct {
 	Results []ResourcePatchSoftwareTitleConfiguration
 }
 
+// UnmarshalJSON accepts the plain array returned by the v2 endpoint as well as a results object.
+func (l *ResponsePatchSoftwareTitleConfigurationList) UnmarshalJSON(data []byte) error {
+	var results []ResourcePatchSoftwareTitleConfiguration
+	if err := json.Unmarshal(data, &results); err == nil {
+		l.Results = results
+		return nil
+	}
+
+	var wrapped struct {
+		Results []ResourcePatchSoftwareTitleConfiguration `json:"results"`
+	}
+	if err := json.Unmarshal(data, &wrapped); err != nil {
+		return err
+	}
+	l.Results = wrapped.Results
+	return nil
+}
+
 type ResponsePatchSoftwareTitleConfigurationCreate struct {
 	ID   string `json:"id"`
 	Href string `json:"href"`
@@ -60,7 +81,7 @@ func (c *Client) GetPatchSoftwareTitleConfigurations() (*ResponsePatchSoftwareTi
 	var out ResponsePatchSoftwareTitleConfigurationList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "patch software title configurations", err)
+		return nil, apiErrorf(errMsgFailedGet, "patch software title configurations", err)
 	}
 
 	if resp != nil {
@@ -77,7 +98,7 @@ func (c *Client) GetPatchSoftwareTitleConfigurationById(id string) (*ResourcePat
 	var out ResourcePatchSoftwareTitleConfiguration
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "patch software title configuration", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "patch software title configuration", id, err)
 	}
 
 	if resp != nil {
@@ -91,7 +112,7 @@ func (c *Client) GetPatchSoftwareTitleConfigurationById(id string) (*ResourcePat
 func (c *Client) GetPatchSoftwareTitleConfigurationByName(name string) (*ResourcePatchSoftwareTitleConfiguration, error) {
 	patchSoftwareTitle, err := c.GetPatchSoftwareTitleConfigurations()
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "patch software title configuration", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "patch software title configuration", err)
 	}
 
 	for _, value := range patchSoftwareTitle.Results {
@@ -109,7 +130,7 @@ func (c *Client) CreatePatchSoftwareTitleConfiguration(configuration ResourcePat
 	var out ResponsePatchSoftwareTitleConfigurationCreate
 	resp, err := c.HTTP.DoRequest("POST", endpoint, configuration, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "patch software title configuration", err)
+		return nil, apiErrorf(errMsgFailedCreate, "patch software title configuration", err)
 	}
 
 	if resp != nil {
@@ -125,7 +146,7 @@ func (c *Client) UpdatePatchSoftwareTitleConfigurationById(id string, updatedCon
 	var out ResponsePatchSoftwareTitleConfigurationCreate
 	resp, err := c.HTTP.DoRequest("PATCH", endpoint, updatedConfiguration, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "patch software title configuration", err)
+		return nil, apiErrorf(errMsgFailedCreate, "patch software title configuration", err)
 	}
 
 	if resp != nil {
@@ -140,7 +161,7 @@ func (c *Client) DeletePatchSoftwareTitleConfigurationById(id string) error {
 	endpoint := fmt.Sprintf("%s/%s", uriPatchSoftwareTitleConfigurations, id)
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "patch software title configuration", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "patch software title configuration", id, err)
 	}
 
 	if resp != nil {
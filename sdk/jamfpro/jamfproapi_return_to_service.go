@@ -1,6 +1,6 @@
 // jamfproapi_return_to_service.go
 // Jamf Pro Api - Return to Service
-// api reference: none available
+// api reference: https://developer.jamf.com/jamf-pro/reference/get_v1-return-to-service
 // docs: https://learn.jamf.com/en-US/bundle/technical-articles/page/Return_to_Service.html
 // Jamf Pro Api requires the structs to support an JSON data structure.
 
@@ -12,18 +12,130 @@ import (
 
 const uriReturnToService = "/api/v1/return-to-service"
 
-// GetReturnToService fetches a list of devices that are in the Return to Service state.
-func (c *Client) GetReturnToService() ([]ResponseJCDS2List, error) {
+// List
+
+// ResponseReturnToServiceList represents the structure of the response for the return to service configurations list.
+type ResponseReturnToServiceList struct {
+	TotalCount int                                    `json:"totalCount"`
+	Results    []ResourceReturnToServiceConfiguration `json:"results"`
+}
+
+// Responses
+
+// ResponseReturnToServiceCreate represents the response structure for creating a return to service configuration.
+type ResponseReturnToServiceCreate struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+// Resource
+
+// ResourceReturnToServiceConfiguration represents a return to service configuration. WifiProfileID is the ID
+// of the mobile device configuration profile providing the Wi-Fi payload used to reconnect wiped devices.
+type ResourceReturnToServiceConfiguration struct {
+	ID            string `json:"id,omitempty"`
+	DisplayName   string `json:"displayName"`
+	WifiProfileID string `json:"wifiProfileId"`
+}
+
+// CRUD
+
+// GetReturnToServiceConfigurations retrieves all return to service configurations.
+func (c *Client) GetReturnToServiceConfigurations() (*ResponseReturnToServiceList, error) {
 	endpoint := uriReturnToService
-	var out []ResponseJCDS2List
+
+	var out ResponseReturnToServiceList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGet, "return to service configurations", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// GetReturnToServiceConfigurationByID retrieves a return to service configuration by its ID.
+func (c *Client) GetReturnToServiceConfigurationByID(id string) (*ResourceReturnToServiceConfiguration, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriReturnToService, id)
+
+	var out ResourceReturnToServiceConfiguration
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "Return To Service", err)
+		return nil, apiErrorf(errMsgFailedGetByID, "return to service configuration", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// GetReturnToServiceConfigurationByName retrieves a return to service configuration by its display name.
+func (c *Client) GetReturnToServiceConfigurationByName(name string) (*ResourceReturnToServiceConfiguration, error) {
+	configurations, err := c.GetReturnToServiceConfigurations()
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByName, "return to service configuration", name, err)
+	}
+
+	for _, value := range configurations.Results {
+		if value.DisplayName == name {
+			return &value, nil
+		}
+	}
+
+	return nil, fmt.Errorf(errMsgFailedGetByName, "return to service configuration", name, errMsgNoName)
+}
+
+// CreateReturnToServiceConfiguration creates a new return to service configuration.
+func (c *Client) CreateReturnToServiceConfiguration(configuration ResourceReturnToServiceConfiguration) (*ResponseReturnToServiceCreate, error) {
+	endpoint := uriReturnToService
+
+	var out ResponseReturnToServiceCreate
+	resp, err := c.HTTP.DoRequest("POST", endpoint, configuration, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "return to service configuration", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// UpdateReturnToServiceConfigurationByID updates a return to service configuration by its ID.
+func (c *Client) UpdateReturnToServiceConfigurationByID(id string, configurationUpdate ResourceReturnToServiceConfiguration) (*ResourceReturnToServiceConfiguration, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriReturnToService, id)
+
+	var out ResourceReturnToServiceConfiguration
+	resp, err := c.HTTP.DoRequest("PUT", endpoint, configurationUpdate, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedUpdateByID, "return to service configuration", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// DeleteReturnToServiceConfigurationByID deletes a return to service configuration by its ID.
+func (c *Client) DeleteReturnToServiceConfigurationByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriReturnToService, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return apiErrorf(errMsgFailedDeleteByID, "return to service configuration", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
 		defer resp.Body.Close()
 	}
 
-	return out, nil
+	return nil
 }
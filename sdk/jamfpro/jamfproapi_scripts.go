@@ -64,7 +64,7 @@ func (c *Client) GetScripts(sort_filter string) (*ResponseScriptsList, error) {
 	)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "scripts", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "scripts", err)
 	}
 
 	var out ResponseScriptsList
@@ -90,7 +90,7 @@ func (c *Client) GetScriptByID(id string) (*ResourceScript, error) {
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &script)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "script", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "script", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -104,7 +104,7 @@ func (c *Client) GetScriptByID(id string) (*ResourceScript, error) {
 func (c *Client) GetScriptByName(name string) (*ResourceScript, error) {
 	scripts, err := c.GetScripts("")
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "scripts", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "scripts", err)
 	}
 
 	for _, value := range scripts.Results {
@@ -123,7 +123,7 @@ func (c *Client) CreateScript(script *ResourceScript) (*ResponseScriptCreate, er
 
 	resp, err := c.HTTP.DoRequest("POST", endpoint, script, &ResponseScriptCreate)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedCreate, "script", err)
+		return nil, apiErrorf(errMsgFailedCreate, "script", err)
 	}
 
 	if resp != nil {
@@ -140,7 +140,7 @@ func (c *Client) UpdateScriptByID(id string, scriptUpdate *ResourceScript) (*Res
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, scriptUpdate, &updatedScript)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByID, "script", id, err)
+		return nil, apiErrorf(errMsgFailedUpdateByID, "script", id, err)
 	}
 
 	if resp != nil {
@@ -155,14 +155,14 @@ func (c *Client) UpdateScriptByID(id string, scriptUpdate *ResourceScript) (*Res
 func (c *Client) UpdateScriptByName(name string, scriptUpdate *ResourceScript) (*ResourceScript, error) {
 	target, err := c.GetScriptByName(name)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "script", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "script", name, err)
 	}
 
 	target_id := target.ID
 	resp, err := c.UpdateScriptByID(target_id, scriptUpdate)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "script", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "script", name, err)
 	}
 
 	return resp, nil
@@ -174,7 +174,7 @@ func (c *Client) DeleteScriptByID(id string) error {
 	var response interface{}
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, &response)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "script", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "script", id, err)
 	}
 
 	if resp != nil {
@@ -188,14 +188,14 @@ func (c *Client) DeleteScriptByID(id string) error {
 func (c *Client) DeleteScriptByName(name string) error {
 	target, err := c.GetScriptByName(name)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedGetByName, "script", name, err)
+		return apiErrorf(errMsgFailedGetByName, "script", name, err)
 	}
 
 	target_id := target.ID
 
 	err = c.DeleteScriptByID(target_id)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "script", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "script", name, err)
 	}
 
 	return nil
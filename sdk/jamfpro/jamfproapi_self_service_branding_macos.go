@@ -44,7 +44,7 @@ func (c *Client) GetSelfServiceBrandingMacOS(sort_filter string) (*ResponseSelfS
 		sort_filter,
 	)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "self service branding", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "self service branding", err)
 	}
 
 	var out ResponseSelfServiceBrandingList
@@ -85,7 +85,7 @@ func (c *Client) GetSelfServiceBrandingMacOSByID(id string) (*ResourceSelfServic
 func (c *Client) GetSelfServiceBrandingMacOSByName(name string) (*ResourceSelfServiceBrandingDetail, error) {
 	all_ssbrandings, err := c.GetSelfServiceBrandingMacOS("")
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "self service brandings", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "self service brandings", err)
 	}
 
 	for _, value := range all_ssbrandings.Results {
@@ -135,14 +135,14 @@ func (c *Client) UpdateSelfServiceBrandingMacOSByID(id string, brandingUpdate *R
 func (c *Client) UpdateSelfServiceBrandingMacOSByName(name string, brandingUpdate *ResourceSelfServiceBrandingDetail) (*ResourceSelfServiceBrandingDetail, error) {
 	target, err := c.GetSelfServiceBrandingMacOSByName(name)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "self service branding", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "self service branding", name, err)
 	}
 
 	target_id := target.ID
 	resp, err := c.UpdateSelfServiceBrandingMacOSByID(target_id, brandingUpdate)
 
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "self service branding", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "self service branding", name, err)
 	}
 
 	return resp, nil
@@ -154,7 +154,7 @@ func (c *Client) DeleteSelfServiceBrandingMacOSByID(id string) error {
 
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "self service branding", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "self service branding", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -168,13 +168,13 @@ func (c *Client) DeleteSelfServiceBrandingMacOSByID(id string) error {
 func (c *Client) DeleteSelfServiceBrandingMacOSByName(name string) error {
 	target, err := c.GetSelfServiceBrandingMacOSByName(name)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedGetByName, "self service branding", name, err)
+		return apiErrorf(errMsgFailedGetByName, "self service branding", name, err)
 	}
 
 	target_id := target.ID
 	err = c.DeleteSelfServiceBrandingMacOSByID(target_id)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "self service branding", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "self service branding", name, err)
 	}
 
 	return nil
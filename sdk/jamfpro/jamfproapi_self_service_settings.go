@@ -5,10 +5,6 @@
 
 package jamfpro
 
-import (
-	"fmt"
-)
-
 const uriSelfServiceSettings = "/api/v1/self-service/settings"
 
 // Resource
@@ -47,7 +43,7 @@ func (c *Client) GetSelfServiceSettings() (*ResourceSelfServiceSettings, error)
 	var settings ResourceSelfServiceSettings
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &settings)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "self service settings", err)
+		return nil, apiErrorf(errMsgFailedGet, "self service settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -64,7 +60,7 @@ func (c *Client) UpdateSelfServiceSettings(settingsUpdate *ResourceSelfServiceSe
 	var updatedSettings ResourceSelfServiceSettings
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, settingsUpdate, &updatedSettings)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdate, "self service settings", err)
+		return nil, apiErrorf(errMsgFailedUpdate, "self service settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -5,4 +5,275 @@
 
 package jamfpro
 
-// TODO - Awaiting some investigation around what this endpoint actually returns (json or cert or ???)
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/helpers"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+const uriSSOCertificate = "/api/v2/sso/cert"
+
+// SSO keystore types and setup types
+const (
+	SSOKeystoreTypePKCS12         = "PKCS12"
+	SSOKeystoreSetupTypeUploaded  = "UPLOADED"
+	SSOKeystoreSetupTypeGenerated = "GENERATED"
+)
+
+// ssoKeystoreDetailsExpiryLayout is the date format of the keystore certificate expiration.
+const ssoKeystoreDetailsExpiryLayout = "2006-01-02T15:04:05.000Z"
+
+// Responses
+
+// ResponseSSOKeystoreWithDetails represents the current SSO keystore together with its certificate details.
+type ResponseSSOKeystoreWithDetails struct {
+	Keystore        ResourceSSOKeystore      `json:"keystore"`
+	KeystoreDetails SSOKeystoreSubsetDetails `json:"keystoreDetails"`
+}
+
+// ResponseSSOKeystoreParse represents the result of parsing a keystore with Jamf Pro.
+type ResponseSSOKeystoreParse struct {
+	Keys []SSOKeystoreSubsetKey `json:"keys"`
+	Type string                 `json:"type"`
+}
+
+// Resource
+
+// ResourceSSOKeystore represents the SSO keystore used to sign SAML requests. KeystoreFile holds the
+// base64 encoded keystore and is only sent when uploading.
+type ResourceSSOKeystore struct {
+	Key               string                 `json:"key"`
+	Keys              []SSOKeystoreSubsetKey `json:"keys"`
+	Type              string                 `json:"type"`
+	KeystoreSetupType string                 `json:"keystoreSetupType"`
+	KeystoreFileName  string                 `json:"keystoreFileName"`
+	KeystoreFile      string                 `json:"keystoreFile,omitempty"`
+	KeystorePassword  string                 `json:"keystorePassword,omitempty"`
+	KeyPassword       string                 `json:"keyPassword,omitempty"`
+}
+
+// ResourceSSOKeystoreParse represents the payload sent to Jamf Pro to parse a keystore before uploading it.
+type ResourceSSOKeystoreParse struct {
+	KeystorePassword string `json:"keystorePassword"`
+	KeystoreFile     string `json:"keystoreFile"`
+	KeystoreFileName string `json:"keystoreFileName"`
+}
+
+// Subsets & Containers
+
+// SSOKeystoreSubsetKey represents a key alias within the keystore.
+type SSOKeystoreSubsetKey struct {
+	ID    string `json:"id"`
+	Valid bool   `json:"valid"`
+}
+
+// SSOKeystoreSubsetDetails represents the certificate details of the keystore.
+type SSOKeystoreSubsetDetails struct {
+	Keys         []string `json:"keys"`
+	SerialNumber int      `json:"serialNumber"`
+	Subject      string   `json:"subject"`
+	Issuer       string   `json:"issuer"`
+	Expiration   string   `json:"expiration"`
+}
+
+// ExpirationTime parses the certificate expiration date returned by Jamf Pro.
+func (d SSOKeystoreSubsetDetails) ExpirationTime() (time.Time, error) {
+	for _, layout := range []string{ssoKeystoreDetailsExpiryLayout, time.RFC3339} {
+		if expiration, err := time.Parse(layout, d.Expiration); err == nil {
+			return expiration, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("failed to parse sso certificate expiration: %s", d.Expiration)
+}
+
+// ExpiresWithin reports whether the certificate expires within the given window from now.
+func (d SSOKeystoreSubsetDetails) ExpiresWithin(window time.Duration) (bool, error) {
+	expiration, err := d.ExpirationTime()
+	if err != nil {
+		return false, err
+	}
+	return time.Until(expiration) <= window, nil
+}
+
+// CRUD
+
+// GetSSOCertificate retrieves the current SSO keystore and its certificate details.
+func (c *Client) GetSSOCertificate() (*ResponseSSOKeystoreWithDetails, error) {
+	endpoint := uriSSOCertificate
+
+	var out ResponseSSOKeystoreWithDetails
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGet, "sso certificate", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// RegenerateSSOCertificate replaces the SSO keystore with a new certificate generated by Jamf Pro.
+func (c *Client) RegenerateSSOCertificate() (*ResourceSSOKeystore, error) {
+	endpoint := uriSSOCertificate
+
+	var out ResourceSSOKeystore
+	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "sso certificate", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// UpdateSSOCertificate replaces the SSO keystore with the keystore provided in the payload.
+func (c *Client) UpdateSSOCertificate(keystore ResourceSSOKeystore) (*ResourceSSOKeystore, error) {
+	endpoint := uriSSOCertificate
+
+	var out ResourceSSOKeystore
+	resp, err := c.HTTP.DoRequest("PUT", endpoint, keystore, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedUpdate, "sso certificate", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// UploadSSOCertificateKeystore uploads a PKCS12 keystore (.p12 or .pfx) as the SSO keystore. keyPassword
+// protects the private key and is usually, but not necessarily, the same as keystorePassword. The keystore is
+// parsed locally to check the password and certificate validity, and by Jamf Pro to select the first valid
+// key alias, before it replaces the current keystore. Keystores using algorithms the local parser does not
+// support, or whose key password differs from the keystore password, are left for Jamf Pro to validate.
+func (c *Client) UploadSSOCertificateKeystore(keystorePath, keystorePassword, keyPassword string) (*ResourceSSOKeystore, error) {
+	if err := checkSSOKeystore(keystorePath, keystorePassword, keyPassword); err != nil {
+		return nil, err
+	}
+
+	keystoreFile, err := helpers.Base64EncodeKeystore(keystorePath)
+	if err != nil {
+		return nil, err
+	}
+	keystoreFileName := filepath.Base(keystorePath)
+
+	parsed, err := c.ParseSSOCertificateKeystore(ResourceSSOKeystoreParse{
+		KeystorePassword: keystorePassword,
+		KeystoreFile:     keystoreFile,
+		KeystoreFileName: keystoreFileName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var key string
+	for _, k := range parsed.Keys {
+		if k.Valid {
+			key = k.ID
+			break
+		}
+	}
+	if key == "" {
+		return nil, fmt.Errorf("sso keystore %s contains no valid keys", keystoreFileName)
+	}
+
+	return c.UpdateSSOCertificate(ResourceSSOKeystore{
+		Key:               key,
+		Keys:              parsed.Keys,
+		Type:              SSOKeystoreTypePKCS12,
+		KeystoreSetupType: SSOKeystoreSetupTypeUploaded,
+		KeystoreFileName:  keystoreFileName,
+		KeystoreFile:      keystoreFile,
+		KeystorePassword:  keystorePassword,
+		KeyPassword:       keyPassword,
+	})
+}
+
+// DeleteSSOCertificate deletes the current SSO keystore.
+func (c *Client) DeleteSSOCertificate() error {
+	endpoint := uriSSOCertificate
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return apiErrorf(errMsgFailedDelete, "sso certificate", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DownloadSSOCertificate downloads the public certificate of the SSO keystore, e.g. to provide to an identity provider.
+func (c *Client) DownloadSSOCertificate() ([]byte, error) {
+	endpoint := uriSSOCertificate + "/download"
+
+	var out bytes.Buffer
+	resp, err := c.doRawDownload(endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGet, "sso certificate download", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return out.Bytes(), nil
+}
+
+// ParseSSOCertificateKeystore asks Jamf Pro to parse a base64 encoded keystore and return its key aliases.
+func (c *Client) ParseSSOCertificateKeystore(payload ResourceSSOKeystoreParse) (*ResponseSSOKeystoreParse, error) {
+	endpoint := uriSSOCertificate + "/parse"
+
+	var out ResponseSSOKeystoreParse
+	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, &out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sso certificate keystore, error: %v", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// checkSSOKeystore checks the password and certificate validity of a keystore before it is uploaded. The
+// local parser decrypts the private key with the keystore password, so when the key has its own password
+// only the keystore password, which the keystore integrity check verifies first, is checked here.
+func checkSSOKeystore(keystorePath, keystorePassword, keyPassword string) error {
+	data, err := os.ReadFile(keystorePath)
+	if err != nil {
+		return fmt.Errorf("failed to read keystore %s, error: %v", keystorePath, err)
+	}
+
+	metadata, err := ParsePKCS12KeystoreMetadata(data, keystorePassword)
+	var notImplemented pkcs12.NotImplementedError
+	switch {
+	case errors.As(err, &notImplemented):
+		// Jamf Pro's parse decides whether the keystore is usable
+		return nil
+	case err != nil && keyPassword != keystorePassword && !errors.Is(err, pkcs12.ErrIncorrectPassword):
+		// The keystore password is correct; the key password is checked by Jamf Pro
+		return nil
+	case err != nil:
+		return err
+	case time.Now().After(metadata.NotAfter):
+		return fmt.Errorf("sso keystore certificate %s expired on %s", metadata.Subject, metadata.NotAfter.Format(time.RFC3339))
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package jamfpro
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func TestCheckSSOKeystore(t *testing.T) {
+	valid, validKey := newTestCertificate(t, "sso.example.com", false, time.Now().Add(30*24*time.Hour), nil, nil)
+	expired, expiredKey := newTestCertificate(t, "expired.example.com", false, time.Now().Add(-time.Hour), nil, nil)
+
+	tests := []struct {
+		name             string
+		keystore         []byte
+		keystorePassword string
+		keyPassword      string
+		wantErr          string
+	}{
+		{
+			name:             "shared password",
+			keystore:         encodeTestKeystore(t, pkcs12.Modern, validKey, valid, nil, "store"),
+			keystorePassword: "store",
+			keyPassword:      "store",
+		},
+		{
+			name:             "wrong keystore password",
+			keystore:         encodeTestKeystore(t, pkcs12.Modern, validKey, valid, nil, "store"),
+			keystorePassword: "wrong",
+			keyPassword:      "wrong",
+			wantErr:          "password incorrect",
+		},
+		{
+			name:             "expired certificate",
+			keystore:         encodeTestKeystore(t, pkcs12.Modern, expiredKey, expired, nil, "store"),
+			keystorePassword: "store",
+			keyPassword:      "store",
+			wantErr:          "expired",
+		},
+		{
+			name:             "separate key password",
+			keystore:         encodeTestKeystoreWithKeyPassword(t, validKey, valid, "store", "key"),
+			keystorePassword: "store",
+			keyPassword:      "key",
+		},
+		{
+			name:             "separate key password with wrong keystore password",
+			keystore:         encodeTestKeystoreWithKeyPassword(t, validKey, valid, "store", "key"),
+			keystorePassword: "wrong",
+			keyPassword:      "key",
+			wantErr:          "password incorrect",
+		},
+		{
+			name:             "separate key password not given",
+			keystore:         encodeTestKeystoreWithKeyPassword(t, validKey, valid, "store", "key"),
+			keystorePassword: "store",
+			keyPassword:      "store",
+			// Decrypting with the wrong password occasionally yields valid padding, so the key then fails
+			// to unmarshal rather than to decrypt.
+			wantErr: "failed to decode pkcs12 keystore",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkSSOKeystore(writeTestKeystore(t, tt.keystore), tt.keystorePassword, tt.keyPassword)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected an error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -5,8 +5,6 @@
 
 package jamfpro
 
-import "fmt"
-
 const uriSsoSettings = "/api/v2/sso"
 const uriSsoDependencies = "/api/v2/sso/dependencies"
 
@@ -70,7 +68,7 @@ func (c *Client) GetSsoSettings() (*ResourceSsoSettings, error) {
 	var out ResourceSsoSettings
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "sso settings", err)
+		return nil, apiErrorf(errMsgFailedGet, "sso settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -86,7 +84,7 @@ func (c *Client) UpdateSsoSettings(updatedSettings ResourceSsoSettings) (*Resour
 	var out ResourceSsoSettings
 	resp, err := c.HTTP.DoRequest("PUT", endpoint, updatedSettings, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdate, "sso settings", err)
+		return nil, apiErrorf(errMsgFailedUpdate, "sso settings", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -102,7 +100,7 @@ func (c *Client) GetSsoEnrollmentCustomizationDependencies() (*ResponseSsoSubset
 	var out ResponseSsoSubsetEnrollmentCustomizationDependencyList
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGet, "sso enrollment customization dependencies", err)
+		return nil, apiErrorf(errMsgFailedGet, "sso enrollment customization dependencies", err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -0,0 +1,43 @@
+// jamfproapi_startup_status.go
+// Jamf Pro Api - Startup Status
+// api reference: https://developer.jamf.com/jamf-pro/reference/get_startup-status
+// Jamf Pro API requires the structs to support a JSON data structure.
+
+package jamfpro
+
+const uriStartupStatus = "/api/startup-status"
+
+// Response
+
+// ResponseStartupStatus represents the startup progress of the Jamf Pro server.
+type ResponseStartupStatus struct {
+	Step                    string `json:"step"`
+	StepCode                string `json:"stepCode"`
+	StepParam               string `json:"stepParam"`
+	Percentage              int    `json:"percentage"`
+	Warning                 string `json:"warning"`
+	WarningCode             string `json:"warningCode"`
+	WarningParam            string `json:"warningParam"`
+	Error                   string `json:"error"`
+	ErrorCode               string `json:"errorCode"`
+	SetupAssistantNecessary bool   `json:"setupAssistantNecessary"`
+}
+
+// CRUD
+
+// GetStartupStatus retrieves the startup status of the Jamf Pro server.
+func (c *Client) GetStartupStatus() (*ResponseStartupStatus, error) {
+	endpoint := uriStartupStatus
+
+	var out ResponseStartupStatus
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGet, "startup status", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
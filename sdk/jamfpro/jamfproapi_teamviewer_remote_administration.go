@@ -0,0 +1,268 @@
+// jamfproapi_teamviewer_remote_administration.go
+// Jamf Pro Api - TeamViewer Remote Administration
+// api reference: https://developer.jamf.com/jamf-pro/reference/get_preview-remote-administration-configurations-team-viewer
+// Jamf Pro API requires the structs to support a JSON data structure.
+
+package jamfpro
+
+import (
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+const uriTeamViewerRemoteAdministration = "/api/preview/remote-administration-configurations/team-viewer"
+
+// List
+
+type ResponseTeamViewerRemoteAdministrationConfigurationsList struct {
+	TotalCount int                                                   `json:"totalCount"`
+	Results    []ResourceTeamViewerRemoteAdministrationConfiguration `json:"results"`
+}
+
+// Responses
+
+// ResponseTeamViewerRemoteAdministrationCreate represents the response structure for creating a configuration.
+type ResponseTeamViewerRemoteAdministrationCreate struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+// ResponseTeamViewerRemoteAdministrationStatus represents the connection status of a configuration.
+type ResponseTeamViewerRemoteAdministrationStatus struct {
+	ConnectionVerificationResult string `json:"connectionVerificationResult"`
+}
+
+// ResponseTeamViewerSessionCreate represents the response structure for creating a session.
+type ResponseTeamViewerSessionCreate struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+// ResponseTeamViewerSessionStatus represents the state of a session.
+type ResponseTeamViewerSessionStatus struct {
+	SessionState string `json:"sessionState"`
+	Online       bool   `json:"online"`
+}
+
+// Resource
+
+// ResourceTeamViewerRemoteAdministrationConfiguration represents a TeamViewer connection. The script token
+// is write only and is never returned by Jamf Pro.
+type ResourceTeamViewerRemoteAdministrationConfiguration struct {
+	ID             string `json:"id,omitempty"`
+	SiteID         string `json:"siteId,omitempty"`
+	DisplayName    string `json:"displayName"`
+	ScriptToken    string `json:"scriptToken,omitempty"`
+	Enabled        bool   `json:"enabled"`
+	SessionTimeout int    `json:"sessionTimeout"`
+}
+
+// ResourceTeamViewerSession represents a remote administration session with a device.
+type ResourceTeamViewerSession struct {
+	ID            string `json:"id,omitempty"`
+	Code          string `json:"code,omitempty"`
+	Description   string `json:"description"`
+	SupporterLink string `json:"supporterLink,omitempty"`
+	EndUserLink   string `json:"endUserLink,omitempty"`
+	DeviceID      string `json:"deviceId"`
+	DeviceName    string `json:"deviceName,omitempty"`
+	DeviceType    string `json:"deviceType"`
+	State         string `json:"state,omitempty"`
+	CreatorID     string `json:"creatorId,omitempty"`
+	CreatorName   string `json:"creatorName,omitempty"`
+	CreatedAt     string `json:"createdAt,omitempty"`
+}
+
+// CRUD
+
+// GetTeamViewerRemoteAdministrationConfigurations retrieves all TeamViewer remote administration configurations.
+func (c *Client) GetTeamViewerRemoteAdministrationConfigurations(sort_filter string) (*ResponseTeamViewerRemoteAdministrationConfigurationsList, error) {
+	resp, err := c.DoPaginatedGet(uriTeamViewerRemoteAdministration, standardPageSize, startingPageNumber, sort_filter)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "teamviewer remote administration configurations", err)
+	}
+
+	var out ResponseTeamViewerRemoteAdministrationConfigurationsList
+	out.TotalCount = resp.Size
+
+	for _, value := range resp.Results {
+		var newObj ResourceTeamViewerRemoteAdministrationConfiguration
+		err := mapstructure.Decode(value, &newObj)
+		if err != nil {
+			return nil, fmt.Errorf(errMsgFailedMapstruct, "teamviewer remote administration configuration", err)
+		}
+		out.Results = append(out.Results, newObj)
+	}
+
+	return &out, nil
+}
+
+// GetTeamViewerRemoteAdministrationConfigurationByID retrieves a TeamViewer remote administration configuration by its ID.
+func (c *Client) GetTeamViewerRemoteAdministrationConfigurationByID(id string) (*ResourceTeamViewerRemoteAdministrationConfiguration, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriTeamViewerRemoteAdministration, id)
+
+	var out ResourceTeamViewerRemoteAdministrationConfiguration
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "teamviewer remote administration configuration", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// GetTeamViewerRemoteAdministrationConfigurationByName retrieves a TeamViewer remote administration configuration by its display name.
+func (c *Client) GetTeamViewerRemoteAdministrationConfigurationByName(name string) (*ResourceTeamViewerRemoteAdministrationConfiguration, error) {
+	configurations, err := c.GetTeamViewerRemoteAdministrationConfigurations("")
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "teamviewer remote administration configurations", err)
+	}
+
+	for _, value := range configurations.Results {
+		if value.DisplayName == name {
+			return &value, nil
+		}
+	}
+
+	return nil, fmt.Errorf(errMsgFailedGetByName, "teamviewer remote administration configuration", name, errMsgNoName)
+}
+
+// CreateTeamViewerRemoteAdministrationConfiguration creates a TeamViewer remote administration configuration.
+func (c *Client) CreateTeamViewerRemoteAdministrationConfiguration(configuration ResourceTeamViewerRemoteAdministrationConfiguration) (*ResponseTeamViewerRemoteAdministrationCreate, error) {
+	endpoint := uriTeamViewerRemoteAdministration
+
+	var out ResponseTeamViewerRemoteAdministrationCreate
+	resp, err := c.HTTP.DoRequest("POST", endpoint, configuration, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "teamviewer remote administration configuration", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// UpdateTeamViewerRemoteAdministrationConfigurationByID updates a TeamViewer remote administration configuration by its ID.
+func (c *Client) UpdateTeamViewerRemoteAdministrationConfigurationByID(id string, configurationUpdate ResourceTeamViewerRemoteAdministrationConfiguration) (*ResourceTeamViewerRemoteAdministrationConfiguration, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriTeamViewerRemoteAdministration, id)
+
+	var out ResourceTeamViewerRemoteAdministrationConfiguration
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, configurationUpdate, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedUpdateByID, "teamviewer remote administration configuration", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// DeleteTeamViewerRemoteAdministrationConfigurationByID deletes a TeamViewer remote administration configuration by its ID.
+func (c *Client) DeleteTeamViewerRemoteAdministrationConfigurationByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", uriTeamViewerRemoteAdministration, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return apiErrorf(errMsgFailedDeleteByID, "teamviewer remote administration configuration", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// GetTeamViewerRemoteAdministrationStatusByID retrieves the connection status of a TeamViewer remote administration configuration.
+func (c *Client) GetTeamViewerRemoteAdministrationStatusByID(id string) (*ResponseTeamViewerRemoteAdministrationStatus, error) {
+	endpoint := fmt.Sprintf("%s/%s/status", uriTeamViewerRemoteAdministration, id)
+
+	var out ResponseTeamViewerRemoteAdministrationStatus
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "teamviewer remote administration status", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// CreateTeamViewerSession creates a remote administration session with a device using the given configuration.
+func (c *Client) CreateTeamViewerSession(configurationID string, session ResourceTeamViewerSession) (*ResponseTeamViewerSessionCreate, error) {
+	endpoint := fmt.Sprintf("%s/%s/sessions", uriTeamViewerRemoteAdministration, configurationID)
+
+	var out ResponseTeamViewerSessionCreate
+	resp, err := c.HTTP.DoRequest("POST", endpoint, session, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "teamviewer session", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// GetTeamViewerSessionByID retrieves a remote administration session by its ID.
+func (c *Client) GetTeamViewerSessionByID(configurationID, sessionID string) (*ResourceTeamViewerSession, error) {
+	endpoint := fmt.Sprintf("%s/%s/sessions/%s", uriTeamViewerRemoteAdministration, configurationID, sessionID)
+
+	var out ResourceTeamViewerSession
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "teamviewer session", sessionID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// GetTeamViewerSessionStatusByID retrieves the state of a remote administration session.
+func (c *Client) GetTeamViewerSessionStatusByID(configurationID, sessionID string) (*ResponseTeamViewerSessionStatus, error) {
+	endpoint := fmt.Sprintf("%s/%s/sessions/%s/status", uriTeamViewerRemoteAdministration, configurationID, sessionID)
+
+	var out ResponseTeamViewerSessionStatus
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "teamviewer session status", sessionID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// CloseTeamViewerSessionByID closes a remote administration session.
+func (c *Client) CloseTeamViewerSessionByID(configurationID, sessionID string) error {
+	endpoint := fmt.Sprintf("%s/%s/sessions/%s/close", uriTeamViewerRemoteAdministration, configurationID, sessionID)
+
+	// The close endpoint returns no body, so only the status code is checked.
+	var out interface{}
+	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, &out)
+	if resp == nil || resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return apiErrorf(errMsgFailedUpdateByID, "teamviewer session", sessionID, err)
+	}
+
+	if resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
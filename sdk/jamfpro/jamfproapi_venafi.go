@@ -5,4 +5,319 @@
 
 package jamfpro
 
-// TODO
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+const uriVenafi = "/api/v1/pki/venafi"
+
+// List
+
+// ResponseVenafiDependentProfilesList represents the configuration profiles that use a Venafi CA configuration.
+type ResponseVenafiDependentProfilesList struct {
+	TotalCount int                            `json:"totalCount"`
+	Results    []VenafiSubsetDependentProfile `json:"results"`
+}
+
+// ResponseVenafiHistoryList represents the history entries of a Venafi CA configuration.
+type ResponseVenafiHistoryList struct {
+	Size    int                     `json:"totalCount"`
+	Results []ResourceVenafiHistory `json:"results"`
+}
+
+// Responses
+
+// ResponseVenafiCreate represents the response structure for creating a Venafi CA configuration.
+type ResponseVenafiCreate struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+// ResponseVenafiConnectionStatus represents the connection status between Jamf Pro and the Venafi adapter.
+type ResponseVenafiConnectionStatus struct {
+	Status string `json:"status"`
+}
+
+// Resource
+
+// ResourceVenafi represents a Venafi CA configuration.
+type ResourceVenafi struct {
+	ID                     int    `json:"id,omitempty"`
+	Name                   string `json:"name"`
+	ProxyAddress           string `json:"proxyAddress,omitempty"`
+	RevocationEnabled      bool   `json:"revocationEnabled"`
+	ClientID               string `json:"clientId,omitempty"`
+	RefreshToken           string `json:"refreshToken,omitempty"`
+	RefreshTokenConfigured bool   `json:"refreshTokenConfigured,omitempty"`
+}
+
+// ResourceVenafiUpdate represents a partial update of a Venafi CA configuration. Nil fields are left unchanged.
+type ResourceVenafiUpdate struct {
+	Name              *string `json:"name,omitempty"`
+	ProxyAddress      *string `json:"proxyAddress,omitempty"`
+	RevocationEnabled *bool   `json:"revocationEnabled,omitempty"`
+	ClientID          *string `json:"clientId,omitempty"`
+	RefreshToken      *string `json:"refreshToken,omitempty"`
+}
+
+// ResourceVenafiHistory represents a single history entry of a Venafi CA configuration.
+type ResourceVenafiHistory struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Date     string `json:"date"`
+	Note     string `json:"note"`
+	Details  string `json:"details"`
+}
+
+// Subsets & Containers
+
+// VenafiSubsetDependentProfile represents a configuration profile that depends on a Venafi CA configuration.
+type VenafiSubsetDependentProfile struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	URLPath string `json:"urlPath"`
+}
+
+// CRUD
+
+// GetVenafiByID retrieves a Venafi CA configuration by its ID.
+func (c *Client) GetVenafiByID(id int) (*ResourceVenafi, error) {
+	endpoint := fmt.Sprintf("%s/%d", uriVenafi, id)
+
+	var out ResourceVenafi
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "venafi ca configuration", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// CreateVenafi creates a new Venafi CA configuration.
+func (c *Client) CreateVenafi(venafi ResourceVenafi) (*ResponseVenafiCreate, error) {
+	endpoint := uriVenafi
+
+	var out ResponseVenafiCreate
+	resp, err := c.HTTP.DoRequest("POST", endpoint, venafi, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "venafi ca configuration", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// UpdateVenafiByID updates a Venafi CA configuration by its ID. Only the fields set on the
+// payload are changed.
+func (c *Client) UpdateVenafiByID(id int, venafiUpdate ResourceVenafiUpdate) (*ResourceVenafi, error) {
+	endpoint := fmt.Sprintf("%s/%d", uriVenafi, id)
+
+	var out ResourceVenafi
+	resp, err := c.HTTP.DoRequest("PATCH", endpoint, venafiUpdate, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedUpdateByID, "venafi ca configuration", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// DeleteVenafiByID deletes a Venafi CA configuration by its ID.
+func (c *Client) DeleteVenafiByID(id int) error {
+	endpoint := fmt.Sprintf("%s/%d", uriVenafi, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return apiErrorf(errMsgFailedDeleteByID, "venafi ca configuration", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// GetVenafiConnectionStatusByID tests the communication between Jamf Pro and the Jamf Pro PKI Proxy Server
+// of a Venafi CA configuration.
+func (c *Client) GetVenafiConnectionStatusByID(id int) (*ResponseVenafiConnectionStatus, error) {
+	endpoint := fmt.Sprintf("%s/%d/connection-status", uriVenafi, id)
+
+	var out ResponseVenafiConnectionStatus
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "venafi connection status", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// GetVenafiDependentProfilesByID retrieves the configuration profiles that use a Venafi CA configuration.
+func (c *Client) GetVenafiDependentProfilesByID(id int) (*ResponseVenafiDependentProfilesList, error) {
+	endpoint := fmt.Sprintf("%s/%d/dependent-profiles", uriVenafi, id)
+
+	var out ResponseVenafiDependentProfilesList
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "venafi dependent profiles", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
+
+// GetVenafiJamfPublicKeyByID downloads the Jamf Pro public key (PEM) used to sign requests to the Venafi adapter.
+func (c *Client) GetVenafiJamfPublicKeyByID(id int) ([]byte, error) {
+	endpoint := fmt.Sprintf("%s/%d/jamf-public-key", uriVenafi, id)
+
+	var out bytes.Buffer
+	resp, err := c.doRawDownload(endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "venafi jamf public key", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return out.Bytes(), nil
+}
+
+// RegenerateVenafiJamfPublicKeyByID regenerates the Jamf Pro public key of a Venafi CA configuration.
+func (c *Client) RegenerateVenafiJamfPublicKeyByID(id int) error {
+	endpoint := fmt.Sprintf("%s/%d/jamf-public-key/regenerate", uriVenafi, id)
+
+	var out interface{}
+	resp, err := c.HTTP.DoRequest("POST", endpoint, nil, &out)
+	if err != nil {
+		return apiErrorf(errMsgFailedUpdateByID, "venafi jamf public key", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// GetVenafiProxyTrustStoreByID downloads the proxy trust store (JKS) of a Venafi CA configuration.
+func (c *Client) GetVenafiProxyTrustStoreByID(id int) ([]byte, error) {
+	endpoint := fmt.Sprintf("%s/%d/proxy-trust-store", uriVenafi, id)
+
+	var out bytes.Buffer
+	resp, err := c.doRawDownload(endpoint, nil, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedGetByID, "venafi proxy trust store", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return out.Bytes(), nil
+}
+
+// UploadVenafiProxyTrustStoreByID uploads a proxy trust store (JKS) file for a Venafi CA configuration.
+func (c *Client) UploadVenafiProxyTrustStoreByID(id int, filePath string) error {
+	endpoint := fmt.Sprintf("%s/%d/proxy-trust-store", uriVenafi, id)
+
+	files := map[string]string{
+		"file": filePath,
+	}
+
+	var out interface{}
+	resp, err := c.HTTP.DoMultipartRequest("POST", endpoint, nil, files, &out)
+	if err != nil {
+		return apiErrorf(errMsgFailedUpdateByID, "venafi proxy trust store", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// DeleteVenafiProxyTrustStoreByID removes the proxy trust store of a Venafi CA configuration,
+// reverting to the default trust store.
+func (c *Client) DeleteVenafiProxyTrustStoreByID(id int) error {
+	endpoint := fmt.Sprintf("%s/%d/proxy-trust-store", uriVenafi, id)
+
+	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
+	if err != nil {
+		return apiErrorf(errMsgFailedDeleteByID, "venafi proxy trust store", id, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// GetVenafiHistoryByID retrieves the history of a Venafi CA configuration with optional sorting.
+func (c *Client) GetVenafiHistoryByID(id int, sort_filter string) (*ResponseVenafiHistoryList, error) {
+	endpoint := fmt.Sprintf("%s/%d/history", uriVenafi, id)
+
+	resp, err := c.DoPaginatedGet(endpoint, standardPageSize, startingPageNumber, sort_filter)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "venafi history", err)
+	}
+
+	var out ResponseVenafiHistoryList
+	out.Size = resp.Size
+
+	for _, value := range resp.Results {
+		var newObj ResourceVenafiHistory
+		err := mapstructure.Decode(value, &newObj)
+		if err != nil {
+			return nil, fmt.Errorf(errMsgFailedMapstruct, "venafi history", err)
+		}
+		out.Results = append(out.Results, newObj)
+	}
+
+	return &out, nil
+}
+
+// CreateVenafiHistoryNoteByID adds a note to the history of a Venafi CA configuration.
+func (c *Client) CreateVenafiHistoryNoteByID(id int, note string) (*ResourceVenafiHistory, error) {
+	endpoint := fmt.Sprintf("%s/%d/history", uriVenafi, id)
+
+	payload := struct {
+		Note string `json:"note"`
+	}{
+		Note: note,
+	}
+
+	var out ResourceVenafiHistory
+	resp, err := c.HTTP.DoRequest("POST", endpoint, payload, &out)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "venafi history note", err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &out, nil
+}
@@ -82,7 +82,7 @@ type VolumePurchasingSubsetContent struct {
 func (c *Client) GetVolumePurchaseLocations(sort_filter string) (*ResponseVolumePurchasingList, error) {
 	resp, err := c.DoPaginatedGet(uriVolumePurchasingLocations, standardPageSize, startingPageNumber, sort_filter)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "vpp locations", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "vpp locations", err)
 	}
 
 	var out ResponseVolumePurchasingList
@@ -106,7 +106,7 @@ func (c *Client) GetVolumePurchasingLocationByID(id string) (*ResourceVolumePurc
 	var responseLocation ResourceVolumePurchasingLocation
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &responseLocation)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "vpp locations", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "vpp locations", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -139,7 +139,7 @@ func (c *Client) UpdateVolumePurchasingLocationByID(id string) (*ResourceVolumeP
 	var responseLocation ResourceVolumePurchasingLocation
 	resp, err := c.HTTP.DoRequest("PATCH", endpoint, nil, &responseLocation)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "vpp locations", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "vpp locations", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -154,7 +154,7 @@ func (c *Client) DeleteVolumePurchasingLocationByID(id string) error {
 	endpoint := uriVolumePurchasingLocations
 	resp, err := c.HTTP.DoRequest("DELETE", endpoint, nil, nil)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByID, "vpp location", id, err)
+		return apiErrorf(errMsgFailedDeleteByID, "vpp location", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -56,7 +56,7 @@ func (c *Client) GetVolumePurchasingSubscriptions(sort_filter string) (*Response
 		sort_filter,
 	)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "volume purchasing subscriptions", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "volume purchasing subscriptions", err)
 	}
 
 	var out ResponseVolumePurchasingSubscriptionsList
@@ -81,7 +81,7 @@ func (c *Client) GetVolumePurchasingSubscriptionByID(id string) (*ResourceVolume
 	var subscription ResourceVolumePurchasingSubscription
 	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &subscription)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByID, "volume purchasing subscription", id, err)
+		return nil, apiErrorf(errMsgFailedGetByID, "volume purchasing subscription", id, err)
 	}
 
 	if resp != nil && resp.Body != nil {
@@ -95,7 +95,7 @@ func (c *Client) GetVolumePurchasingSubscriptionByID(id string) (*ResourceVolume
 func (c *Client) GetVolumePurchasingSubscriptionByName(name string) (*ResourceVolumePurchasingSubscription, error) {
 	vpSubcriptions, err := c.GetVolumePurchasingSubscriptions("")
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedPaginatedGet, "volume purchasing subscriptions", err)
+		return nil, apiErrorf(errMsgFailedPaginatedGet, "volume purchasing subscriptions", err)
 	}
 
 	for _, value := range vpSubcriptions.Results {
@@ -151,13 +151,13 @@ func (c *Client) UpdateVolumePurchasingSubscriptionByID(id string, subscription
 func (c *Client) UpdateVolumePurchasingSubscriptionByName(name string, updateData *ResourceVolumePurchasingSubscription) (*ResourceVolumePurchasingSubscription, error) {
 	target, err := c.GetVolumePurchasingSubscriptionByName(name)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedGetByName, "volume purchasing subscription", name, err)
+		return nil, apiErrorf(errMsgFailedGetByName, "volume purchasing subscription", name, err)
 	}
 
 	target_id := target.Id
 	resp, err := c.UpdateVolumePurchasingSubscriptionByID(target_id, updateData)
 	if err != nil {
-		return nil, fmt.Errorf(errMsgFailedUpdateByName, "volume purchasing subscription", name, err)
+		return nil, apiErrorf(errMsgFailedUpdateByName, "volume purchasing subscription", name, err)
 	}
 	return resp, nil
 }
@@ -182,13 +182,13 @@ func (c *Client) DeleteVolumePurchasingSubscriptionByID(id string) error {
 func (c *Client) DeleteVolumePurchasingSubscriptionByName(name string) error {
 	target, err := c.GetVolumePurchasingSubscriptionByName(name)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedGetByName, "volume purchasing subscription", name, err)
+		return apiErrorf(errMsgFailedGetByName, "volume purchasing subscription", name, err)
 	}
 
 	target_id := target.Id
 	err = c.DeleteVolumePurchasingSubscriptionByID(target_id)
 	if err != nil {
-		return fmt.Errorf(errMsgFailedDeleteByName, "volume purchasing subscription", name, err)
+		return apiErrorf(errMsgFailedDeleteByName, "volume purchasing subscription", name, err)
 	}
 
 	return nil
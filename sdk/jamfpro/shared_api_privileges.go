@@ -0,0 +1,163 @@
+// shared_api_privileges.go
+// Jamf Pro API role privileges required by the SDK client methods. Privilege names match those returned by
+// GetJamfAPIPrivileges. Methods and endpoints that only need an authenticated token map to an empty
+// privilege list. The table itself is generated from the SDK sources, see tools/APIPrivilegeTableGenerator.
+
+package jamfpro
+
+//go:generate go run ../../tools/APIPrivilegeTableGenerator -dir . -out shared_api_privileges_table.go
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-http-client/response"
+)
+
+// endpointPrivileges is an endpoint requested by the SDK. Path segments in braces match any value.
+type endpointPrivileges struct {
+	Method     string
+	Path       string
+	Privileges []string
+}
+
+// MissingPrivilegeError is a 403 response enriched with the privileges the requested endpoint needs.
+type MissingPrivilegeError struct {
+	Method     string
+	Path       string
+	Privileges []string
+	Err        error
+}
+
+func (e *MissingPrivilegeError) Error() string {
+	return fmt.Sprintf("%v, missing privilege: %s", e.Err, strings.Join(e.Privileges, ", "))
+}
+
+func (e *MissingPrivilegeError) Unwrap() error {
+	return e.Err
+}
+
+// PrivilegesForMethod returns the privileges needed to call a Client method, e.g. "GetComputersInventory".
+func PrivilegesForMethod(method string) ([]string, bool) {
+	privileges, ok := clientMethodPrivileges[method]
+	return privileges, ok
+}
+
+// RequiredPrivilegesForMethods returns the sorted, de-duplicated privileges needed to call the given Client
+// methods, e.g. "GetScripts" or "CreatePolicy". Methods without a known mapping are returned as unknown.
+func RequiredPrivilegesForMethods(methods ...string) (privileges []string, unknown []string) {
+	seen := make(map[string]bool)
+	for _, method := range methods {
+		methodPrivileges, ok := clientMethodPrivileges[method]
+		if !ok {
+			unknown = append(unknown, method)
+			continue
+		}
+		for _, privilege := range methodPrivileges {
+			if !seen[privilege] {
+				seen[privilege] = true
+				privileges = append(privileges, privilege)
+			}
+		}
+	}
+
+	sort.Strings(privileges)
+	return privileges, unknown
+}
+
+// PrivilegesForEndpoint returns the privileges needed to request an endpoint. The path may be a full url or
+// a path starting at /api or /JSSResource; query strings are ignored.
+func PrivilegesForEndpoint(httpMethod, path string) ([]string, bool) {
+	segments := strings.Split(endpointPath(path), "/")
+
+	var match *endpointPrivileges
+	matchLiterals := -1
+	for i := range endpointPrivilegeTable {
+		entry := &endpointPrivilegeTable[i]
+		if !strings.EqualFold(entry.Method, httpMethod) {
+			continue
+		}
+		if literals, ok := matchEndpointPath(strings.Split(entry.Path, "/"), segments); ok && literals > matchLiterals {
+			match, matchLiterals = entry, literals
+		}
+	}
+
+	if match == nil {
+		return nil, false
+	}
+	return match.Privileges, true
+}
+
+// EnrichAccessDeniedError adds the privileges the requested endpoint needs to a 403 error returned by an SDK
+// method, as a *MissingPrivilegeError. Other errors, and endpoints without a known mapping, are returned unchanged.
+// The errors of the SDK's own resource methods are already enriched.
+func EnrichAccessDeniedError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var missing *MissingPrivilegeError
+	if errors.As(err, &missing) {
+		return err
+	}
+	var apiErr *response.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 403 {
+		return err
+	}
+	method, requestURL := apiErr.Method, apiErr.URL
+
+	privileges, ok := PrivilegesForEndpoint(method, requestURL)
+	if !ok || len(privileges) == 0 {
+		return err
+	}
+
+	return &MissingPrivilegeError{
+		Method:     method,
+		Path:       endpointPath(requestURL),
+		Privileges: privileges,
+		Err:        err,
+	}
+}
+
+// endpointPath strips the scheme, host, context path and query from a request url.
+func endpointPath(requestURL string) string {
+	path := requestURL
+	if parsed, err := url.Parse(requestURL); err == nil && parsed.Path != "" {
+		path = parsed.Path
+	}
+	if i := strings.IndexAny(path, "?\\"); i >= 0 {
+		path = path[:i]
+	}
+	for _, prefix := range []string{"/JSSResource/", "/api/"} {
+		if i := strings.Index(path, prefix); i >= 0 {
+			path = path[i:]
+			break
+		}
+	}
+	return strings.TrimSuffix(path, "/")
+}
+
+// matchEndpointPath reports whether a path matches a table pattern and how many literal segments matched.
+func matchEndpointPath(pattern, segments []string) (int, bool) {
+	if len(pattern) != len(segments) {
+		return 0, false
+	}
+
+	literals := 0
+	for i, part := range pattern {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			if segments[i] == "" {
+				return 0, false
+			}
+			continue
+		}
+		if !strings.EqualFold(part, segments[i]) {
+			return 0, false
+		}
+		literals++
+	}
+	return literals, true
+}
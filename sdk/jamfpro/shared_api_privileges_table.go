@@ -0,0 +1,1301 @@
+// Code generated by tools/APIPrivilegeTableGenerator. DO NOT EDIT.
+
+package jamfpro
+
+// clientMethodPrivileges maps Client method names to the privileges an API role needs to call them.
+var clientMethodPrivileges = map[string][]string{
+	"AddComputersToStaticGroup":                               {"Update Static Computer Groups"},
+	"AddComputersToStaticGroupBySerialNumber":                 {"Update Static Computer Groups"},
+	"AddMobileDevicesToStaticGroup":                           {"Update Static Mobile Device Groups"},
+	"AddMobileDevicesToStaticGroupBySerialNumber":             {"Update Static Mobile Device Groups"},
+	"AddUsersToStaticGroup":                                   {"Update Static User Groups"},
+	"AddUsersToStaticGroupByUsername":                         {"Update Static User Groups"},
+	"AnalyzePackageUsage":                                     {"Read Computer PreStage Enrollments", "Read Jamf Content Distribution Server Files", "Read Packages", "Read Patch Management Software Titles", "Read Policies"},
+	"CloseTeamViewerSessionByID":                              {"Update Remote Administration"},
+	"CreateAccount":                                           {"Create Accounts"},
+	"CreateAccountDrivenUserEnrollmentAccessGroup":            {"Create Scripts"},
+	"CreateAccountGroup":                                      {"Create Accounts"},
+	"CreateAdvancedComputerSearch":                            {"Create Advanced Computer Searches"},
+	"CreateAdvancedMobileDeviceSearch":                        {"Create Advanced Mobile Device Searches"},
+	"CreateAdvancedUserSearch":                                {"Create Advanced User Searches"},
+	"CreateAllowedFileExtension":                              {"Create Allowed File Extensions"},
+	"CreateApiIntegration":                                    {"Create API Integrations"},
+	"CreateBYOProfile":                                        {"Create Personal Device Profiles"},
+	"CreateBuilding":                                          {"Create Buildings"},
+	"CreateBuildingResourceHistoryByID":                       {"Update Buildings"},
+	"CreateCategory":                                          {"Create Categories"},
+	"CreateClass":                                             {"Create Classes"},
+	"CreateCloudIdentityProvider":                             {"Create LDAP Servers"},
+	"CreateComputer":                                          {"Create Computers"},
+	"CreateComputerExtensionAttribute":                        {"Create Computer Extension Attributes"},
+	"CreateComputerGroup":                                     {"Create Smart Computer Groups", "Create Static Computer Groups"},
+	"CreateComputerInventoryCollectionSettingsCustomPath":     {"Create Computer Inventory Collection"},
+	"CreateComputerInvitation":                                {"Create Computer Invitations"},
+	"CreateComputerPrestage":                                  {"Create Computer PreStage Enrollments"},
+	"CreateDepartment":                                        {"Create Departments"},
+	"CreateDeviceEnrollmentHistoryNoteByID":                   {"Update Device Enrollment Program Instances"},
+	"CreateDeviceEnrollmentWithToken":                         {"Create Device Enrollment Program Instances"},
+	"CreateDeviceEnrollmentWithTokenFile":                     {"Create Device Enrollment Program Instances"},
+	"CreateDirectoryBinding":                                  {"Create Directory Bindings"},
+	"CreateDiskEncryptionConfiguration":                       {"Create Disk Encryption Configurations"},
+	"CreateDistributionPoint":                                 {"Create Distribution Points"},
+	"CreateDockItem":                                          {"Create Dock Items"},
+	"CreateEbook":                                             {"Create eBooks"},
+	"CreateEnrollmentCustomization":                           {"Create Enrollment Customizations"},
+	"CreateExternalPatchSource":                               {"Create Patch External Source"},
+	"CreateFileAttachments":                                   {"Create File Attachments"},
+	"CreateIBeacon":                                           {"Create iBeacon Regions"},
+	"CreateJCDS2PackageV2":                                    {"Create Jamf Content Distribution Server Files", "Read Jamf Content Distribution Server Files"},
+	"CreateJamfApiRole":                                       {"Create API Roles"},
+	"CreateLDAPServer":                                        {"Create LDAP Servers"},
+	"CreateLicensedSoftware":                                  {"Create Licensed Software"},
+	"CreateMacApplication":                                    {"Create Mac Applications"},
+	"CreateMacOSConfigurationProfile":                         {"Create macOS Configuration Profiles"},
+	"CreateManagedSoftwareUpdatePlanByDeviceID":               {"Create Managed Software Updates"},
+	"CreateManagedSoftwareUpdatePlanByGroupID":                {"Create Managed Software Updates"},
+	"CreateMobileDevice":                                      {"Create Mobile Devices"},
+	"CreateMobileDeviceApplication":                           {"Create Mobile Device Apps"},
+	"CreateMobileDeviceConfigurationProfile":                  {"Create iOS Configuration Profiles"},
+	"CreateMobileDeviceEnrollmentProfile":                     {"Create Mobile Device Enrollment Profiles"},
+	"CreateMobileDeviceGroup":                                 {"Create Smart Mobile Device Groups", "Create Static Mobile Device Groups"},
+	"CreateMobileDevicePrestage":                              {"Create Mobile Device PreStage Enrollments"},
+	"CreateMobileDeviceProvisioningProfile":                   {"Create Provisioning Profiles"},
+	"CreateMobileDeviceProvisioningProfileByName":             {"Create Provisioning Profiles"},
+	"CreateMobileDeviceProvisioningProfileByUUID":             {"Create Provisioning Profiles"},
+	"CreateMobileExtensionAttribute":                          {"Create Mobile Device Extension Attributes"},
+	"CreateNetworkSegment":                                    {"Create Network Segments"},
+	"CreatePackage":                                           {"Create Packages"},
+	"CreatePatchPolicy":                                       {"Create Patch Policies"},
+	"CreatePatchSoftwareTitleConfiguration":                   {"Create Patch Management Software Titles"},
+	"CreatePolicy":                                            {"Create Policies"},
+	"CreatePrinter":                                           {"Create Printers"},
+	"CreateRemovableMACAddress":                               {"Create Removable MAC Address"},
+	"CreateRestrictedSoftware":                                {"Create Restricted Software"},
+	"CreateReturnToServiceConfiguration":                      {"Create Return to Service"},
+	"CreateScript":                                            {"Create Scripts"},
+	"CreateSelfServiceBrandingMacOS":                          {"Create Self Service Branding Configuration"},
+	"CreateSite":                                              {"Create Sites"},
+	"CreateSoftwareUpdateServer":                              {"Create Software Update Servers"},
+	"CreateTeamViewerRemoteAdministrationConfiguration":       {"Create Remote Administration"},
+	"CreateTeamViewerSession":                                 {"Create Remote Administration"},
+	"CreateUser":                                              {"Create Users"},
+	"CreateUserExtensionAttribute":                            {"Create User Extension Attributes"},
+	"CreateUserGroup":                                         {"Create Smart User Groups", "Create Static User Groups"},
+	"CreateVPPAccount":                                        {"Create Volume Purchasing Administrator Accounts"},
+	"CreateVPPAssignment":                                     {"Create VPP Assignments"},
+	"CreateVenafi":                                            {"Create PKI"},
+	"CreateVenafiHistoryNoteByID":                             {"Update PKI"},
+	"CreateVolumePurchasingLocation":                          {"Create Volume Purchasing Administrator Accounts"},
+	"CreateVolumePurchasingSubscription":                      {"Create Volume Purchasing Subscriptions"},
+	"CreateWebhook":                                           {"Create Webhooks"},
+	"DeleteAccountByID":                                       {"Delete Accounts"},
+	"DeleteAccountByName":                                     {"Delete Accounts"},
+	"DeleteAccountDrivenUserEnrollmentAccessGroupByID":        {"Delete User-Initiated Enrollment"},
+	"DeleteAccountDrivenUserEnrollmentAccessGroupByName":      {"Delete User-Initiated Enrollment", "Read User-Initiated Enrollment"},
+	"DeleteAccountGroupByID":                                  {"Delete Accounts"},
+	"DeleteAccountGroupByName":                                {"Delete Accounts"},
+	"DeleteAdvancedComputerSearchByID":                        {"Delete Advanced Computer Searches"},
+	"DeleteAdvancedComputerSearchByName":                      {"Delete Advanced Computer Searches"},
+	"DeleteAdvancedMobileDeviceSearchByID":                    {"Delete Advanced Mobile Device Searches"},
+	"DeleteAdvancedMobileDeviceSearchByName":                  {"Delete Advanced Mobile Device Searches"},
+	"DeleteAdvancedUserSearchByID":                            {"Delete Advanced User Searches"},
+	"DeleteAdvancedUserSearchByName":                          {"Delete Advanced User Searches"},
+	"DeleteAllowedFileExtensionByID":                          {"Delete Allowed File Extensions"},
+	"DeleteAllowedFileExtensionByName":                        {"Delete Allowed File Extensions", "Read Allowed File Extensions"},
+	"DeleteApiIntegrationByID":                                {"Delete API Integrations"},
+	"DeleteApiIntegrationByName":                              {"Delete API Integrations", "Read API Integrations"},
+	"DeleteAttachmentByIDAndComputerID":                       {"Delete Computers"},
+	"DeleteBYOProfileByID":                                    {"Delete Personal Device Profiles"},
+	"DeleteBYOProfileByName":                                  {"Delete Personal Device Profiles"},
+	"DeleteBuildingByID":                                      {"Delete Buildings"},
+	"DeleteBuildingByName":                                    {"Delete Buildings", "Read Buildings"},
+	"DeleteCSATokenExchange":                                  {"Delete Cloud Services Settings"},
+	"DeleteCategoryByID":                                      {"Delete Categories"},
+	"DeleteCategoryByName":                                    {"Delete Categories", "Read Categories"},
+	"DeleteClassByID":                                         {"Delete Classes"},
+	"DeleteClassByName":                                       {"Delete Classes"},
+	"DeleteCloudIdentityProviderByID":                         {"Delete LDAP Servers"},
+	"DeleteComputerByID":                                      {"Delete Computers"},
+	"DeleteComputerByName":                                    {"Delete Computers"},
+	"DeleteComputerExtensionAttributeByID":                    {"Delete Computer Extension Attributes"},
+	"DeleteComputerExtensionAttributeByNameByID":              {"Delete Computer Extension Attributes", "Read Computer Extension Attributes"},
+	"DeleteComputerGroupByID":                                 {"Delete Smart Computer Groups", "Delete Static Computer Groups"},
+	"DeleteComputerGroupByName":                               {"Delete Smart Computer Groups", "Delete Static Computer Groups"},
+	"DeleteComputerInventoryByID":                             {"Delete Computers"},
+	"DeleteComputerInventoryCollectionSettingsCustomPathByID": {"Delete Computer Inventory Collection"},
+	"DeleteComputerInvitationByID":                            {"Delete Computer Invitations"},
+	"DeleteComputerPrestageByID":                              {"Delete Computer PreStage Enrollments"},
+	"DeleteComputerPrestageByName":                            {"Delete Computer PreStage Enrollments", "Read Computer PreStage Enrollments"},
+	"DeleteDepartmentByID":                                    {"Delete Departments"},
+	"DeleteDepartmentByName":                                  {"Delete Departments", "Read Departments"},
+	"DeleteDeviceEnrollmentByID":                              {"Delete Device Enrollment Program Instances"},
+	"DeleteDirectoryBindingByID":                              {"Delete Directory Bindings"},
+	"DeleteDirectoryBindingByName":                            {"Delete Directory Bindings"},
+	"DeleteDiskEncryptionConfigurationByID":                   {"Delete Disk Encryption Configurations"},
+	"DeleteDiskEncryptionConfigurationByName":                 {"Delete Disk Encryption Configurations"},
+	"DeleteDistributionPointByID":                             {"Delete Distribution Points"},
+	"DeleteDistributionPointByName":                           {"Delete Distribution Points"},
+	"DeleteDockItemByID":                                      {"Delete Dock Items"},
+	"DeleteDockItemByName":                                    {"Delete Dock Items"},
+	"DeleteEbookByID":                                         {"Delete eBooks"},
+	"DeleteEbookByName":                                       {"Delete eBooks"},
+	"DeleteEnrollmentCustomizationByID":                       {"Delete Enrollment Customizations"},
+	"DeleteExternalPatchSourceByID":                           {"Delete Patch External Source"},
+	"DeleteIBeaconByID":                                       {"Delete iBeacon Regions"},
+	"DeleteIBeaconByName":                                     {"Delete iBeacon Regions"},
+	"DeleteJCDS2PackageV2":                                    {"Create Jamf Content Distribution Server Files"},
+	"DeleteJamfApiRoleByID":                                   {"Delete API Roles"},
+	"DeleteJamfApiRoleByName":                                 {"Delete API Roles", "Read API Roles"},
+	"DeleteJamfProtectIntegration":                            {"Delete Jamf Protect Integration"},
+	"DeleteLDAPServerByID":                                    {"Delete LDAP Servers"},
+	"DeleteLDAPServerByName":                                  {"Delete LDAP Servers"},
+	"DeleteLicensedSoftwareByID":                              {"Delete Licensed Software"},
+	"DeleteLicensedSoftwareByName":                            {"Delete Licensed Software"},
+	"DeleteMacApplicationByID":                                {"Delete Mac Applications"},
+	"DeleteMacApplicationByName":                              {"Delete Mac Applications"},
+	"DeleteMacOSConfigurationProfileByID":                     {"Delete macOS Configuration Profiles"},
+	"DeleteMacOSConfigurationProfileByName":                   {"Delete macOS Configuration Profiles"},
+	"DeleteMobileDeviceApplicationByBundleID":                 {"Delete Mobile Device Apps"},
+	"DeleteMobileDeviceApplicationByBundleIDAndVersion":       {"Delete Mobile Device Apps"},
+	"DeleteMobileDeviceApplicationByName":                     {"Delete Mobile Device Apps"},
+	"DeleteMobileDeviceApplicationpByID":                      {"Delete Mobile Device Apps"},
+	"DeleteMobileDeviceByID":                                  {"Delete Mobile Devices"},
+	"DeleteMobileDeviceByName":                                {"Delete Mobile Devices"},
+	"DeleteMobileDeviceConfigurationProfileByID":              {"Delete iOS Configuration Profiles"},
+	"DeleteMobileDeviceConfigurationProfileByName":            {"Delete iOS Configuration Profiles"},
+	"DeleteMobileDeviceEnrollmentProfileByID":                 {"Delete Mobile Device Enrollment Profiles"},
+	"DeleteMobileDeviceEnrollmentProfileByInvitation":         {"Delete Mobile Device Enrollment Profiles"},
+	"DeleteMobileDeviceEnrollmentProfileByName":               {"Delete Mobile Device Enrollment Profiles"},
+	"DeleteMobileDeviceGroupByID":                             {"Delete Smart Mobile Device Groups", "Delete Static Mobile Device Groups"},
+	"DeleteMobileDeviceGroupByName":                           {"Delete Smart Mobile Device Groups", "Delete Static Mobile Device Groups"},
+	"DeleteMobileDevicePrestageByID":                          {"Delete Mobile Device PreStage Enrollments"},
+	"DeleteMobileDeviceProvisioningProfileByID":               {"Delete Provisioning Profiles"},
+	"DeleteMobileDeviceProvisioningProfileByName":             {"Delete Provisioning Profiles"},
+	"DeleteMobileDeviceProvisioningProfileByUUID":             {"Delete Provisioning Profiles"},
+	"DeleteMobileExtensionAttributeByID":                      {"Delete Mobile Device Extension Attributes"},
+	"DeleteMobileExtensionAttributeByName":                    {"Delete Mobile Device Extension Attributes"},
+	"DeleteMultipleBuildingsByID":                             {},
+	"DeleteMultipleCategoriesByID":                            {"Delete Categories"},
+	"DeleteNetworkSegmentByID":                                {"Delete Network Segments"},
+	"DeleteNetworkSegmentByName":                              {"Delete Network Segments"},
+	"DeletePackageByID":                                       {"Delete Packages"},
+	"DeletePackageByName":                                     {"Delete Packages"},
+	"DeletePatchPolicyByID":                                   {"Delete Patch Policies"},
+	"DeletePatchSoftwareTitleConfigurationById":               {"Delete Patch Management Software Titles"},
+	"DeletePolicyByID":                                        {"Delete Policies"},
+	"DeletePolicyByName":                                      {"Delete Policies"},
+	"DeletePrinterByID":                                       {"Delete Printers"},
+	"DeletePrinterByName":                                     {"Delete Printers"},
+	"DeleteRemovableMACAddressByID":                           {"Delete Removable MAC Address"},
+	"DeleteRemovableMACAddressByName":                         {"Delete Removable MAC Address"},
+	"DeleteRestrictedSoftwareByID":                            {"Delete Restricted Software"},
+	"DeleteRestrictedSoftwareByName":                          {"Delete Restricted Software"},
+	"DeleteReturnToServiceConfigurationByID":                  {"Delete Return to Service"},
+	"DeleteSSOCertificate":                                    {"Delete SSO Settings"},
+	"DeleteScriptByID":                                        {"Delete Scripts"},
+	"DeleteScriptByName":                                      {"Delete Scripts", "Read Scripts"},
+	"DeleteSelfServiceBrandingMacOSByID":                      {"Delete Self Service Branding Configuration"},
+	"DeleteSelfServiceBrandingMacOSByName":                    {"Delete Self Service Branding Configuration", "Read Self Service Branding Configuration"},
+	"DeleteSiteByID":                                          {"Delete Sites"},
+	"DeleteSiteByName":                                        {"Delete Sites"},
+	"DeleteSoftwareUpdateServerByID":                          {"Delete Software Update Servers"},
+	"DeleteSoftwareUpdateServerByName":                        {"Delete Software Update Servers"},
+	"DeleteTeamViewerRemoteAdministrationConfigurationByID":   {"Delete Remote Administration"},
+	"DeleteUserByEmail":                                       {"Delete Users"},
+	"DeleteUserByID":                                          {"Delete Users"},
+	"DeleteUserByName":                                        {"Delete Users"},
+	"DeleteUserExtensionAttributeByID":                        {"Delete User Extension Attributes"},
+	"DeleteUserExtensionAttributeByName":                      {"Delete User Extension Attributes"},
+	"DeleteUserGroupByID":                                     {"Delete Smart User Groups", "Delete Static User Groups"},
+	"DeleteUserGroupByName":                                   {"Delete Smart User Groups", "Delete Static User Groups"},
+	"DeleteVPPAccountByID":                                    {"Delete Volume Purchasing Administrator Accounts"},
+	"DeleteVPPAssignmentByID":                                 {"Delete VPP Assignments"},
+	"DeleteVenafiByID":                                        {"Delete PKI"},
+	"DeleteVenafiProxyTrustStoreByID":                         {"Delete PKI"},
+	"DeleteVolumePurchasingLocationByID":                      {"Delete Volume Purchasing Administrator Accounts"},
+	"DeleteVolumePurchasingSubscriptionByID":                  {"Delete Volume Purchasing Subscriptions"},
+	"DeleteVolumePurchasingSubscriptionByName":                {"Delete Volume Purchasing Subscriptions", "Read Volume Purchasing Subscriptions"},
+	"DeleteWebhookByID":                                       {"Delete Webhooks"},
+	"DeleteWebhookByName":                                     {"Delete Webhooks"},
+	"Diagnose":                                                {"Read Jamf Content Distribution Server Files", "Read LDAP Servers", "Read PKI", "Read SMTP Server"},
+	"DisownDeviceEnrollmentDevicesByID":                       {"Update Device Enrollment Program Instances"},
+	"DoPackageUpload":                                         {"Create Jamf Content Distribution Server Files", "Create Packages", "Read Jamf Content Distribution Server Files"},
+	"DoPackageUploadWithManifest":                             {"Create Jamf Content Distribution Server Files", "Create Packages", "Read Jamf Content Distribution Server Files"},
+	"DoPackageUploadWithOptions":                              {"Create Jamf Content Distribution Server Files", "Create Packages", "Read Jamf Content Distribution Server Files"},
+	"DoPaginatedGet":                                          {},
+	"DownloadIcon":                                            {"Read Icons"},
+	"DownloadIconTo":                                          {"Read Icons"},
+	"DownloadJCDS2Package":                                    {"Read Jamf Content Distribution Server Files"},
+	"DownloadJCDS2PackageToFile":                              {"Read Jamf Content Distribution Server Files"},
+	"DownloadSSOCertificate":                                  {"Read SSO Settings"},
+	"DownloadSelfServiceBrandingImage":                        {"Read Self Service Branding Configuration"},
+	"EraseMobileDeviceByID":                                   {"Read Mobile Devices", "Read Return to Service", "Read iOS Configuration Profiles", "Send Mobile Device Remote Wipe Command"},
+	"ExportSelfServiceIcons":                                  {"Read Icons", "Read Mac Applications", "Read Mobile Device Apps", "Read Policies"},
+	"FindJCDS2PackageByChecksums":                             {"Read Jamf Content Distribution Server Files"},
+	"GetADUESessionTokenSettings":                             {"Read User-Initiated Enrollment"},
+	"GetAccountByID":                                          {"Read Accounts"},
+	"GetAccountByName":                                        {"Read Accounts"},
+	"GetAccountDrivenUserEnrollmentAccessGroupByID":           {"Read User-Initiated Enrollment"},
+	"GetAccountDrivenUserEnrollmentAccessGroupByName":         {"Read User-Initiated Enrollment"},
+	"GetAccountDrivenUserEnrollmentAccessGroups":              {"Read User-Initiated Enrollment"},
+	"GetAccountGroupByID":                                     {"Read Accounts"},
+	"GetAccountGroupByName":                                   {"Read Accounts"},
+	"GetAccountPreferences":                                   {},
+	"GetAccounts":                                             {"Read Accounts"},
+	"GetActivationCode":                                       {"Read Activation Code"},
+	"GetActiveCertificateAuthority":                           {"Read PKI"},
+	"GetAdvancedComputerSearchByID":                           {"Read Advanced Computer Searches"},
+	"GetAdvancedComputerSearchByName":                         {"Read Advanced Computer Searches"},
+	"GetAdvancedComputerSearchResultsByID":                    {},
+	"GetAdvancedComputerSearchResultsByName":                  {},
+	"GetAdvancedComputerSearches":                             {"Read Advanced Computer Searches"},
+	"GetAdvancedMobileDeviceSearchByID":                       {"Read Advanced Mobile Device Searches"},
+	"GetAdvancedMobileDeviceSearchByName":                     {"Read Advanced Mobile Device Searches"},
+	"GetAdvancedMobileDeviceSearchResultsByID":                {},
+	"GetAdvancedMobileDeviceSearchResultsByName":              {},
+	"GetAdvancedMobileDeviceSearches":                         {"Read Advanced Mobile Device Searches"},
+	"GetAdvancedUserSearchByID":                               {"Read Advanced User Searches"},
+	"GetAdvancedUserSearchByName":                             {"Read Advanced User Searches"},
+	"GetAdvancedUserSearchResultsByID":                        {},
+	"GetAdvancedUserSearchResultsByName":                      {},
+	"GetAdvancedUserSearches":                                 {"Read Advanced User Searches"},
+	"GetAllowedFileExtensionByID":                             {"Read Allowed File Extensions"},
+	"GetAllowedFileExtensionByName":                           {"Read Allowed File Extensions"},
+	"GetAllowedFileExtensions":                                {"Read Allowed File Extensions"},
+	"GetApiIntegrationByID":                                   {"Read API Integrations"},
+	"GetApiIntegrationByName":                                 {"Read API Integrations"},
+	"GetApiIntegrations":                                      {"Read API Integrations"},
+	"GetAuthDetails":                                          {},
+	"GetBYOProfileByID":                                       {"Read Personal Device Profiles"},
+	"GetBYOProfileByName":                                     {"Read Personal Device Profiles"},
+	"GetBYOProfiles":                                          {"Read Personal Device Profiles"},
+	"GetBuildingByID":                                         {"Read Buildings"},
+	"GetBuildingByName":                                       {"Read Buildings"},
+	"GetBuildingResourceHistoryByID":                          {"Read Buildings"},
+	"GetBuildings":                                            {"Read Buildings"},
+	"GetCSATokenExchangeInfo":                                 {"Read Cloud Services Settings"},
+	"GetCacheSettings":                                        {"Read Cache"},
+	"GetCategories":                                           {"Read Categories"},
+	"GetCategoryByID":                                         {"Read Categories"},
+	"GetCategoryByName":                                       {"Read Categories"},
+	"GetClassByID":                                            {"Read Classes"},
+	"GetClassByName":                                          {"Read Classes"},
+	"GetClasses":                                              {"Read Classes"},
+	"GetClientCheckinSettings":                                {},
+	"GetCloudDistributionPoint":                               {"Read Cloud Distribution Point"},
+	"GetCloudDistributionPointUploadCapability":               {"Read Cloud Distribution Point"},
+	"GetCloudIdentityProviderByID":                            {"Read LDAP Servers"},
+	"GetCloudIdentityProviderDefaultServerMappings":           {"Read LDAP Servers"},
+	"GetComputerByID":                                         {"Read Computers"},
+	"GetComputerByName":                                       {"Read Computers"},
+	"GetComputerCheckinInformation":                           {"Read Computer Check-In"},
+	"GetComputerExtensionAttributeByID":                       {"Read Computer Extension Attributes"},
+	"GetComputerExtensionAttributeByName":                     {"Read Computer Extension Attributes"},
+	"GetComputerExtensionAttributeValue":                      {"Read Computers"},
+	"GetComputerExtensionAttributes":                          {"Read Computer Extension Attributes"},
+	"GetComputerFileVaultInventoryByID":                       {"Read Computers", "View Disk Encryption Recovery Key"},
+	"GetComputerGroupByID":                                    {"Read Smart Computer Groups", "Read Static Computer Groups"},
+	"GetComputerGroupByName":                                  {"Read Smart Computer Groups", "Read Static Computer Groups"},
+	"GetComputerGroups":                                       {"Read Smart Computer Groups", "Read Static Computer Groups"},
+	"GetComputerInventoryByID":                                {"Read Computers"},
+	"GetComputerInventoryByName":                              {"Read Computers"},
+	"GetComputerInventoryCollectionInformation":               {"Read Computer Inventory Collection"},
+	"GetComputerInventoryCollectionSettings":                  {"Read Computer Inventory Collection"},
+	"GetComputerInvitationByID":                               {"Read Computer Invitations"},
+	"GetComputerInvitationByInvitationID":                     {"Read Computer Invitations"},
+	"GetComputerInvitations":                                  {"Read Computer Invitations"},
+	"GetComputerPrestageByID":                                 {"Read Computer PreStage Enrollments"},
+	"GetComputerPrestageByName":                               {"Read Computer PreStage Enrollments"},
+	"GetComputerPrestages":                                    {"Read Computer PreStage Enrollments"},
+	"GetComputerRecoveryLockPasswordByID":                     {"View Recovery Lock"},
+	"GetComputers":                                            {"Read Computers"},
+	"GetComputersFileVaultInventory":                          {"Read Computers", "View Disk Encryption Recovery Key"},
+	"GetComputersInventory":                                   {"Read Computers"},
+	"GetConditionalAccessDeviceComplianceFeatureEnablement":   {"Read Conditional Access"},
+	"GetDefaultCloudIdentityProvider":                         {"Read LDAP Servers"},
+	"GetDefaultCloudIdentityProviderDefaultMappings":          {"Read LDAP Servers"},
+	"GetDepartmentByID":                                       {"Read Departments"},
+	"GetDepartmentByName":                                     {"Read Departments"},
+	"GetDepartments":                                          {"Read Departments"},
+	"GetDeviceCommunicationSettings":                          {"Read Automatically Renew MDM Profile Settings"},
+	"GetDeviceEnrollmentByID":                                 {"Read Device Enrollment Program Instances"},
+	"GetDeviceEnrollmentByName":                               {"Read Device Enrollment Program Instances"},
+	"GetDeviceEnrollmentDevicesByID":                          {"Read Device Enrollment Program Instances"},
+	"GetDeviceEnrollmentHistoryByID":                          {"Read Device Enrollment Program Instances"},
+	"GetDeviceEnrollmentLatestSyncStateByID":                  {"Read Device Enrollment Program Instances"},
+	"GetDeviceEnrollmentPublicKey":                            {"Read Device Enrollment Program Instances"},
+	"GetDeviceEnrollmentSyncStatesByID":                       {"Read Device Enrollment Program Instances"},
+	"GetDeviceEnrollmentTokensExpiringWithin":                 {"Read Device Enrollment Program Instances"},
+	"GetDeviceEnrollments":                                    {"Read Device Enrollment Program Instances"},
+	"GetDeviceEnrollmentsSyncStates":                          {"Read Device Enrollment Program Instances"},
+	"GetDeviceScopeForComputerPrestageByID":                   {"Read Computer PreStage Enrollments"},
+	"GetDirectoryBindingByID":                                 {"Read Directory Bindings"},
+	"GetDirectoryBindingByName":                               {"Read Directory Bindings"},
+	"GetDirectoryBindings":                                    {"Read Directory Bindings"},
+	"GetDiskEncryptionConfigurationByID":                      {"Read Disk Encryption Configurations"},
+	"GetDiskEncryptionConfigurationByName":                    {"Read Disk Encryption Configurations"},
+	"GetDiskEncryptionConfigurations":                         {"Read Disk Encryption Configurations"},
+	"GetDistributionPointByID":                                {"Read Distribution Points"},
+	"GetDistributionPointByName":                              {"Read Distribution Points"},
+	"GetDistributionPointUploaders":                           {"Read Cloud Distribution Point", "Read Distribution Points", "Read Jamf Content Distribution Server Files"},
+	"GetDistributionPoints":                                   {"Read Distribution Points"},
+	"GetDockItemByID":                                         {"Read Dock Items"},
+	"GetDockItemByName":                                       {"Read Dock Items"},
+	"GetDockItems":                                            {"Read Dock Items"},
+	"GetEbookByID":                                            {"Read eBooks"},
+	"GetEbookByName":                                          {"Read eBooks"},
+	"GetEbookByNameAndDataSubset":                             {"Read eBooks"},
+	"GetEbooks":                                               {"Read eBooks"},
+	"GetEnrollmentCustomizationByID":                          {"Read Enrollment Customizations"},
+	"GetEnrollmentCustomizations":                             {"Read Enrollment Customizations"},
+	"GetEnrollmentSettings":                                   {"Read User-Initiated Enrollment"},
+	"GetGSXConnectionInformation":                             {"Read GSX Connection"},
+	"GetHealthCheck":                                          {},
+	"GetIBeaconByID":                                          {"Read iBeacon Regions"},
+	"GetIBeaconByName":                                        {"Read iBeacon Regions"},
+	"GetIBeacons":                                             {"Read iBeacon Regions"},
+	"GetJCDS2PackageURIByName":                                {"Read Jamf Content Distribution Server Files"},
+	"GetJCDS2Packages":                                        {"Read Jamf Content Distribution Server Files"},
+	"GetJCDS2Properties":                                      {"Read Jamf Content Distribution Server Files"},
+	"GetJamfAPIPrivileges":                                    {"Read API Roles"},
+	"GetJamfAPIPrivilegesByName":                              {"Read API Roles"},
+	"GetJamfAPIRoles":                                         {"Read API Roles"},
+	"GetJamfApiRoleByID":                                      {"Read API Roles"},
+	"GetJamfApiRoleByName":                                    {"Read API Roles"},
+	"GetJamfProInformation":                                   {},
+	"GetJamfProServerUrlSettings":                             {"Read Jamf Pro URL"},
+	"GetJamfProVersion":                                       {},
+	"GetJamfProtectIntegrationSettings":                       {"Read Jamf Protect Integration"},
+	"GetLDAPServerByID":                                       {"Read LDAP Servers"},
+	"GetLDAPServerByIDAndGroupDataSubset":                     {"Read LDAP Servers"},
+	"GetLDAPServerByIDAndUserDataSubset":                      {"Read LDAP Servers"},
+	"GetLDAPServerByIDAndUserMembershipInGroupDataSubset":     {"Read LDAP Servers"},
+	"GetLDAPServerByName":                                     {"Read LDAP Servers"},
+	"GetLDAPServerByNameAndGroupDataSubset":                   {"Read LDAP Servers"},
+	"GetLDAPServerByNameAndUserDataSubset":                    {"Read LDAP Servers"},
+	"GetLDAPServerByNameAndUserMembershipInGroupDataSubset":   {"Read LDAP Servers"},
+	"GetLDAPServers":                                          {"Read LDAP Servers"},
+	"GetLicensedSoftware":                                     {"Read Licensed Software"},
+	"GetLicensedSoftwareByID":                                 {"Read Licensed Software"},
+	"GetLicensedSoftwareByName":                               {"Read Licensed Software"},
+	"GetLocalAdminPasswordSettings":                           {"Read Local Admin Password Settings"},
+	"GetMacApplicationByID":                                   {"Read Mac Applications"},
+	"GetMacApplicationByIDAndDataSubset":                      {"Read Mac Applications"},
+	"GetMacApplicationByName":                                 {"Read Mac Applications"},
+	"GetMacApplicationByNameAndDataSubset":                    {"Read Mac Applications"},
+	"GetMacApplications":                                      {"Read Mac Applications"},
+	"GetMacOSConfigurationProfileByID":                        {"Read macOS Configuration Profiles"},
+	"GetMacOSConfigurationProfileByName":                      {"Read macOS Configuration Profiles"},
+	"GetMacOSConfigurationProfileByNameByID":                  {"Read macOS Configuration Profiles"},
+	"GetMacOSConfigurationProfiles":                           {"Read macOS Configuration Profiles"},
+	"GetManagedSoftwareUpdateFeatureToggle":                   {"Read Managed Software Updates"},
+	"GetManagedSoftwareUpdatePlans":                           {"Read Managed Software Updates"},
+	"GetManagedSoftwareUpdatePlansByGroupID":                  {"Read Managed Software Updates"},
+	"GetManagedSoftwareUpdates":                               {"Read Managed Software Updates"},
+	"GetMissingPrivilegesForMethods":                          {},
+	"GetMobileDeviceApplicationByAppBundleID":                 {"Read Mobile Device Apps"},
+	"GetMobileDeviceApplicationByAppBundleIDAndVersion":       {"Read Mobile Device Apps"},
+	"GetMobileDeviceApplicationByID":                          {"Read Mobile Device Apps"},
+	"GetMobileDeviceApplicationByIDAndDataSubset":             {"Read Mobile Device Apps"},
+	"GetMobileDeviceApplicationByName":                        {"Read Mobile Device Apps"},
+	"GetMobileDeviceApplicationByNameAndDataSubset":           {"Read Mobile Device Apps"},
+	"GetMobileDeviceApplications":                             {"Read Mobile Device Apps"},
+	"GetMobileDeviceByID":                                     {"Read Mobile Devices"},
+	"GetMobileDeviceByIDAndDataSubset":                        {"Read Mobile Devices"},
+	"GetMobileDeviceByName":                                   {"Read Mobile Devices"},
+	"GetMobileDeviceByNameAndDataSubset":                      {"Read Mobile Devices"},
+	"GetMobileDeviceConfigurationProfileByID":                 {"Read iOS Configuration Profiles"},
+	"GetMobileDeviceConfigurationProfileByIDWithSubset":       {"Read iOS Configuration Profiles"},
+	"GetMobileDeviceConfigurationProfileByName":               {"Read iOS Configuration Profiles"},
+	"GetMobileDeviceConfigurationProfileByNameWithSubset":     {"Read iOS Configuration Profiles"},
+	"GetMobileDeviceConfigurationProfiles":                    {"Read iOS Configuration Profiles"},
+	"GetMobileDeviceEnrollmentProfileByID":                    {"Read Mobile Device Enrollment Profiles"},
+	"GetMobileDeviceEnrollmentProfileByIDWithSubset":          {"Read Mobile Device Enrollment Profiles"},
+	"GetMobileDeviceEnrollmentProfileByName":                  {"Read Mobile Device Enrollment Profiles"},
+	"GetMobileDeviceEnrollmentProfileByNameWithSubset":        {"Read Mobile Device Enrollment Profiles"},
+	"GetMobileDeviceEnrollmentProfiles":                       {"Read Mobile Device Enrollment Profiles"},
+	"GetMobileDeviceExtensionAttributeValue":                  {"Read Mobile Devices"},
+	"GetMobileDeviceGroupByID":                                {"Read Smart Mobile Device Groups", "Read Static Mobile Device Groups"},
+	"GetMobileDeviceGroupByName":                              {"Read Smart Mobile Device Groups", "Read Static Mobile Device Groups"},
+	"GetMobileDeviceGroups":                                   {"Read Smart Mobile Device Groups", "Read Static Mobile Device Groups"},
+	"GetMobileDevicePrestageByID":                             {"Read Mobile Device PreStage Enrollments"},
+	"GetMobileDevicePrestages":                                {"Read Mobile Device PreStage Enrollments"},
+	"GetMobileDeviceProvisioningProfileByID":                  {"Read Provisioning Profiles"},
+	"GetMobileDeviceProvisioningProfileByName":                {"Read Provisioning Profiles"},
+	"GetMobileDeviceProvisioningProfileByUUID":                {"Read Provisioning Profiles"},
+	"GetMobileDeviceProvisioningProfiles":                     {"Read Provisioning Profiles"},
+	"GetMobileDevices":                                        {"Read Mobile Devices"},
+	"GetMobileExtensionAttributeByID":                         {"Read Mobile Device Extension Attributes"},
+	"GetMobileExtensionAttributeByName":                       {"Read Mobile Device Extension Attributes"},
+	"GetMobileExtensionAttributes":                            {"Read Mobile Device Extension Attributes"},
+	"GetNetworkSegmentByID":                                   {"Read Network Segments"},
+	"GetNetworkSegmentByName":                                 {"Read Network Segments"},
+	"GetNetworkSegments":                                      {"Read Network Segments"},
+	"GetPackageByID":                                          {"Read Packages"},
+	"GetPackageByName":                                        {"Read Packages"},
+	"GetPackageInfoByApplicationV1":                           {"Read Packages"},
+	"GetPackageInfoByApplicationV2":                           {"Read Packages"},
+	"GetPackages":                                             {"Read Packages"},
+	"GetPatchExternalSourceByID":                              {"Read Patch External Source"},
+	"GetPatchExternalSourceByName":                            {"Read Patch External Source"},
+	"GetPatchExternalSources":                                 {"Read Patch External Source"},
+	"GetPatchPolicies":                                        {"Read Patch Policies"},
+	"GetPatchPoliciesByID":                                    {"Read Patch Policies"},
+	"GetPatchPolicyByIDAndDataSubset":                         {"Read Patch Policies"},
+	"GetPatchSoftwareTitleConfigurationById":                  {"Read Patch Management Software Titles"},
+	"GetPatchSoftwareTitleConfigurationByName":                {"Read Patch Management Software Titles"},
+	"GetPatchSoftwareTitleConfigurations":                     {"Read Patch Management Software Titles"},
+	"GetPolicies":                                             {"Read Policies"},
+	"GetPoliciesByType":                                       {"Read Policies"},
+	"GetPolicyByCategory":                                     {"Read Policies"},
+	"GetPolicyByID":                                           {"Read Policies"},
+	"GetPolicyByName":                                         {"Read Policies"},
+	"GetPrinterByID":                                          {"Read Printers"},
+	"GetPrinterByName":                                        {"Read Printers"},
+	"GetPrinters":                                             {"Read Printers"},
+	"GetProfileByInvitation":                                  {"Read Mobile Device Enrollment Profiles"},
+	"GetRemovableMACAddressByID":                              {"Read Removable MAC Address"},
+	"GetRemovableMACAddressByName":                            {"Read Removable MAC Address"},
+	"GetRemovableMACAddresses":                                {"Read Removable MAC Address"},
+	"GetRestrictedSoftwareByID":                               {"Read Restricted Software"},
+	"GetRestrictedSoftwareByName":                             {"Read Restricted Software"},
+	"GetRestrictedSoftwares":                                  {"Read Restricted Software"},
+	"GetReturnToServiceConfigurationByID":                     {"Read Return to Service"},
+	"GetReturnToServiceConfigurationByName":                   {"Read Return to Service"},
+	"GetReturnToServiceConfigurations":                        {"Read Return to Service"},
+	"GetSMTPServerInformation":                                {"Read SMTP Server"},
+	"GetSSOCertificate":                                       {"Read SSO Settings"},
+	"GetSSOFailoverSettings":                                  {"Read SSO Settings"},
+	"GetScriptByID":                                           {"Read Scripts"},
+	"GetScriptByName":                                         {"Read Scripts"},
+	"GetScripts":                                              {"Read Scripts"},
+	"GetSelfServiceBrandingMacOS":                             {"Read Self Service Branding Configuration"},
+	"GetSelfServiceBrandingMacOSByID":                         {"Read Self Service Branding Configuration"},
+	"GetSelfServiceBrandingMacOSByName":                       {"Read Self Service Branding Configuration"},
+	"GetSelfServiceSettings":                                  {"Read Self Service"},
+	"GetSiteByID":                                             {"Read Sites"},
+	"GetSiteByName":                                           {"Read Sites"},
+	"GetSites":                                                {"Read Sites"},
+	"GetSoftwareUpdateServerByID":                             {"Read Software Update Servers"},
+	"GetSoftwareUpdateServerByName":                           {"Read Software Update Servers"},
+	"GetSoftwareUpdateServers":                                {"Read Software Update Servers"},
+	"GetSsoEnrollmentCustomizationDependencies":               {"Read SSO Settings"},
+	"GetSsoSettings":                                          {"Read SSO Settings"},
+	"GetStartupStatus":                                        {},
+	"GetTeamViewerRemoteAdministrationConfigurationByID":      {"Read Remote Administration"},
+	"GetTeamViewerRemoteAdministrationConfigurationByName":    {"Read Remote Administration"},
+	"GetTeamViewerRemoteAdministrationConfigurations":         {"Read Remote Administration"},
+	"GetTeamViewerRemoteAdministrationStatusByID":             {"Read Remote Administration"},
+	"GetTeamViewerSessionByID":                                {"Read Remote Administration"},
+	"GetTeamViewerSessionStatusByID":                          {"Read Remote Administration"},
+	"GetUserByEmail":                                          {"Read Users"},
+	"GetUserByID":                                             {"Read Users"},
+	"GetUserByName":                                           {"Read Users"},
+	"GetUserExtensionAttributeByID":                           {"Read User Extension Attributes"},
+	"GetUserExtensionAttributeByName":                         {"Read User Extension Attributes"},
+	"GetUserExtensionAttributeValue":                          {"Read Users"},
+	"GetUserExtensionAttributes":                              {"Read User Extension Attributes"},
+	"GetUserGroupByID":                                        {"Read Smart User Groups", "Read Static User Groups"},
+	"GetUserGroupByName":                                      {"Read Smart User Groups", "Read Static User Groups"},
+	"GetUserGroups":                                           {"Read Smart User Groups", "Read Static User Groups"},
+	"GetUsers":                                                {"Read Users"},
+	"GetVPPAccountByID":                                       {"Read Volume Purchasing Administrator Accounts"},
+	"GetVPPAccounts":                                          {"Read Volume Purchasing Administrator Accounts"},
+	"GetVPPAssignmentByID":                                    {"Read VPP Assignments"},
+	"GetVPPAssignments":                                       {"Read VPP Assignments"},
+	"GetVenafiByID":                                           {"Read PKI"},
+	"GetVenafiConnectionStatusByID":                           {"Read PKI"},
+	"GetVenafiDependentProfilesByID":                          {"Read PKI"},
+	"GetVenafiHistoryByID":                                    {"Read PKI"},
+	"GetVenafiJamfPublicKeyByID":                              {"Read PKI"},
+	"GetVenafiProxyTrustStoreByID":                            {"Read PKI"},
+	"GetVolumePurchaseLocations":                              {"Read Volume Purchasing Administrator Accounts"},
+	"GetVolumePurchasingContentForLocationByID":               {"Read Volume Purchasing Administrator Accounts"},
+	"GetVolumePurchasingLocationByID":                         {"Read Volume Purchasing Administrator Accounts"},
+	"GetVolumePurchasingSubscriptionByID":                     {"Read Volume Purchasing Subscriptions"},
+	"GetVolumePurchasingSubscriptionByName":                   {"Read Volume Purchasing Subscriptions"},
+	"GetVolumePurchasingSubscriptions":                        {"Read Volume Purchasing Subscriptions"},
+	"GetWebhookByID":                                          {"Read Webhooks"},
+	"GetWebhookByName":                                        {"Read Webhooks"},
+	"GetWebhooks":                                             {"Read Webhooks"},
+	"InitializeCSATokenExchange":                              {"Create Cloud Services Settings"},
+	"InvalidateAuthToken":                                     {},
+	"KeepAliveAuthToken":                                      {},
+	"MirrorJCDS2PackagesToClient":                             {"Read Jamf Content Distribution Server Files"},
+	"MirrorJCDS2PackagesToDirectory":                          {"Read Jamf Content Distribution Server Files"},
+	"NewPolicyBuilder":                                        {},
+	"ParseSSOCertificateKeystore":                             {"Read SSO Settings"},
+	"PingHost":                                                {},
+	"PingResource":                                            {},
+	"PlanAPIClientProvisioning":                               {"Read API Integrations", "Read API Roles"},
+	"PlanScriptSync":                                          {"Read Categories", "Read Scripts"},
+	"ProvisionAPIClient":                                      {"Create API Integrations", "Create API Roles", "Read API Integrations", "Read API Roles", "Update API Integrations", "Update API Roles"},
+	"RefreshCSATokenExchange":                                 {"Update Cloud Services Settings"},
+	"RefreshClientCredentialsByApiRoleID":                     {"Update API Integrations"},
+	"RegenerateSSOCertificate":                                {"Create SSO Settings"},
+	"RegenerateVenafiJamfPublicKeyByID":                       {"Update PKI"},
+	"RemoveComputersFromStaticGroup":                          {"Update Static Computer Groups"},
+	"RemoveComputersFromStaticGroupBySerialNumber":            {"Update Static Computer Groups"},
+	"RemoveMobileDevicesFromStaticGroup":                      {"Update Static Mobile Device Groups"},
+	"RemoveMobileDevicesFromStaticGroupBySerialNumber":        {"Update Static Mobile Device Groups"},
+	"RemoveUsersFromStaticGroup":                              {"Update Static User Groups"},
+	"RemoveUsersFromStaticGroupByUsername":                    {"Update Static User Groups"},
+	"RenewDeviceEnrollmentTokenByID":                          {"Update Device Enrollment Program Instances"},
+	"RenewJCDS2Credentials":                                   {"Create Jamf Content Distribution Server Files"},
+	"RotateAPIClientCredentials":                              {"Read API Integrations", "Update API Integrations"},
+	"SendMDMCommand":                                          {"Send Mobile Device Remote Wipe Command"},
+	"SetComputerExtensionAttributeValue":                      {"Read Computer Extension Attributes", "Update Computers"},
+	"SetComputerExtensionAttributeValueForComputers":          {"Read Computer Extension Attributes", "Update Computers"},
+	"SetComputerExtensionAttributeValues":                     {"Read Computer Extension Attributes", "Update Computers"},
+	"SetMobileDeviceExtensionAttributeValue":                  {"Read Mobile Device Extension Attributes", "Update Mobile Devices"},
+	"SetMobileDeviceExtensionAttributeValueForMobileDevices":  {"Read Mobile Device Extension Attributes", "Update Mobile Devices"},
+	"SetMobileDeviceExtensionAttributeValues":                 {"Read Mobile Device Extension Attributes", "Update Mobile Devices"},
+	"SetUserExtensionAttributeValue":                          {"Read User Extension Attributes", "Update Users"},
+	"SetUserExtensionAttributeValueForUsers":                  {"Read User Extension Attributes", "Update Users"},
+	"SetUserExtensionAttributeValues":                         {"Read User Extension Attributes", "Update Users"},
+	"SyncScripts":                                             {"Create Scripts", "Delete Scripts", "Read Categories", "Read Scripts", "Update Scripts"},
+	"UpdateADUESessionTokenSettings":                          {"Update User-Initiated Enrollment"},
+	"UpdateAccountByID":                                       {"Update Accounts"},
+	"UpdateAccountByName":                                     {"Update Accounts"},
+	"UpdateAccountDrivenUserEnrollmentAccessGroupByID":        {"Update User-Initiated Enrollment"},
+	"UpdateAccountDrivenUserEnrollmentAccessGroupByName":      {"Read User-Initiated Enrollment", "Update User-Initiated Enrollment"},
+	"UpdateAccountGroupByID":                                  {"Update Accounts"},
+	"UpdateAccountGroupByName":                                {"Update Accounts"},
+	"UpdateAccountPreferences":                                {"Update User-Initiated Enrollment"},
+	"UpdateActivationCode":                                    {"Create Activation Code"},
+	"UpdateAdvancedComputerSearchByID":                        {"Update Advanced Computer Searches"},
+	"UpdateAdvancedComputerSearchByName":                      {"Update Advanced Computer Searches"},
+	"UpdateAdvancedMobileDeviceSearchByID":                    {"Update Advanced Mobile Device Searches"},
+	"UpdateAdvancedMobileDeviceSearchByName":                  {"Update Advanced Mobile Device Searches"},
+	"UpdateAdvancedUserSearchByID":                            {"Update Advanced User Searches"},
+	"UpdateAdvancedUserSearchByName":                          {"Update Advanced User Searches"},
+	"UpdateApiIntegrationByID":                                {"Update API Integrations"},
+	"UpdateApiIntegrationByName":                              {"Read API Integrations", "Update API Integrations"},
+	"UpdateBYOProfileByID":                                    {"Update Personal Device Profiles"},
+	"UpdateBYOProfileByName":                                  {"Update Personal Device Profiles"},
+	"UpdateBuildingByID":                                      {"Update Buildings"},
+	"UpdateBuildingByName":                                    {"Read Buildings", "Update Buildings"},
+	"UpdateCacheSettings":                                     {"Update Cache"},
+	"UpdateCategoryByID":                                      {"Update Categories"},
+	"UpdateCategoryByName":                                    {"Read Categories", "Update Categories"},
+	"UpdateClassByID":                                         {"Update Classes"},
+	"UpdateClassByName":                                       {"Update Classes"},
+	"UpdateClientCheckinSettings":                             {},
+	"UpdateCloudIdentityProviderByID":                         {"Update LDAP Servers"},
+	"UpdateComputerByID":                                      {"Update Computers"},
+	"UpdateComputerByName":                                    {"Update Computers"},
+	"UpdateComputerCheckinInformation":                        {"Update Computer Check-In"},
+	"UpdateComputerExtensionAttributeByID":                    {"Update Computer Extension Attributes"},
+	"UpdateComputerExtensionAttributeByName":                  {"Update Computer Extension Attributes"},
+	"UpdateComputerGroupByID":                                 {"Update Smart Computer Groups", "Update Static Computer Groups"},
+	"UpdateComputerGroupByName":                               {"Update Smart Computer Groups", "Update Static Computer Groups"},
+	"UpdateComputerInventoryByID":                             {"Update Computers"},
+	"UpdateComputerInventoryCollectionInformation":            {"Update Computer Inventory Collection"},
+	"UpdateComputerInventoryCollectionSettings":               {"Update Computer Inventory Collection"},
+	"UpdateComputerPrestageByID":                              {"Update Computer PreStage Enrollments"},
+	"UpdateComputerPrestageByName":                            {"Read Computer PreStage Enrollments", "Update Computer PreStage Enrollments"},
+	"UpdateDepartmentByID":                                    {"Update Departments"},
+	"UpdateDepartmentByName":                                  {"Read Departments", "Update Departments"},
+	"UpdateDeviceCommunicationSettings":                       {"Update Automatically Renew MDM Profile Settings"},
+	"UpdateDeviceEnrollmentByID":                              {"Update Device Enrollment Program Instances"},
+	"UpdateDeviceEnrollmentTokenByID":                         {"Update Device Enrollment Program Instances"},
+	"UpdateDirectoryBindingByID":                              {"Update Directory Bindings"},
+	"UpdateDirectoryBindingByName":                            {"Update Directory Bindings"},
+	"UpdateDiskEncryptionConfigurationByID":                   {"Update Disk Encryption Configurations"},
+	"UpdateDiskEncryptionConfigurationByName":                 {"Update Disk Encryption Configurations"},
+	"UpdateDistributionPointByID":                             {"Update Distribution Points"},
+	"UpdateDistributionPointByName":                           {"Update Distribution Points"},
+	"UpdateDockItemByID":                                      {"Update Dock Items"},
+	"UpdateDockItemByName":                                    {"Update Dock Items"},
+	"UpdateEbookByID":                                         {"Update eBooks"},
+	"UpdateEbookByName":                                       {"Update eBooks"},
+	"UpdateEnrollmentCustomizationByID":                       {"Update Enrollment Customizations"},
+	"UpdateEnrollmentSettings":                                {"Update User-Initiated Enrollment"},
+	"UpdateExternalPatchSourceByID":                           {"Create Patch External Source"},
+	"UpdateExternalPatchSourceByName":                         {"Update Patch External Source"},
+	"UpdateFailoverUrl":                                       {"Create SSO Settings"},
+	"UpdateGSXConnectionInformation":                          {"Update GSX Connection"},
+	"UpdateIBeaconByID":                                       {"Update iBeacon Regions"},
+	"UpdateIBeaconByName":                                     {"Update iBeacon Regions"},
+	"UpdateJamfApiRoleByID":                                   {"Update API Roles"},
+	"UpdateJamfApiRoleByName":                                 {"Read API Roles", "Update API Roles"},
+	"UpdateJamfProServerUrlSettings":                          {"Update Jamf Pro URL"},
+	"UpdateJamfProtectIntegrationSettings":                    {"Update Jamf Protect Integration"},
+	"UpdateLDAPServerByID":                                    {"Update LDAP Servers"},
+	"UpdateLDAPServerByName":                                  {"Update LDAP Servers"},
+	"UpdateLicensedSoftwareByID":                              {"Update Licensed Software"},
+	"UpdateLicensedSoftwareByName":                            {"Update Licensed Software"},
+	"UpdateLocalAdminPasswordSettings":                        {"Update Local Admin Password Settings"},
+	"UpdateMacApplicationByID":                                {"Update Mac Applications"},
+	"UpdateMacApplicationByName":                              {"Update Mac Applications"},
+	"UpdateMacOSConfigurationProfileByID":                     {"Update macOS Configuration Profiles"},
+	"UpdateMacOSConfigurationProfileByName":                   {"Update macOS Configuration Profiles"},
+	"UpdateManagedSoftwareUpdateFeatureToggle":                {"Update Managed Software Updates"},
+	"UpdateMobileDeviceApplicationByApplicationBundleID":      {"Update Mobile Device Apps"},
+	"UpdateMobileDeviceApplicationByID":                       {"Update Mobile Device Apps"},
+	"UpdateMobileDeviceApplicationByIDAndAppVersion":          {"Update Mobile Device Apps"},
+	"UpdateMobileDeviceApplicationByName":                     {"Update Mobile Device Apps"},
+	"UpdateMobileDeviceByID":                                  {"Update Mobile Devices"},
+	"UpdateMobileDeviceByName":                                {"Update Mobile Devices"},
+	"UpdateMobileDeviceConfigurationProfileByID":              {"Update iOS Configuration Profiles"},
+	"UpdateMobileDeviceConfigurationProfileByName":            {"Update iOS Configuration Profiles"},
+	"UpdateMobileDeviceEnrollmentProfileByID":                 {"Update Mobile Device Enrollment Profiles"},
+	"UpdateMobileDeviceEnrollmentProfileByInvitation":         {"Update Mobile Device Enrollment Profiles"},
+	"UpdateMobileDeviceEnrollmentProfileByName":               {"Update Mobile Device Enrollment Profiles"},
+	"UpdateMobileDeviceGroupByID":                             {"Update Smart Mobile Device Groups", "Update Static Mobile Device Groups"},
+	"UpdateMobileDeviceGroupByName":                           {"Update Smart Mobile Device Groups", "Update Static Mobile Device Groups"},
+	"UpdateMobileDeviceProvisioningProfileByID":               {"Update Provisioning Profiles"},
+	"UpdateMobileDeviceProvisioningProfileByName":             {"Update Provisioning Profiles"},
+	"UpdateMobileDeviceProvisioningProfileByUUID":             {"Update Provisioning Profiles"},
+	"UpdateMobileExtensionAttributeByID":                      {"Update Mobile Device Extension Attributes"},
+	"UpdateMobileExtensionAttributeByName":                    {"Update Mobile Device Extension Attributes"},
+	"UpdateNetworkSegmentByID":                                {"Update Network Segments"},
+	"UpdateNetworkSegmentByName":                              {"Update Network Segments"},
+	"UpdatePackageByID":                                       {"Update Packages"},
+	"UpdatePackageByName":                                     {"Update Packages"},
+	"UpdatePatchPolicy":                                       {"Update Patch Policies"},
+	"UpdatePatchSoftwareTitleConfigurationById":               {"Update Patch Management Software Titles"},
+	"UpdatePolicyByID":                                        {"Update Policies"},
+	"UpdatePolicyByName":                                      {"Update Policies"},
+	"UpdatePrinterByID":                                       {"Update Printers"},
+	"UpdatePrinterByName":                                     {"Update Printers"},
+	"UpdateRemovableMACAddressByID":                           {"Update Removable MAC Address"},
+	"UpdateRemovableMACAddressByName":                         {"Update Removable MAC Address"},
+	"UpdateRestrictedSoftwareByID":                            {"Update Restricted Software"},
+	"UpdateRestrictedSoftwareByName":                          {"Update Restricted Software"},
+	"UpdateReturnToServiceConfigurationByID":                  {"Update Return to Service"},
+	"UpdateSMTPServerInformation":                             {"Update SMTP Server"},
+	"UpdateSSOCertificate":                                    {"Update SSO Settings"},
+	"UpdateScriptByID":                                        {"Update Scripts"},
+	"UpdateScriptByName":                                      {"Read Scripts", "Update Scripts"},
+	"UpdateSelfServiceBrandingMacOSByID":                      {"Update Self Service Branding Configuration"},
+	"UpdateSelfServiceBrandingMacOSByName":                    {"Read Self Service Branding Configuration", "Update Self Service Branding Configuration"},
+	"UpdateSelfServiceSettings":                               {"Update Self Service"},
+	"UpdateSiteByID":                                          {"Update Sites"},
+	"UpdateSiteByName":                                        {"Update Sites"},
+	"UpdateSoftwareUpdateServerByID":                          {"Update Software Update Servers"},
+	"UpdateSoftwareUpdateServerByName":                        {"Update Software Update Servers"},
+	"UpdateSsoSettings":                                       {"Update SSO Settings"},
+	"UpdateTeamViewerRemoteAdministrationConfigurationByID":   {"Update Remote Administration"},
+	"UpdateUserByEmail":                                       {"Update Users"},
+	"UpdateUserByID":                                          {"Update Users"},
+	"UpdateUserByName":                                        {"Update Users"},
+	"UpdateUserExtensionAttributeByID":                        {"Update User Extension Attributes"},
+	"UpdateUserExtensionAttributeByName":                      {"Update User Extension Attributes"},
+	"UpdateUserGroupByID":                                     {"Update Smart User Groups", "Update Static User Groups"},
+	"UpdateUserGroupByName":                                   {"Update Smart User Groups", "Update Static User Groups"},
+	"UpdateVPPAccountByID":                                    {"Update Volume Purchasing Administrator Accounts"},
+	"UpdateVPPAssignmentByID":                                 {"Update VPP Assignments"},
+	"UpdateVenafiByID":                                        {"Update PKI"},
+	"UpdateVolumePurchasingLocationByID":                      {"Update Volume Purchasing Administrator Accounts"},
+	"UpdateVolumePurchasingSubscriptionByID":                  {"Update Volume Purchasing Subscriptions"},
+	"UpdateVolumePurchasingSubscriptionByName":                {"Read Volume Purchasing Subscriptions", "Update Volume Purchasing Subscriptions"},
+	"UpdateWebhookByID":                                       {"Update Webhooks"},
+	"UpdateWebhookByName":                                     {"Update Webhooks"},
+	"UploadAttachmentAndAssignToComputerByID":                 {"Create Computers"},
+	"UploadIcon":                                              {"Create Icons"},
+	"UploadIconFromReader":                                    {"Create Icons"},
+	"UploadJCDS2PackageFromFile":                              {"Create Jamf Content Distribution Server Files", "Read Jamf Content Distribution Server Files"},
+	"UploadJCDS2PackageFromReader":                            {"Create Jamf Content Distribution Server Files", "Read Jamf Content Distribution Server Files"},
+	"UploadPackageToDistributionPoints":                       {"Read Cloud Distribution Point", "Read Distribution Points", "Read Jamf Content Distribution Server Files"},
+	"UploadPackageToDistributionPointsWithContext":            {"Read Cloud Distribution Point", "Read Distribution Points", "Read Jamf Content Distribution Server Files"},
+	"UploadSSOCertificateKeystore":                            {"Read SSO Settings", "Update SSO Settings"},
+	"UploadVenafiProxyTrustStoreByID":                         {"Create PKI"},
+	"ValidateCloudLdapKeystore":                               {"Read LDAP Servers"},
+	"VerifyPackage":                                           {"Read Jamf Content Distribution Server Files", "Read Packages"},
+}
+
+// endpointPrivilegeTable maps the endpoints requested by the SDK to the privileges they need.
+var endpointPrivilegeTable = []endpointPrivileges{
+	{Method: "GET", Path: "/JSSResource/accounts", Privileges: []string{"Read Accounts"}},
+	{Method: "DELETE", Path: "/JSSResource/accounts/groupid/{param}", Privileges: []string{"Delete Accounts"}},
+	{Method: "GET", Path: "/JSSResource/accounts/groupid/{param}", Privileges: []string{"Read Accounts"}},
+	{Method: "POST", Path: "/JSSResource/accounts/groupid/{param}", Privileges: []string{"Create Accounts"}},
+	{Method: "PUT", Path: "/JSSResource/accounts/groupid/{param}", Privileges: []string{"Update Accounts"}},
+	{Method: "DELETE", Path: "/JSSResource/accounts/groupname/{param}", Privileges: []string{"Delete Accounts"}},
+	{Method: "GET", Path: "/JSSResource/accounts/groupname/{param}", Privileges: []string{"Read Accounts"}},
+	{Method: "PUT", Path: "/JSSResource/accounts/groupname/{param}", Privileges: []string{"Update Accounts"}},
+	{Method: "DELETE", Path: "/JSSResource/accounts/userid/{param}", Privileges: []string{"Delete Accounts"}},
+	{Method: "GET", Path: "/JSSResource/accounts/userid/{param}", Privileges: []string{"Read Accounts"}},
+	{Method: "POST", Path: "/JSSResource/accounts/userid/{param}", Privileges: []string{"Create Accounts"}},
+	{Method: "PUT", Path: "/JSSResource/accounts/userid/{param}", Privileges: []string{"Update Accounts"}},
+	{Method: "DELETE", Path: "/JSSResource/accounts/username/{param}", Privileges: []string{"Delete Accounts"}},
+	{Method: "GET", Path: "/JSSResource/accounts/username/{param}", Privileges: []string{"Read Accounts"}},
+	{Method: "PUT", Path: "/JSSResource/accounts/username/{param}", Privileges: []string{"Update Accounts"}},
+	{Method: "GET", Path: "/JSSResource/activationcode", Privileges: []string{"Read Activation Code"}},
+	{Method: "POST", Path: "/JSSResource/activationcode", Privileges: []string{"Create Activation Code"}},
+	{Method: "GET", Path: "/JSSResource/advancedcomputersearches", Privileges: []string{"Read Advanced Computer Searches"}},
+	{Method: "POST", Path: "/JSSResource/advancedcomputersearches", Privileges: []string{"Create Advanced Computer Searches"}},
+	{Method: "DELETE", Path: "/JSSResource/advancedcomputersearches/id/{param}", Privileges: []string{"Delete Advanced Computer Searches"}},
+	{Method: "GET", Path: "/JSSResource/advancedcomputersearches/id/{param}", Privileges: []string{"Read Advanced Computer Searches"}},
+	{Method: "PUT", Path: "/JSSResource/advancedcomputersearches/id/{param}", Privileges: []string{"Update Advanced Computer Searches"}},
+	{Method: "DELETE", Path: "/JSSResource/advancedcomputersearches/name/{param}", Privileges: []string{"Delete Advanced Computer Searches"}},
+	{Method: "GET", Path: "/JSSResource/advancedcomputersearches/name/{param}", Privileges: []string{"Read Advanced Computer Searches"}},
+	{Method: "PUT", Path: "/JSSResource/advancedcomputersearches/name/{param}", Privileges: []string{"Update Advanced Computer Searches"}},
+	{Method: "GET", Path: "/JSSResource/advancedmobiledevicesearches", Privileges: []string{"Read Advanced Mobile Device Searches"}},
+	{Method: "POST", Path: "/JSSResource/advancedmobiledevicesearches", Privileges: []string{"Create Advanced Mobile Device Searches"}},
+	{Method: "DELETE", Path: "/JSSResource/advancedmobiledevicesearches/id/{param}", Privileges: []string{"Delete Advanced Mobile Device Searches"}},
+	{Method: "GET", Path: "/JSSResource/advancedmobiledevicesearches/id/{param}", Privileges: []string{"Read Advanced Mobile Device Searches"}},
+	{Method: "PUT", Path: "/JSSResource/advancedmobiledevicesearches/id/{param}", Privileges: []string{"Update Advanced Mobile Device Searches"}},
+	{Method: "DELETE", Path: "/JSSResource/advancedmobiledevicesearches/name/{param}", Privileges: []string{"Delete Advanced Mobile Device Searches"}},
+	{Method: "GET", Path: "/JSSResource/advancedmobiledevicesearches/name/{param}", Privileges: []string{"Read Advanced Mobile Device Searches"}},
+	{Method: "PUT", Path: "/JSSResource/advancedmobiledevicesearches/name/{param}", Privileges: []string{"Update Advanced Mobile Device Searches"}},
+	{Method: "GET", Path: "/JSSResource/advancedusersearches", Privileges: []string{"Read Advanced User Searches"}},
+	{Method: "POST", Path: "/JSSResource/advancedusersearches", Privileges: []string{"Create Advanced User Searches"}},
+	{Method: "DELETE", Path: "/JSSResource/advancedusersearches/id/{param}", Privileges: []string{"Delete Advanced User Searches"}},
+	{Method: "GET", Path: "/JSSResource/advancedusersearches/id/{param}", Privileges: []string{"Read Advanced User Searches"}},
+	{Method: "PUT", Path: "/JSSResource/advancedusersearches/id/{param}", Privileges: []string{"Update Advanced User Searches"}},
+	{Method: "DELETE", Path: "/JSSResource/advancedusersearches/name/{param}", Privileges: []string{"Delete Advanced User Searches"}},
+	{Method: "GET", Path: "/JSSResource/advancedusersearches/name/{param}", Privileges: []string{"Read Advanced User Searches"}},
+	{Method: "PUT", Path: "/JSSResource/advancedusersearches/name/{param}", Privileges: []string{"Update Advanced User Searches"}},
+	{Method: "GET", Path: "/JSSResource/allowedfileextensions", Privileges: []string{"Read Allowed File Extensions"}},
+	{Method: "GET", Path: "/JSSResource/allowedfileextensions/extension/{param}", Privileges: []string{"Read Allowed File Extensions"}},
+	{Method: "POST", Path: "/JSSResource/allowedfileextensions/id/0", Privileges: []string{"Create Allowed File Extensions"}},
+	{Method: "DELETE", Path: "/JSSResource/allowedfileextensions/id/{param}", Privileges: []string{"Delete Allowed File Extensions"}},
+	{Method: "GET", Path: "/JSSResource/allowedfileextensions/id/{param}", Privileges: []string{"Read Allowed File Extensions"}},
+	{Method: "GET", Path: "/JSSResource/byoprofiles", Privileges: []string{"Read Personal Device Profiles"}},
+	{Method: "POST", Path: "/JSSResource/byoprofiles/id/0", Privileges: []string{"Create Personal Device Profiles"}},
+	{Method: "DELETE", Path: "/JSSResource/byoprofiles/id/{param}", Privileges: []string{"Delete Personal Device Profiles"}},
+	{Method: "GET", Path: "/JSSResource/byoprofiles/id/{param}", Privileges: []string{"Read Personal Device Profiles"}},
+	{Method: "PUT", Path: "/JSSResource/byoprofiles/id/{param}", Privileges: []string{"Update Personal Device Profiles"}},
+	{Method: "DELETE", Path: "/JSSResource/byoprofiles/name/{param}", Privileges: []string{"Delete Personal Device Profiles"}},
+	{Method: "GET", Path: "/JSSResource/byoprofiles/name/{param}", Privileges: []string{"Read Personal Device Profiles"}},
+	{Method: "PUT", Path: "/JSSResource/byoprofiles/name/{param}", Privileges: []string{"Update Personal Device Profiles"}},
+	{Method: "GET", Path: "/JSSResource/classes", Privileges: []string{"Read Classes"}},
+	{Method: "POST", Path: "/JSSResource/classes", Privileges: []string{"Create Classes"}},
+	{Method: "DELETE", Path: "/JSSResource/classes/id/{param}", Privileges: []string{"Delete Classes"}},
+	{Method: "GET", Path: "/JSSResource/classes/id/{param}", Privileges: []string{"Read Classes"}},
+	{Method: "PUT", Path: "/JSSResource/classes/id/{param}", Privileges: []string{"Update Classes"}},
+	{Method: "DELETE", Path: "/JSSResource/classes/name/{param}", Privileges: []string{"Delete Classes"}},
+	{Method: "GET", Path: "/JSSResource/classes/name/{param}", Privileges: []string{"Read Classes"}},
+	{Method: "PUT", Path: "/JSSResource/classes/name/{param}", Privileges: []string{"Update Classes"}},
+	{Method: "GET", Path: "/JSSResource/computercheckin", Privileges: []string{"Read Computer Check-In"}},
+	{Method: "PUT", Path: "/JSSResource/computercheckin", Privileges: []string{"Update Computer Check-In"}},
+	{Method: "GET", Path: "/JSSResource/computerextensionattributes", Privileges: []string{"Read Computer Extension Attributes"}},
+	{Method: "POST", Path: "/JSSResource/computerextensionattributes/id/0", Privileges: []string{"Create Computer Extension Attributes"}},
+	{Method: "DELETE", Path: "/JSSResource/computerextensionattributes/id/{param}", Privileges: []string{"Delete Computer Extension Attributes"}},
+	{Method: "GET", Path: "/JSSResource/computerextensionattributes/id/{param}", Privileges: []string{"Read Computer Extension Attributes"}},
+	{Method: "PUT", Path: "/JSSResource/computerextensionattributes/id/{param}", Privileges: []string{"Update Computer Extension Attributes"}},
+	{Method: "GET", Path: "/JSSResource/computerextensionattributes/name/{param}", Privileges: []string{"Read Computer Extension Attributes"}},
+	{Method: "PUT", Path: "/JSSResource/computerextensionattributes/name/{param}", Privileges: []string{"Update Computer Extension Attributes"}},
+	{Method: "GET", Path: "/JSSResource/computergroups", Privileges: []string{"Read Smart Computer Groups", "Read Static Computer Groups"}},
+	{Method: "POST", Path: "/JSSResource/computergroups", Privileges: []string{"Create Smart Computer Groups", "Create Static Computer Groups"}},
+	{Method: "DELETE", Path: "/JSSResource/computergroups/id/{param}", Privileges: []string{"Delete Smart Computer Groups", "Delete Static Computer Groups"}},
+	{Method: "GET", Path: "/JSSResource/computergroups/id/{param}", Privileges: []string{"Read Smart Computer Groups", "Read Static Computer Groups"}},
+	{Method: "PUT", Path: "/JSSResource/computergroups/id/{param}", Privileges: []string{"Update Smart Computer Groups", "Update Static Computer Groups"}},
+	{Method: "DELETE", Path: "/JSSResource/computergroups/name/{param}", Privileges: []string{"Delete Smart Computer Groups", "Delete Static Computer Groups"}},
+	{Method: "GET", Path: "/JSSResource/computergroups/name/{param}", Privileges: []string{"Read Smart Computer Groups", "Read Static Computer Groups"}},
+	{Method: "PUT", Path: "/JSSResource/computergroups/name/{param}", Privileges: []string{"Update Smart Computer Groups", "Update Static Computer Groups"}},
+	{Method: "GET", Path: "/JSSResource/computerinventorycollection", Privileges: []string{"Read Computer Inventory Collection"}},
+	{Method: "PUT", Path: "/JSSResource/computerinventorycollection", Privileges: []string{"Update Computer Inventory Collection"}},
+	{Method: "GET", Path: "/JSSResource/computerinvitations", Privileges: []string{"Read Computer Invitations"}},
+	{Method: "POST", Path: "/JSSResource/computerinvitations/id/0", Privileges: []string{"Create Computer Invitations"}},
+	{Method: "DELETE", Path: "/JSSResource/computerinvitations/id/{param}", Privileges: []string{"Delete Computer Invitations"}},
+	{Method: "GET", Path: "/JSSResource/computerinvitations/id/{param}", Privileges: []string{"Read Computer Invitations"}},
+	{Method: "GET", Path: "/JSSResource/computerinvitations/invitation/{param}", Privileges: []string{"Read Computer Invitations"}},
+	{Method: "GET", Path: "/JSSResource/computers", Privileges: []string{"Read Computers"}},
+	{Method: "POST", Path: "/JSSResource/computers", Privileges: []string{"Create Computers"}},
+	{Method: "DELETE", Path: "/JSSResource/computers/id/{param}", Privileges: []string{"Delete Computers"}},
+	{Method: "GET", Path: "/JSSResource/computers/id/{param}", Privileges: []string{"Read Computers"}},
+	{Method: "PUT", Path: "/JSSResource/computers/id/{param}", Privileges: []string{"Update Computers"}},
+	{Method: "GET", Path: "/JSSResource/computers/id/{param}/subset/ExtensionAttributes", Privileges: []string{"Read Computers"}},
+	{Method: "DELETE", Path: "/JSSResource/computers/name/{param}", Privileges: []string{"Delete Computers"}},
+	{Method: "GET", Path: "/JSSResource/computers/name/{param}", Privileges: []string{"Read Computers"}},
+	{Method: "PUT", Path: "/JSSResource/computers/name/{param}", Privileges: []string{"Update Computers"}},
+	{Method: "GET", Path: "/JSSResource/directorybindings", Privileges: []string{"Read Directory Bindings"}},
+	{Method: "POST", Path: "/JSSResource/directorybindings/id/0", Privileges: []string{"Create Directory Bindings"}},
+	{Method: "DELETE", Path: "/JSSResource/directorybindings/id/{param}", Privileges: []string{"Delete Directory Bindings"}},
+	{Method: "GET", Path: "/JSSResource/directorybindings/id/{param}", Privileges: []string{"Read Directory Bindings"}},
+	{Method: "PUT", Path: "/JSSResource/directorybindings/id/{param}", Privileges: []string{"Update Directory Bindings"}},
+	{Method: "DELETE", Path: "/JSSResource/directorybindings/name/{param}", Privileges: []string{"Delete Directory Bindings"}},
+	{Method: "GET", Path: "/JSSResource/directorybindings/name/{param}", Privileges: []string{"Read Directory Bindings"}},
+	{Method: "PUT", Path: "/JSSResource/directorybindings/name/{param}", Privileges: []string{"Update Directory Bindings"}},
+	{Method: "GET", Path: "/JSSResource/diskencryptionconfigurations", Privileges: []string{"Read Disk Encryption Configurations"}},
+	{Method: "POST", Path: "/JSSResource/diskencryptionconfigurations/id/0", Privileges: []string{"Create Disk Encryption Configurations"}},
+	{Method: "DELETE", Path: "/JSSResource/diskencryptionconfigurations/id/{param}", Privileges: []string{"Delete Disk Encryption Configurations"}},
+	{Method: "GET", Path: "/JSSResource/diskencryptionconfigurations/id/{param}", Privileges: []string{"Read Disk Encryption Configurations"}},
+	{Method: "PUT", Path: "/JSSResource/diskencryptionconfigurations/id/{param}", Privileges: []string{"Update Disk Encryption Configurations"}},
+	{Method: "DELETE", Path: "/JSSResource/diskencryptionconfigurations/name/{param}", Privileges: []string{"Delete Disk Encryption Configurations"}},
+	{Method: "GET", Path: "/JSSResource/diskencryptionconfigurations/name/{param}", Privileges: []string{"Read Disk Encryption Configurations"}},
+	{Method: "PUT", Path: "/JSSResource/diskencryptionconfigurations/name/{param}", Privileges: []string{"Update Disk Encryption Configurations"}},
+	{Method: "GET", Path: "/JSSResource/distributionpoints", Privileges: []string{"Read Distribution Points"}},
+	{Method: "POST", Path: "/JSSResource/distributionpoints/id/0", Privileges: []string{"Create Distribution Points"}},
+	{Method: "DELETE", Path: "/JSSResource/distributionpoints/id/{param}", Privileges: []string{"Delete Distribution Points"}},
+	{Method: "GET", Path: "/JSSResource/distributionpoints/id/{param}", Privileges: []string{"Read Distribution Points"}},
+	{Method: "PUT", Path: "/JSSResource/distributionpoints/id/{param}", Privileges: []string{"Update Distribution Points"}},
+	{Method: "DELETE", Path: "/JSSResource/distributionpoints/name/{param}", Privileges: []string{"Delete Distribution Points"}},
+	{Method: "GET", Path: "/JSSResource/distributionpoints/name/{param}", Privileges: []string{"Read Distribution Points"}},
+	{Method: "PUT", Path: "/JSSResource/distributionpoints/name/{param}", Privileges: []string{"Update Distribution Points"}},
+	{Method: "GET", Path: "/JSSResource/dockitems", Privileges: []string{"Read Dock Items"}},
+	{Method: "POST", Path: "/JSSResource/dockitems/id/0", Privileges: []string{"Create Dock Items"}},
+	{Method: "DELETE", Path: "/JSSResource/dockitems/id/{param}", Privileges: []string{"Delete Dock Items"}},
+	{Method: "GET", Path: "/JSSResource/dockitems/id/{param}", Privileges: []string{"Read Dock Items"}},
+	{Method: "PUT", Path: "/JSSResource/dockitems/id/{param}", Privileges: []string{"Update Dock Items"}},
+	{Method: "DELETE", Path: "/JSSResource/dockitems/name/{param}", Privileges: []string{"Delete Dock Items"}},
+	{Method: "GET", Path: "/JSSResource/dockitems/name/{param}", Privileges: []string{"Read Dock Items"}},
+	{Method: "PUT", Path: "/JSSResource/dockitems/name/{param}", Privileges: []string{"Update Dock Items"}},
+	{Method: "GET", Path: "/JSSResource/ebooks", Privileges: []string{"Read eBooks"}},
+	{Method: "POST", Path: "/JSSResource/ebooks/id/0", Privileges: []string{"Create eBooks"}},
+	{Method: "DELETE", Path: "/JSSResource/ebooks/id/{param}", Privileges: []string{"Delete eBooks"}},
+	{Method: "GET", Path: "/JSSResource/ebooks/id/{param}", Privileges: []string{"Read eBooks"}},
+	{Method: "PUT", Path: "/JSSResource/ebooks/id/{param}", Privileges: []string{"Update eBooks"}},
+	{Method: "DELETE", Path: "/JSSResource/ebooks/name/{param}", Privileges: []string{"Delete eBooks"}},
+	{Method: "GET", Path: "/JSSResource/ebooks/name/{param}", Privileges: []string{"Read eBooks"}},
+	{Method: "PUT", Path: "/JSSResource/ebooks/name/{param}", Privileges: []string{"Update eBooks"}},
+	{Method: "GET", Path: "/JSSResource/ebooks/name/{param}/subset/{param}", Privileges: []string{"Read eBooks"}},
+	{Method: "POST", Path: "/JSSResource/fileuploads/{param}/{param}/{param}", Privileges: []string{"Create File Attachments"}},
+	{Method: "GET", Path: "/JSSResource/gsxconnection", Privileges: []string{"Read GSX Connection"}},
+	{Method: "PUT", Path: "/JSSResource/gsxconnection", Privileges: []string{"Update GSX Connection"}},
+	{Method: "GET", Path: "/JSSResource/ibeacons", Privileges: []string{"Read iBeacon Regions"}},
+	{Method: "POST", Path: "/JSSResource/ibeacons/id/0", Privileges: []string{"Create iBeacon Regions"}},
+	{Method: "DELETE", Path: "/JSSResource/ibeacons/id/{param}", Privileges: []string{"Delete iBeacon Regions"}},
+	{Method: "GET", Path: "/JSSResource/ibeacons/id/{param}", Privileges: []string{"Read iBeacon Regions"}},
+	{Method: "PUT", Path: "/JSSResource/ibeacons/id/{param}", Privileges: []string{"Update iBeacon Regions"}},
+	{Method: "DELETE", Path: "/JSSResource/ibeacons/name/{param}", Privileges: []string{"Delete iBeacon Regions"}},
+	{Method: "GET", Path: "/JSSResource/ibeacons/name/{param}", Privileges: []string{"Read iBeacon Regions"}},
+	{Method: "PUT", Path: "/JSSResource/ibeacons/name/{param}", Privileges: []string{"Update iBeacon Regions"}},
+	{Method: "GET", Path: "/JSSResource/ldapservers", Privileges: []string{"Read LDAP Servers"}},
+	{Method: "POST", Path: "/JSSResource/ldapservers/id/0", Privileges: []string{"Create LDAP Servers"}},
+	{Method: "DELETE", Path: "/JSSResource/ldapservers/id/{param}", Privileges: []string{"Delete LDAP Servers"}},
+	{Method: "GET", Path: "/JSSResource/ldapservers/id/{param}", Privileges: []string{"Read LDAP Servers"}},
+	{Method: "PUT", Path: "/JSSResource/ldapservers/id/{param}", Privileges: []string{"Update LDAP Servers"}},
+	{Method: "GET", Path: "/JSSResource/ldapservers/id/{param}/group/{param}", Privileges: []string{"Read LDAP Servers"}},
+	{Method: "GET", Path: "/JSSResource/ldapservers/id/{param}/group/{param}/user/{param}", Privileges: []string{"Read LDAP Servers"}},
+	{Method: "GET", Path: "/JSSResource/ldapservers/id/{param}/user/{param}", Privileges: []string{"Read LDAP Servers"}},
+	{Method: "DELETE", Path: "/JSSResource/ldapservers/name/{param}", Privileges: []string{"Delete LDAP Servers"}},
+	{Method: "GET", Path: "/JSSResource/ldapservers/name/{param}", Privileges: []string{"Read LDAP Servers"}},
+	{Method: "PUT", Path: "/JSSResource/ldapservers/name/{param}", Privileges: []string{"Update LDAP Servers"}},
+	{Method: "GET", Path: "/JSSResource/ldapservers/name/{param}/group/{param}", Privileges: []string{"Read LDAP Servers"}},
+	{Method: "GET", Path: "/JSSResource/ldapservers/name/{param}/group/{param}/user/{param}", Privileges: []string{"Read LDAP Servers"}},
+	{Method: "GET", Path: "/JSSResource/ldapservers/name/{param}/user/{param}", Privileges: []string{"Read LDAP Servers"}},
+	{Method: "GET", Path: "/JSSResource/licensedsoftware", Privileges: []string{"Read Licensed Software"}},
+	{Method: "POST", Path: "/JSSResource/licensedsoftware/id/0", Privileges: []string{"Create Licensed Software"}},
+	{Method: "DELETE", Path: "/JSSResource/licensedsoftware/id/{param}", Privileges: []string{"Delete Licensed Software"}},
+	{Method: "GET", Path: "/JSSResource/licensedsoftware/id/{param}", Privileges: []string{"Read Licensed Software"}},
+	{Method: "PUT", Path: "/JSSResource/licensedsoftware/id/{param}", Privileges: []string{"Update Licensed Software"}},
+	{Method: "DELETE", Path: "/JSSResource/licensedsoftware/name/{param}", Privileges: []string{"Delete Licensed Software"}},
+	{Method: "GET", Path: "/JSSResource/licensedsoftware/name/{param}", Privileges: []string{"Read Licensed Software"}},
+	{Method: "PUT", Path: "/JSSResource/licensedsoftware/name/{param}", Privileges: []string{"Update Licensed Software"}},
+	{Method: "GET", Path: "/JSSResource/macapplications", Privileges: []string{"Read Mac Applications"}},
+	{Method: "POST", Path: "/JSSResource/macapplications/id/0", Privileges: []string{"Create Mac Applications"}},
+	{Method: "DELETE", Path: "/JSSResource/macapplications/id/{param}", Privileges: []string{"Delete Mac Applications"}},
+	{Method: "GET", Path: "/JSSResource/macapplications/id/{param}", Privileges: []string{"Read Mac Applications"}},
+	{Method: "PUT", Path: "/JSSResource/macapplications/id/{param}", Privileges: []string{"Update Mac Applications"}},
+	{Method: "GET", Path: "/JSSResource/macapplications/id/{param}/subset/{param}", Privileges: []string{"Read Mac Applications"}},
+	{Method: "DELETE", Path: "/JSSResource/macapplications/name/{param}", Privileges: []string{"Delete Mac Applications"}},
+	{Method: "GET", Path: "/JSSResource/macapplications/name/{param}", Privileges: []string{"Read Mac Applications"}},
+	{Method: "PUT", Path: "/JSSResource/macapplications/name/{param}", Privileges: []string{"Update Mac Applications"}},
+	{Method: "GET", Path: "/JSSResource/macapplications/name/{param}/subset/{param}", Privileges: []string{"Read Mac Applications"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceapplications", Privileges: []string{"Read Mobile Device Apps"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledeviceapplications/bundleid/{param}", Privileges: []string{"Delete Mobile Device Apps"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceapplications/bundleid/{param}", Privileges: []string{"Read Mobile Device Apps"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledeviceapplications/bundleid/{param}", Privileges: []string{"Update Mobile Device Apps"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledeviceapplications/bundleid/{param}/version/{param}", Privileges: []string{"Delete Mobile Device Apps"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceapplications/bundleid/{param}/version/{param}", Privileges: []string{"Read Mobile Device Apps"}},
+	{Method: "POST", Path: "/JSSResource/mobiledeviceapplications/id/0", Privileges: []string{"Create Mobile Device Apps"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledeviceapplications/id/{param}", Privileges: []string{"Delete Mobile Device Apps"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceapplications/id/{param}", Privileges: []string{"Read Mobile Device Apps"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledeviceapplications/id/{param}", Privileges: []string{"Update Mobile Device Apps"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceapplications/id/{param}/subset/{param}", Privileges: []string{"Read Mobile Device Apps"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledeviceapplications/id/{param}/version/{param}", Privileges: []string{"Update Mobile Device Apps"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledeviceapplications/name/{param}", Privileges: []string{"Delete Mobile Device Apps"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceapplications/name/{param}", Privileges: []string{"Read Mobile Device Apps"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledeviceapplications/name/{param}", Privileges: []string{"Update Mobile Device Apps"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceapplications/name/{param}/subset/{param}", Privileges: []string{"Read Mobile Device Apps"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceconfigurationprofiles", Privileges: []string{"Read iOS Configuration Profiles"}},
+	{Method: "POST", Path: "/JSSResource/mobiledeviceconfigurationprofiles/id/0", Privileges: []string{"Create iOS Configuration Profiles"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledeviceconfigurationprofiles/id/{param}", Privileges: []string{"Delete iOS Configuration Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceconfigurationprofiles/id/{param}", Privileges: []string{"Read iOS Configuration Profiles"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledeviceconfigurationprofiles/id/{param}", Privileges: []string{"Update iOS Configuration Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceconfigurationprofiles/id/{param}/subset/{param}", Privileges: []string{"Read iOS Configuration Profiles"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledeviceconfigurationprofiles/name/{param}", Privileges: []string{"Delete iOS Configuration Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceconfigurationprofiles/name/{param}", Privileges: []string{"Read iOS Configuration Profiles"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledeviceconfigurationprofiles/name/{param}", Privileges: []string{"Update iOS Configuration Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceconfigurationprofiles/name/{param}/subset/{param}", Privileges: []string{"Read iOS Configuration Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceenrollmentprofiles", Privileges: []string{"Read Mobile Device Enrollment Profiles"}},
+	{Method: "POST", Path: "/JSSResource/mobiledeviceenrollmentprofiles/id/0", Privileges: []string{"Create Mobile Device Enrollment Profiles"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledeviceenrollmentprofiles/id/{param}", Privileges: []string{"Delete Mobile Device Enrollment Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceenrollmentprofiles/id/{param}", Privileges: []string{"Read Mobile Device Enrollment Profiles"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledeviceenrollmentprofiles/id/{param}", Privileges: []string{"Update Mobile Device Enrollment Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceenrollmentprofiles/id/{param}/subset/{param}", Privileges: []string{"Read Mobile Device Enrollment Profiles"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledeviceenrollmentprofiles/invitation/{param}", Privileges: []string{"Delete Mobile Device Enrollment Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceenrollmentprofiles/invitation/{param}", Privileges: []string{"Read Mobile Device Enrollment Profiles"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledeviceenrollmentprofiles/invitation/{param}", Privileges: []string{"Update Mobile Device Enrollment Profiles"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledeviceenrollmentprofiles/name/{param}", Privileges: []string{"Delete Mobile Device Enrollment Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceenrollmentprofiles/name/{param}", Privileges: []string{"Read Mobile Device Enrollment Profiles"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledeviceenrollmentprofiles/name/{param}", Privileges: []string{"Update Mobile Device Enrollment Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceenrollmentprofiles/name/{param}/subset/{param}", Privileges: []string{"Read Mobile Device Enrollment Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceextensionattributes", Privileges: []string{"Read Mobile Device Extension Attributes"}},
+	{Method: "POST", Path: "/JSSResource/mobiledeviceextensionattributes/id/0", Privileges: []string{"Create Mobile Device Extension Attributes"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledeviceextensionattributes/id/{param}", Privileges: []string{"Delete Mobile Device Extension Attributes"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceextensionattributes/id/{param}", Privileges: []string{"Read Mobile Device Extension Attributes"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledeviceextensionattributes/id/{param}", Privileges: []string{"Update Mobile Device Extension Attributes"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledeviceextensionattributes/name/{param}", Privileges: []string{"Delete Mobile Device Extension Attributes"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceextensionattributes/name/{param}", Privileges: []string{"Read Mobile Device Extension Attributes"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledeviceextensionattributes/name/{param}", Privileges: []string{"Update Mobile Device Extension Attributes"}},
+	{Method: "GET", Path: "/JSSResource/mobiledevicegroups", Privileges: []string{"Read Smart Mobile Device Groups", "Read Static Mobile Device Groups"}},
+	{Method: "POST", Path: "/JSSResource/mobiledevicegroups/id/0", Privileges: []string{"Create Smart Mobile Device Groups", "Create Static Mobile Device Groups"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledevicegroups/id/{param}", Privileges: []string{"Delete Smart Mobile Device Groups", "Delete Static Mobile Device Groups"}},
+	{Method: "GET", Path: "/JSSResource/mobiledevicegroups/id/{param}", Privileges: []string{"Read Smart Mobile Device Groups", "Read Static Mobile Device Groups"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledevicegroups/id/{param}", Privileges: []string{"Update Smart Mobile Device Groups", "Update Static Mobile Device Groups"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledevicegroups/name/{param}", Privileges: []string{"Delete Smart Mobile Device Groups", "Delete Static Mobile Device Groups"}},
+	{Method: "GET", Path: "/JSSResource/mobiledevicegroups/name/{param}", Privileges: []string{"Read Smart Mobile Device Groups", "Read Static Mobile Device Groups"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledevicegroups/name/{param}", Privileges: []string{"Update Smart Mobile Device Groups", "Update Static Mobile Device Groups"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceprovisioningprofiles", Privileges: []string{"Read Provisioning Profiles"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledeviceprovisioningprofiles/id/{param}", Privileges: []string{"Delete Provisioning Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceprovisioningprofiles/id/{param}", Privileges: []string{"Read Provisioning Profiles"}},
+	{Method: "POST", Path: "/JSSResource/mobiledeviceprovisioningprofiles/id/{param}", Privileges: []string{"Create Provisioning Profiles"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledeviceprovisioningprofiles/id/{param}", Privileges: []string{"Update Provisioning Profiles"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledeviceprovisioningprofiles/name/{param}", Privileges: []string{"Delete Provisioning Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceprovisioningprofiles/name/{param}", Privileges: []string{"Read Provisioning Profiles"}},
+	{Method: "POST", Path: "/JSSResource/mobiledeviceprovisioningprofiles/name/{param}", Privileges: []string{"Create Provisioning Profiles"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledeviceprovisioningprofiles/name/{param}", Privileges: []string{"Update Provisioning Profiles"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledeviceprovisioningprofiles/uuid/{param}", Privileges: []string{"Delete Provisioning Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledeviceprovisioningprofiles/uuid/{param}", Privileges: []string{"Read Provisioning Profiles"}},
+	{Method: "POST", Path: "/JSSResource/mobiledeviceprovisioningprofiles/uuid/{param}", Privileges: []string{"Create Provisioning Profiles"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledeviceprovisioningprofiles/uuid/{param}", Privileges: []string{"Update Provisioning Profiles"}},
+	{Method: "GET", Path: "/JSSResource/mobiledevices", Privileges: []string{"Read Mobile Devices"}},
+	{Method: "POST", Path: "/JSSResource/mobiledevices/id/0", Privileges: []string{"Create Mobile Devices"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledevices/id/{param}", Privileges: []string{"Delete Mobile Devices"}},
+	{Method: "GET", Path: "/JSSResource/mobiledevices/id/{param}", Privileges: []string{"Read Mobile Devices"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledevices/id/{param}", Privileges: []string{"Update Mobile Devices"}},
+	{Method: "GET", Path: "/JSSResource/mobiledevices/id/{param}/subset/{param}", Privileges: []string{"Read Mobile Devices"}},
+	{Method: "DELETE", Path: "/JSSResource/mobiledevices/name/{param}", Privileges: []string{"Delete Mobile Devices"}},
+	{Method: "GET", Path: "/JSSResource/mobiledevices/name/{param}", Privileges: []string{"Read Mobile Devices"}},
+	{Method: "PUT", Path: "/JSSResource/mobiledevices/name/{param}", Privileges: []string{"Update Mobile Devices"}},
+	{Method: "GET", Path: "/JSSResource/mobiledevices/name/{param}/subset/{param}", Privileges: []string{"Read Mobile Devices"}},
+	{Method: "GET", Path: "/JSSResource/networksegments", Privileges: []string{"Read Network Segments"}},
+	{Method: "POST", Path: "/JSSResource/networksegments/id/0", Privileges: []string{"Create Network Segments"}},
+	{Method: "DELETE", Path: "/JSSResource/networksegments/id/{param}", Privileges: []string{"Delete Network Segments"}},
+	{Method: "GET", Path: "/JSSResource/networksegments/id/{param}", Privileges: []string{"Read Network Segments"}},
+	{Method: "PUT", Path: "/JSSResource/networksegments/id/{param}", Privileges: []string{"Update Network Segments"}},
+	{Method: "DELETE", Path: "/JSSResource/networksegments/name/{param}", Privileges: []string{"Delete Network Segments"}},
+	{Method: "GET", Path: "/JSSResource/networksegments/name/{param}", Privileges: []string{"Read Network Segments"}},
+	{Method: "PUT", Path: "/JSSResource/networksegments/name/{param}", Privileges: []string{"Update Network Segments"}},
+	{Method: "GET", Path: "/JSSResource/osxconfigurationprofiles", Privileges: []string{"Read macOS Configuration Profiles"}},
+	{Method: "POST", Path: "/JSSResource/osxconfigurationprofiles/id/0", Privileges: []string{"Create macOS Configuration Profiles"}},
+	{Method: "DELETE", Path: "/JSSResource/osxconfigurationprofiles/id/{param}", Privileges: []string{"Delete macOS Configuration Profiles"}},
+	{Method: "GET", Path: "/JSSResource/osxconfigurationprofiles/id/{param}", Privileges: []string{"Read macOS Configuration Profiles"}},
+	{Method: "PUT", Path: "/JSSResource/osxconfigurationprofiles/id/{param}", Privileges: []string{"Update macOS Configuration Profiles"}},
+	{Method: "DELETE", Path: "/JSSResource/osxconfigurationprofiles/name/{param}", Privileges: []string{"Delete macOS Configuration Profiles"}},
+	{Method: "GET", Path: "/JSSResource/osxconfigurationprofiles/name/{param}", Privileges: []string{"Read macOS Configuration Profiles"}},
+	{Method: "PUT", Path: "/JSSResource/osxconfigurationprofiles/name/{param}", Privileges: []string{"Update macOS Configuration Profiles"}},
+	{Method: "GET", Path: "/JSSResource/packages", Privileges: []string{"Read Packages"}},
+	{Method: "DELETE", Path: "/JSSResource/packages/id/{param}", Privileges: []string{"Delete Packages"}},
+	{Method: "GET", Path: "/JSSResource/packages/id/{param}", Privileges: []string{"Read Packages"}},
+	{Method: "POST", Path: "/JSSResource/packages/id/{param}", Privileges: []string{"Create Packages"}},
+	{Method: "PUT", Path: "/JSSResource/packages/id/{param}", Privileges: []string{"Update Packages"}},
+	{Method: "DELETE", Path: "/JSSResource/packages/name/{param}", Privileges: []string{"Delete Packages"}},
+	{Method: "GET", Path: "/JSSResource/packages/name/{param}", Privileges: []string{"Read Packages"}},
+	{Method: "PUT", Path: "/JSSResource/packages/name/{param}", Privileges: []string{"Update Packages"}},
+	{Method: "GET", Path: "/JSSResource/patchexternalsources", Privileges: []string{"Read Patch External Source"}},
+	{Method: "POST", Path: "/JSSResource/patchexternalsources/id/0", Privileges: []string{"Create Patch External Source"}},
+	{Method: "DELETE", Path: "/JSSResource/patchexternalsources/id/{param}", Privileges: []string{"Delete Patch External Source"}},
+	{Method: "GET", Path: "/JSSResource/patchexternalsources/id/{param}", Privileges: []string{"Read Patch External Source"}},
+	{Method: "GET", Path: "/JSSResource/patchexternalsources/name/{param}", Privileges: []string{"Read Patch External Source"}},
+	{Method: "PUT", Path: "/JSSResource/patchexternalsources/name/{param}", Privileges: []string{"Update Patch External Source"}},
+	{Method: "DELETE", Path: "/JSSResource/patchpolicies/id/{param}", Privileges: []string{"Delete Patch Policies"}},
+	{Method: "GET", Path: "/JSSResource/patchpolicies/id/{param}", Privileges: []string{"Read Patch Policies"}},
+	{Method: "GET", Path: "/JSSResource/patchpolicies/id/{param}/subset/{param}", Privileges: []string{"Read Patch Policies"}},
+	{Method: "POST", Path: "/JSSResource/patchpolicies/softwaretitleconfig/id/{param}", Privileges: []string{"Create Patch Policies"}},
+	{Method: "PUT", Path: "/JSSResource/patchpolicies/softwaretitleconfig/id/{param}", Privileges: []string{"Update Patch Policies"}},
+	{Method: "GET", Path: "/JSSResource/policies", Privileges: []string{"Read Policies"}},
+	{Method: "GET", Path: "/JSSResource/policies/category/{param}", Privileges: []string{"Read Policies"}},
+	{Method: "GET", Path: "/JSSResource/policies/createdBy/{param}", Privileges: []string{"Read Policies"}},
+	{Method: "DELETE", Path: "/JSSResource/policies/id/{param}", Privileges: []string{"Delete Policies"}},
+	{Method: "GET", Path: "/JSSResource/policies/id/{param}", Privileges: []string{"Read Policies"}},
+	{Method: "POST", Path: "/JSSResource/policies/id/{param}", Privileges: []string{"Create Policies"}},
+	{Method: "PUT", Path: "/JSSResource/policies/id/{param}", Privileges: []string{"Update Policies"}},
+	{Method: "DELETE", Path: "/JSSResource/policies/name/{param}", Privileges: []string{"Delete Policies"}},
+	{Method: "GET", Path: "/JSSResource/policies/name/{param}", Privileges: []string{"Read Policies"}},
+	{Method: "PUT", Path: "/JSSResource/policies/name/{param}", Privileges: []string{"Update Policies"}},
+	{Method: "GET", Path: "/JSSResource/printers", Privileges: []string{"Read Printers"}},
+	{Method: "POST", Path: "/JSSResource/printers/id/0", Privileges: []string{"Create Printers"}},
+	{Method: "DELETE", Path: "/JSSResource/printers/id/{param}", Privileges: []string{"Delete Printers"}},
+	{Method: "GET", Path: "/JSSResource/printers/id/{param}", Privileges: []string{"Read Printers"}},
+	{Method: "PUT", Path: "/JSSResource/printers/id/{param}", Privileges: []string{"Update Printers"}},
+	{Method: "DELETE", Path: "/JSSResource/printers/name/{param}", Privileges: []string{"Delete Printers"}},
+	{Method: "GET", Path: "/JSSResource/printers/name/{param}", Privileges: []string{"Read Printers"}},
+	{Method: "PUT", Path: "/JSSResource/printers/name/{param}", Privileges: []string{"Update Printers"}},
+	{Method: "GET", Path: "/JSSResource/removablemacaddresses", Privileges: []string{"Read Removable MAC Address"}},
+	{Method: "DELETE", Path: "/JSSResource/removablemacaddresses/id/{param}", Privileges: []string{"Delete Removable MAC Address"}},
+	{Method: "GET", Path: "/JSSResource/removablemacaddresses/id/{param}", Privileges: []string{"Read Removable MAC Address"}},
+	{Method: "POST", Path: "/JSSResource/removablemacaddresses/id/{param}", Privileges: []string{"Create Removable MAC Address"}},
+	{Method: "PUT", Path: "/JSSResource/removablemacaddresses/id/{param}", Privileges: []string{"Update Removable MAC Address"}},
+	{Method: "DELETE", Path: "/JSSResource/removablemacaddresses/name/{param}", Privileges: []string{"Delete Removable MAC Address"}},
+	{Method: "GET", Path: "/JSSResource/removablemacaddresses/name/{param}", Privileges: []string{"Read Removable MAC Address"}},
+	{Method: "PUT", Path: "/JSSResource/removablemacaddresses/name/{param}", Privileges: []string{"Update Removable MAC Address"}},
+	{Method: "GET", Path: "/JSSResource/restrictedsoftware", Privileges: []string{"Read Restricted Software"}},
+	{Method: "DELETE", Path: "/JSSResource/restrictedsoftware/id/{param}", Privileges: []string{"Delete Restricted Software"}},
+	{Method: "GET", Path: "/JSSResource/restrictedsoftware/id/{param}", Privileges: []string{"Read Restricted Software"}},
+	{Method: "POST", Path: "/JSSResource/restrictedsoftware/id/{param}", Privileges: []string{"Create Restricted Software"}},
+	{Method: "PUT", Path: "/JSSResource/restrictedsoftware/id/{param}", Privileges: []string{"Update Restricted Software"}},
+	{Method: "DELETE", Path: "/JSSResource/restrictedsoftware/name/{param}", Privileges: []string{"Delete Restricted Software"}},
+	{Method: "GET", Path: "/JSSResource/restrictedsoftware/name/{param}", Privileges: []string{"Read Restricted Software"}},
+	{Method: "PUT", Path: "/JSSResource/restrictedsoftware/name/{param}", Privileges: []string{"Update Restricted Software"}},
+	{Method: "GET", Path: "/JSSResource/sites", Privileges: []string{"Read Sites"}},
+	{Method: "POST", Path: "/JSSResource/sites/id/0", Privileges: []string{"Create Sites"}},
+	{Method: "DELETE", Path: "/JSSResource/sites/id/{param}", Privileges: []string{"Delete Sites"}},
+	{Method: "GET", Path: "/JSSResource/sites/id/{param}", Privileges: []string{"Read Sites"}},
+	{Method: "PUT", Path: "/JSSResource/sites/id/{param}", Privileges: []string{"Update Sites"}},
+	{Method: "DELETE", Path: "/JSSResource/sites/name/{param}", Privileges: []string{"Delete Sites"}},
+	{Method: "GET", Path: "/JSSResource/sites/name/{param}", Privileges: []string{"Read Sites"}},
+	{Method: "PUT", Path: "/JSSResource/sites/name/{param}", Privileges: []string{"Update Sites"}},
+	{Method: "GET", Path: "/JSSResource/softwareupdateservers", Privileges: []string{"Read Software Update Servers"}},
+	{Method: "POST", Path: "/JSSResource/softwareupdateservers/id/0", Privileges: []string{"Create Software Update Servers"}},
+	{Method: "DELETE", Path: "/JSSResource/softwareupdateservers/id/{param}", Privileges: []string{"Delete Software Update Servers"}},
+	{Method: "GET", Path: "/JSSResource/softwareupdateservers/id/{param}", Privileges: []string{"Read Software Update Servers"}},
+	{Method: "PUT", Path: "/JSSResource/softwareupdateservers/id/{param}", Privileges: []string{"Update Software Update Servers"}},
+	{Method: "DELETE", Path: "/JSSResource/softwareupdateservers/name/{param}", Privileges: []string{"Delete Software Update Servers"}},
+	{Method: "GET", Path: "/JSSResource/softwareupdateservers/name/{param}", Privileges: []string{"Read Software Update Servers"}},
+	{Method: "PUT", Path: "/JSSResource/softwareupdateservers/name/{param}", Privileges: []string{"Update Software Update Servers"}},
+	{Method: "GET", Path: "/JSSResource/userextensionattributes", Privileges: []string{"Read User Extension Attributes"}},
+	{Method: "POST", Path: "/JSSResource/userextensionattributes/id/0", Privileges: []string{"Create User Extension Attributes"}},
+	{Method: "DELETE", Path: "/JSSResource/userextensionattributes/id/{param}", Privileges: []string{"Delete User Extension Attributes"}},
+	{Method: "GET", Path: "/JSSResource/userextensionattributes/id/{param}", Privileges: []string{"Read User Extension Attributes"}},
+	{Method: "PUT", Path: "/JSSResource/userextensionattributes/id/{param}", Privileges: []string{"Update User Extension Attributes"}},
+	{Method: "DELETE", Path: "/JSSResource/userextensionattributes/name/{param}", Privileges: []string{"Delete User Extension Attributes"}},
+	{Method: "GET", Path: "/JSSResource/userextensionattributes/name/{param}", Privileges: []string{"Read User Extension Attributes"}},
+	{Method: "PUT", Path: "/JSSResource/userextensionattributes/name/{param}", Privileges: []string{"Update User Extension Attributes"}},
+	{Method: "GET", Path: "/JSSResource/usergroups", Privileges: []string{"Read Smart User Groups", "Read Static User Groups"}},
+	{Method: "POST", Path: "/JSSResource/usergroups/id/0", Privileges: []string{"Create Smart User Groups", "Create Static User Groups"}},
+	{Method: "DELETE", Path: "/JSSResource/usergroups/id/{param}", Privileges: []string{"Delete Smart User Groups", "Delete Static User Groups"}},
+	{Method: "GET", Path: "/JSSResource/usergroups/id/{param}", Privileges: []string{"Read Smart User Groups", "Read Static User Groups"}},
+	{Method: "PUT", Path: "/JSSResource/usergroups/id/{param}", Privileges: []string{"Update Smart User Groups", "Update Static User Groups"}},
+	{Method: "DELETE", Path: "/JSSResource/usergroups/name/{param}", Privileges: []string{"Delete Smart User Groups", "Delete Static User Groups"}},
+	{Method: "GET", Path: "/JSSResource/usergroups/name/{param}", Privileges: []string{"Read Smart User Groups", "Read Static User Groups"}},
+	{Method: "PUT", Path: "/JSSResource/usergroups/name/{param}", Privileges: []string{"Update Smart User Groups", "Update Static User Groups"}},
+	{Method: "GET", Path: "/JSSResource/users", Privileges: []string{"Read Users"}},
+	{Method: "DELETE", Path: "/JSSResource/users/email/{param}", Privileges: []string{"Delete Users"}},
+	{Method: "GET", Path: "/JSSResource/users/email/{param}", Privileges: []string{"Read Users"}},
+	{Method: "PUT", Path: "/JSSResource/users/email/{param}", Privileges: []string{"Update Users"}},
+	{Method: "POST", Path: "/JSSResource/users/id/0", Privileges: []string{"Create Users"}},
+	{Method: "DELETE", Path: "/JSSResource/users/id/{param}", Privileges: []string{"Delete Users"}},
+	{Method: "GET", Path: "/JSSResource/users/id/{param}", Privileges: []string{"Read Users"}},
+	{Method: "PUT", Path: "/JSSResource/users/id/{param}", Privileges: []string{"Update Users"}},
+	{Method: "DELETE", Path: "/JSSResource/users/name/{param}", Privileges: []string{"Delete Users"}},
+	{Method: "GET", Path: "/JSSResource/users/name/{param}", Privileges: []string{"Read Users"}},
+	{Method: "PUT", Path: "/JSSResource/users/name/{param}", Privileges: []string{"Update Users"}},
+	{Method: "GET", Path: "/JSSResource/vppaccounts", Privileges: []string{"Read Volume Purchasing Administrator Accounts"}},
+	{Method: "POST", Path: "/JSSResource/vppaccounts/id/0", Privileges: []string{"Create Volume Purchasing Administrator Accounts"}},
+	{Method: "DELETE", Path: "/JSSResource/vppaccounts/id/{param}", Privileges: []string{"Delete Volume Purchasing Administrator Accounts"}},
+	{Method: "GET", Path: "/JSSResource/vppaccounts/id/{param}", Privileges: []string{"Read Volume Purchasing Administrator Accounts"}},
+	{Method: "PUT", Path: "/JSSResource/vppaccounts/id/{param}", Privileges: []string{"Update Volume Purchasing Administrator Accounts"}},
+	{Method: "GET", Path: "/JSSResource/vppassignments", Privileges: []string{"Read VPP Assignments"}},
+	{Method: "POST", Path: "/JSSResource/vppassignments/id/0", Privileges: []string{"Create VPP Assignments"}},
+	{Method: "DELETE", Path: "/JSSResource/vppassignments/id/{param}", Privileges: []string{"Delete VPP Assignments"}},
+	{Method: "GET", Path: "/JSSResource/vppassignments/id/{param}", Privileges: []string{"Read VPP Assignments"}},
+	{Method: "PUT", Path: "/JSSResource/vppassignments/id/{param}", Privileges: []string{"Update VPP Assignments"}},
+	{Method: "GET", Path: "/JSSResource/webhooks", Privileges: []string{"Read Webhooks"}},
+	{Method: "POST", Path: "/JSSResource/webhooks/id/0", Privileges: []string{"Create Webhooks"}},
+	{Method: "DELETE", Path: "/JSSResource/webhooks/id/{param}", Privileges: []string{"Delete Webhooks"}},
+	{Method: "GET", Path: "/JSSResource/webhooks/id/{param}", Privileges: []string{"Read Webhooks"}},
+	{Method: "PUT", Path: "/JSSResource/webhooks/id/{param}", Privileges: []string{"Update Webhooks"}},
+	{Method: "DELETE", Path: "/JSSResource/webhooks/name/{param}", Privileges: []string{"Delete Webhooks"}},
+	{Method: "GET", Path: "/JSSResource/webhooks/name/{param}", Privileges: []string{"Read Webhooks"}},
+	{Method: "PUT", Path: "/JSSResource/webhooks/name/{param}", Privileges: []string{"Update Webhooks"}},
+	{Method: "GET", Path: "/api/preview/remote-administration-configurations/team-viewer", Privileges: []string{"Read Remote Administration"}},
+	{Method: "POST", Path: "/api/preview/remote-administration-configurations/team-viewer", Privileges: []string{"Create Remote Administration"}},
+	{Method: "DELETE", Path: "/api/preview/remote-administration-configurations/team-viewer/{param}", Privileges: []string{"Delete Remote Administration"}},
+	{Method: "GET", Path: "/api/preview/remote-administration-configurations/team-viewer/{param}", Privileges: []string{"Read Remote Administration"}},
+	{Method: "PATCH", Path: "/api/preview/remote-administration-configurations/team-viewer/{param}", Privileges: []string{"Update Remote Administration"}},
+	{Method: "POST", Path: "/api/preview/remote-administration-configurations/team-viewer/{param}/sessions", Privileges: []string{"Create Remote Administration"}},
+	{Method: "GET", Path: "/api/preview/remote-administration-configurations/team-viewer/{param}/sessions/{param}", Privileges: []string{"Read Remote Administration"}},
+	{Method: "POST", Path: "/api/preview/remote-administration-configurations/team-viewer/{param}/sessions/{param}/close", Privileges: []string{"Update Remote Administration"}},
+	{Method: "GET", Path: "/api/preview/remote-administration-configurations/team-viewer/{param}/sessions/{param}/status", Privileges: []string{"Read Remote Administration"}},
+	{Method: "GET", Path: "/api/preview/remote-administration-configurations/team-viewer/{param}/status", Privileges: []string{"Read Remote Administration"}},
+	{Method: "GET", Path: "/api/startup-status", Privileges: []string{}},
+	{Method: "GET", Path: "/api/v1/adue-session-token-settings", Privileges: []string{"Read User-Initiated Enrollment"}},
+	{Method: "PATCH", Path: "/api/v1/adue-session-token-settings", Privileges: []string{"Update User-Initiated Enrollment"}},
+	{Method: "PUT", Path: "/api/v1/adue-session-token-settings", Privileges: []string{"Update User-Initiated Enrollment"}},
+	{Method: "GET", Path: "/api/v1/api-integrations", Privileges: []string{"Read API Integrations"}},
+	{Method: "POST", Path: "/api/v1/api-integrations", Privileges: []string{"Create API Integrations"}},
+	{Method: "DELETE", Path: "/api/v1/api-integrations/{param}", Privileges: []string{"Delete API Integrations"}},
+	{Method: "GET", Path: "/api/v1/api-integrations/{param}", Privileges: []string{"Read API Integrations"}},
+	{Method: "PUT", Path: "/api/v1/api-integrations/{param}", Privileges: []string{"Update API Integrations"}},
+	{Method: "POST", Path: "/api/v1/api-integrations/{param}/client-credentials", Privileges: []string{"Update API Integrations"}},
+	{Method: "GET", Path: "/api/v1/api-role-privileges", Privileges: []string{"Read API Roles"}},
+	{Method: "GET", Path: "/api/v1/api-role-privileges/search", Privileges: []string{"Read API Roles"}},
+	{Method: "GET", Path: "/api/v1/api-roles", Privileges: []string{"Read API Roles"}},
+	{Method: "POST", Path: "/api/v1/api-roles", Privileges: []string{"Create API Roles"}},
+	{Method: "DELETE", Path: "/api/v1/api-roles/{param}", Privileges: []string{"Delete API Roles"}},
+	{Method: "GET", Path: "/api/v1/api-roles/{param}", Privileges: []string{"Read API Roles"}},
+	{Method: "PUT", Path: "/api/v1/api-roles/{param}", Privileges: []string{"Update API Roles"}},
+	{Method: "GET", Path: "/api/v1/auth", Privileges: []string{}},
+	{Method: "POST", Path: "/api/v1/auth/invalidate-token", Privileges: []string{}},
+	{Method: "GET", Path: "/api/v1/branding-images/download/{param}", Privileges: []string{"Read Self Service Branding Configuration"}},
+	{Method: "GET", Path: "/api/v1/buildings", Privileges: []string{"Read Buildings"}},
+	{Method: "POST", Path: "/api/v1/buildings", Privileges: []string{"Create Buildings"}},
+	{Method: "DELETE", Path: "/api/v1/buildings/{param}", Privileges: []string{"Delete Buildings"}},
+	{Method: "GET", Path: "/api/v1/buildings/{param}", Privileges: []string{"Read Buildings"}},
+	{Method: "PUT", Path: "/api/v1/buildings/{param}", Privileges: []string{"Update Buildings"}},
+	{Method: "GET", Path: "/api/v1/buildings/{param}/history", Privileges: []string{"Read Buildings"}},
+	{Method: "POST", Path: "/api/v1/buildings/{param}/history", Privileges: []string{"Update Buildings"}},
+	{Method: "GET", Path: "/api/v1/cache-settings", Privileges: []string{"Read Cache"}},
+	{Method: "PUT", Path: "/api/v1/cache-settings", Privileges: []string{"Update Cache"}},
+	{Method: "GET", Path: "/api/v1/categories", Privileges: []string{"Read Categories"}},
+	{Method: "POST", Path: "/api/v1/categories", Privileges: []string{"Create Categories"}},
+	{Method: "POST", Path: "/api/v1/categories/delete-multiple", Privileges: []string{"Delete Categories"}},
+	{Method: "DELETE", Path: "/api/v1/categories/{param}", Privileges: []string{"Delete Categories"}},
+	{Method: "GET", Path: "/api/v1/categories/{param}", Privileges: []string{"Read Categories"}},
+	{Method: "PUT", Path: "/api/v1/categories/{param}", Privileges: []string{"Update Categories"}},
+	{Method: "POST", Path: "/api/v1/cloud-azure", Privileges: []string{"Create LDAP Servers"}},
+	{Method: "GET", Path: "/api/v1/cloud-azure/defaults/server-configuration", Privileges: []string{"Read LDAP Servers"}},
+	{Method: "GET", Path: "/api/v1/cloud-azure/{param}", Privileges: []string{"Read LDAP Servers"}},
+	{Method: "GET", Path: "/api/v1/cloud-azuredefaults/mappings", Privileges: []string{"Read LDAP Servers"}},
+	{Method: "DELETE", Path: "/api/v1/cloud-azure{param}", Privileges: []string{"Delete LDAP Servers"}},
+	{Method: "PUT", Path: "/api/v1/cloud-azure{param}", Privileges: []string{"Update LDAP Servers"}},
+	{Method: "GET", Path: "/api/v1/cloud-distribution-point", Privileges: []string{"Read Cloud Distribution Point"}},
+	{Method: "GET", Path: "/api/v1/cloud-distribution-point/upload-capability", Privileges: []string{"Read Cloud Distribution Point"}},
+	{Method: "GET", Path: "/api/v1/computer-inventory-collection-settings", Privileges: []string{"Read Computer Inventory Collection"}},
+	{Method: "PATCH", Path: "/api/v1/computer-inventory-collection-settings", Privileges: []string{"Update Computer Inventory Collection"}},
+	{Method: "POST", Path: "/api/v1/computer-inventory-collection-settings/custom-path", Privileges: []string{"Create Computer Inventory Collection"}},
+	{Method: "DELETE", Path: "/api/v1/computer-inventory-collection-settings/custom-path/{param}", Privileges: []string{"Delete Computer Inventory Collection"}},
+	{Method: "GET", Path: "/api/v1/computers-inventory-detail", Privileges: []string{"Read Computers"}},
+	{Method: "GET", Path: "/api/v1/computers-inventory-detail/filevault", Privileges: []string{"Read Computers", "View Disk Encryption Recovery Key"}},
+	{Method: "DELETE", Path: "/api/v1/computers-inventory-detail/{param}", Privileges: []string{"Delete Computers"}},
+	{Method: "GET", Path: "/api/v1/computers-inventory-detail/{param}", Privileges: []string{"Read Computers"}},
+	{Method: "PATCH", Path: "/api/v1/computers-inventory-detail/{param}", Privileges: []string{"Update Computers"}},
+	{Method: "POST", Path: "/api/v1/computers-inventory-detail/{param}/attachments", Privileges: []string{"Create Computers"}},
+	{Method: "DELETE", Path: "/api/v1/computers-inventory-detail/{param}/attachments/{param}", Privileges: []string{"Delete Computers"}},
+	{Method: "GET", Path: "/api/v1/computers-inventory-detail/{param}/filevault", Privileges: []string{"Read Computers", "View Disk Encryption Recovery Key"}},
+	{Method: "GET", Path: "/api/v1/computers-inventory-detail/{param}/view-recovery-lock-password", Privileges: []string{"View Recovery Lock"}},
+	{Method: "GET", Path: "/api/v1/conditional-access/device-compliance/feature-toggle", Privileges: []string{"Read Conditional Access"}},
+	{Method: "DELETE", Path: "/api/v1/csa/token", Privileges: []string{"Delete Cloud Services Settings"}},
+	{Method: "GET", Path: "/api/v1/csa/token", Privileges: []string{"Read Cloud Services Settings"}},
+	{Method: "POST", Path: "/api/v1/csa/token", Privileges: []string{"Create Cloud Services Settings"}},
+	{Method: "PUT", Path: "/api/v1/csa/token", Privileges: []string{"Update Cloud Services Settings"}},
+	{Method: "GET", Path: "/api/v1/departments", Privileges: []string{"Read Departments"}},
+	{Method: "POST", Path: "/api/v1/departments", Privileges: []string{"Create Departments"}},
+	{Method: "DELETE", Path: "/api/v1/departments/{param}", Privileges: []string{"Delete Departments"}},
+	{Method: "GET", Path: "/api/v1/departments/{param}", Privileges: []string{"Read Departments"}},
+	{Method: "PUT", Path: "/api/v1/departments/{param}", Privileges: []string{"Update Departments"}},
+	{Method: "GET", Path: "/api/v1/device-communication-settings", Privileges: []string{"Read Automatically Renew MDM Profile Settings"}},
+	{Method: "PUT", Path: "/api/v1/device-communication-settings", Privileges: []string{"Update Automatically Renew MDM Profile Settings"}},
+	{Method: "GET", Path: "/api/v1/device-enrollments", Privileges: []string{"Read Device Enrollment Program Instances"}},
+	{Method: "GET", Path: "/api/v1/device-enrollments/public-key", Privileges: []string{"Read Device Enrollment Program Instances"}},
+	{Method: "GET", Path: "/api/v1/device-enrollments/syncs", Privileges: []string{"Read Device Enrollment Program Instances"}},
+	{Method: "POST", Path: "/api/v1/device-enrollments/upload-token", Privileges: []string{"Create Device Enrollment Program Instances"}},
+	{Method: "DELETE", Path: "/api/v1/device-enrollments/{param}", Privileges: []string{"Delete Device Enrollment Program Instances"}},
+	{Method: "GET", Path: "/api/v1/device-enrollments/{param}", Privileges: []string{"Read Device Enrollment Program Instances"}},
+	{Method: "PUT", Path: "/api/v1/device-enrollments/{param}", Privileges: []string{"Update Device Enrollment Program Instances"}},
+	{Method: "GET", Path: "/api/v1/device-enrollments/{param}/devices", Privileges: []string{"Read Device Enrollment Program Instances"}},
+	{Method: "POST", Path: "/api/v1/device-enrollments/{param}/disown", Privileges: []string{"Update Device Enrollment Program Instances"}},
+	{Method: "GET", Path: "/api/v1/device-enrollments/{param}/history", Privileges: []string{"Read Device Enrollment Program Instances"}},
+	{Method: "POST", Path: "/api/v1/device-enrollments/{param}/history", Privileges: []string{"Update Device Enrollment Program Instances"}},
+	{Method: "GET", Path: "/api/v1/device-enrollments/{param}/syncs", Privileges: []string{"Read Device Enrollment Program Instances"}},
+	{Method: "GET", Path: "/api/v1/device-enrollments/{param}/syncs/latest", Privileges: []string{"Read Device Enrollment Program Instances"}},
+	{Method: "PUT", Path: "/api/v1/device-enrollments/{param}/upload-token", Privileges: []string{"Update Device Enrollment Program Instances"}},
+	{Method: "GET", Path: "/api/v1/health-check", Privileges: []string{}},
+	{Method: "POST", Path: "/api/v1/icon", Privileges: []string{"Create Icons"}},
+	{Method: "GET", Path: "/api/v1/icon/download/{param}", Privileges: []string{"Read Icons"}},
+	{Method: "GET", Path: "/api/v1/jamf-package", Privileges: []string{"Read Packages"}},
+	{Method: "GET", Path: "/api/v1/jamf-pro-server-url", Privileges: []string{"Read Jamf Pro URL"}},
+	{Method: "PUT", Path: "/api/v1/jamf-pro-server-url", Privileges: []string{"Update Jamf Pro URL"}},
+	{Method: "GET", Path: "/api/v1/jamf-pro-version", Privileges: []string{}},
+	{Method: "DELETE", Path: "/api/v1/jamf-protect", Privileges: []string{"Delete Jamf Protect Integration"}},
+	{Method: "GET", Path: "/api/v1/jamf-protect", Privileges: []string{"Read Jamf Protect Integration"}},
+	{Method: "PUT", Path: "/api/v1/jamf-protect", Privileges: []string{"Update Jamf Protect Integration"}},
+	{Method: "GET", Path: "/api/v1/jcds/files", Privileges: []string{"Read Jamf Content Distribution Server Files"}},
+	{Method: "POST", Path: "/api/v1/jcds/files", Privileges: []string{"Create Jamf Content Distribution Server Files"}},
+	{Method: "GET", Path: "/api/v1/jcds/files/{param}", Privileges: []string{"Read Jamf Content Distribution Server Files"}},
+	{Method: "GET", Path: "/api/v1/jcds/properties", Privileges: []string{"Read Jamf Content Distribution Server Files"}},
+	{Method: "POST", Path: "/api/v1/jcds/renew-credentials", Privileges: []string{"Create Jamf Content Distribution Server Files"}},
+	{Method: "POST", Path: "/api/v1/ldap-keystore/verify", Privileges: []string{"Read LDAP Servers"}},
+	{Method: "GET", Path: "/api/v1/managed-software-updates/available-updates", Privileges: []string{"Read Managed Software Updates"}},
+	{Method: "GET", Path: "/api/v1/managed-software-updates/plans", Privileges: []string{"Read Managed Software Updates"}},
+	{Method: "POST", Path: "/api/v1/managed-software-updates/plans", Privileges: []string{"Create Managed Software Updates"}},
+	{Method: "GET", Path: "/api/v1/managed-software-updates/plans/feature-toggle", Privileges: []string{"Read Managed Software Updates"}},
+	{Method: "PUT", Path: "/api/v1/managed-software-updates/plans/feature-toggle", Privileges: []string{"Update Managed Software Updates"}},
+	{Method: "POST", Path: "/api/v1/managed-software-updates/plans/group", Privileges: []string{"Create Managed Software Updates"}},
+	{Method: "GET", Path: "/api/v1/managed-software-updates/plans/group/{param}", Privileges: []string{"Read Managed Software Updates"}},
+	{Method: "GET", Path: "/api/v1/pki/certificate-authority/active", Privileges: []string{"Read PKI"}},
+	{Method: "POST", Path: "/api/v1/pki/venafi", Privileges: []string{"Create PKI"}},
+	{Method: "DELETE", Path: "/api/v1/pki/venafi/{param}", Privileges: []string{"Delete PKI"}},
+	{Method: "GET", Path: "/api/v1/pki/venafi/{param}", Privileges: []string{"Read PKI"}},
+	{Method: "PATCH", Path: "/api/v1/pki/venafi/{param}", Privileges: []string{"Update PKI"}},
+	{Method: "GET", Path: "/api/v1/pki/venafi/{param}/connection-status", Privileges: []string{"Read PKI"}},
+	{Method: "GET", Path: "/api/v1/pki/venafi/{param}/dependent-profiles", Privileges: []string{"Read PKI"}},
+	{Method: "GET", Path: "/api/v1/pki/venafi/{param}/history", Privileges: []string{"Read PKI"}},
+	{Method: "POST", Path: "/api/v1/pki/venafi/{param}/history", Privileges: []string{"Update PKI"}},
+	{Method: "GET", Path: "/api/v1/pki/venafi/{param}/jamf-public-key", Privileges: []string{"Read PKI"}},
+	{Method: "POST", Path: "/api/v1/pki/venafi/{param}/jamf-public-key/regenerate", Privileges: []string{"Update PKI"}},
+	{Method: "DELETE", Path: "/api/v1/pki/venafi/{param}/proxy-trust-store", Privileges: []string{"Delete PKI"}},
+	{Method: "GET", Path: "/api/v1/pki/venafi/{param}/proxy-trust-store", Privileges: []string{"Read PKI"}},
+	{Method: "POST", Path: "/api/v1/pki/venafi/{param}/proxy-trust-store", Privileges: []string{"Create PKI"}},
+	{Method: "GET", Path: "/api/v1/return-to-service", Privileges: []string{"Read Return to Service"}},
+	{Method: "POST", Path: "/api/v1/return-to-service", Privileges: []string{"Create Return to Service"}},
+	{Method: "DELETE", Path: "/api/v1/return-to-service/{param}", Privileges: []string{"Delete Return to Service"}},
+	{Method: "GET", Path: "/api/v1/return-to-service/{param}", Privileges: []string{"Read Return to Service"}},
+	{Method: "PUT", Path: "/api/v1/return-to-service/{param}", Privileges: []string{"Update Return to Service"}},
+	{Method: "GET", Path: "/api/v1/scripts", Privileges: []string{"Read Scripts"}},
+	{Method: "POST", Path: "/api/v1/scripts", Privileges: []string{"Create Scripts"}},
+	{Method: "DELETE", Path: "/api/v1/scripts/{param}", Privileges: []string{"Delete Scripts"}},
+	{Method: "GET", Path: "/api/v1/scripts/{param}", Privileges: []string{"Read Scripts"}},
+	{Method: "PUT", Path: "/api/v1/scripts/{param}", Privileges: []string{"Update Scripts"}},
+	{Method: "GET", Path: "/api/v1/self-service/branding/macos", Privileges: []string{"Read Self Service Branding Configuration"}},
+	{Method: "POST", Path: "/api/v1/self-service/branding/macos", Privileges: []string{"Create Self Service Branding Configuration"}},
+	{Method: "DELETE", Path: "/api/v1/self-service/branding/macos/{param}", Privileges: []string{"Delete Self Service Branding Configuration"}},
+	{Method: "GET", Path: "/api/v1/self-service/branding/macos/{param}", Privileges: []string{"Read Self Service Branding Configuration"}},
+	{Method: "PUT", Path: "/api/v1/self-service/branding/macos/{param}", Privileges: []string{"Update Self Service Branding Configuration"}},
+	{Method: "GET", Path: "/api/v1/self-service/settings", Privileges: []string{"Read Self Service"}},
+	{Method: "PUT", Path: "/api/v1/self-service/settings", Privileges: []string{"Update Self Service"}},
+	{Method: "GET", Path: "/api/v1/smtp-server", Privileges: []string{"Read SMTP Server"}},
+	{Method: "PUT", Path: "/api/v1/smtp-server", Privileges: []string{"Update SMTP Server"}},
+	{Method: "GET", Path: "/api/v1/sso/failover", Privileges: []string{"Read SSO Settings"}},
+	{Method: "POST", Path: "/api/v1/sso/failover/generate", Privileges: []string{"Create SSO Settings"}},
+	{Method: "DELETE", Path: "/api/v1/volume-purchasing-locations", Privileges: []string{"Delete Volume Purchasing Administrator Accounts"}},
+	{Method: "GET", Path: "/api/v1/volume-purchasing-locations", Privileges: []string{"Read Volume Purchasing Administrator Accounts"}},
+	{Method: "POST", Path: "/api/v1/volume-purchasing-locations", Privileges: []string{"Create Volume Purchasing Administrator Accounts"}},
+	{Method: "GET", Path: "/api/v1/volume-purchasing-locations/{param}", Privileges: []string{"Read Volume Purchasing Administrator Accounts"}},
+	{Method: "PATCH", Path: "/api/v1/volume-purchasing-locations/{param}", Privileges: []string{"Update Volume Purchasing Administrator Accounts"}},
+	{Method: "GET", Path: "/api/v1/volume-purchasing-locations/{param}/content", Privileges: []string{"Read Volume Purchasing Administrator Accounts"}},
+	{Method: "GET", Path: "/api/v1/volume-purchasing-subscriptions", Privileges: []string{"Read Volume Purchasing Subscriptions"}},
+	{Method: "POST", Path: "/api/v1/volume-purchasing-subscriptions", Privileges: []string{"Create Volume Purchasing Subscriptions"}},
+	{Method: "DELETE", Path: "/api/v1/volume-purchasing-subscriptions/{param}", Privileges: []string{"Delete Volume Purchasing Subscriptions"}},
+	{Method: "GET", Path: "/api/v1/volume-purchasing-subscriptions/{param}", Privileges: []string{"Read Volume Purchasing Subscriptions"}},
+	{Method: "PUT", Path: "/api/v1/volume-purchasing-subscriptions/{param}", Privileges: []string{"Update Volume Purchasing Subscriptions"}},
+	{Method: "GET", Path: "/api/v2/account-preferences", Privileges: []string{}},
+	{Method: "GET", Path: "/api/v2/cloud-ldaps/{param}/mappings", Privileges: []string{"Read LDAP Servers"}},
+	{Method: "GET", Path: "/api/v2/computer-prestages/{param}/scope", Privileges: []string{"Read Computer PreStage Enrollments"}},
+	{Method: "GET", Path: "/api/v2/enrollment-customizations", Privileges: []string{"Read Enrollment Customizations"}},
+	{Method: "POST", Path: "/api/v2/enrollment-customizations", Privileges: []string{"Create Enrollment Customizations"}},
+	{Method: "DELETE", Path: "/api/v2/enrollment-customizations/{param}", Privileges: []string{"Delete Enrollment Customizations"}},
+	{Method: "GET", Path: "/api/v2/enrollment-customizations/{param}", Privileges: []string{"Read Enrollment Customizations"}},
+	{Method: "PUT", Path: "/api/v2/enrollment-customizations/{param}", Privileges: []string{"Update Enrollment Customizations"}},
+	{Method: "GET", Path: "/api/v2/jamf-package", Privileges: []string{"Read Packages"}},
+	{Method: "GET", Path: "/api/v2/jamf-pro-information", Privileges: []string{}},
+	{Method: "GET", Path: "/api/v2/local-admin-password/settings", Privileges: []string{"Read Local Admin Password Settings"}},
+	{Method: "PUT", Path: "/api/v2/local-admin-password/settings", Privileges: []string{"Update Local Admin Password Settings"}},
+	{Method: "POST", Path: "/api/v2/mdm/commands", Privileges: []string{"Send Mobile Device Remote Wipe Command"}},
+	{Method: "GET", Path: "/api/v2/mobile-device-prestages", Privileges: []string{"Read Mobile Device PreStage Enrollments"}},
+	{Method: "POST", Path: "/api/v2/mobile-device-prestages", Privileges: []string{"Create Mobile Device PreStage Enrollments"}},
+	{Method: "DELETE", Path: "/api/v2/mobile-device-prestages/{param}", Privileges: []string{"Delete Mobile Device PreStage Enrollments"}},
+	{Method: "GET", Path: "/api/v2/mobile-device-prestages/{param}", Privileges: []string{"Read Mobile Device PreStage Enrollments"}},
+	{Method: "GET", Path: "/api/v2/mobile-devices/{param}", Privileges: []string{"Read Mobile Devices"}},
+	{Method: "GET", Path: "/api/v2/patch-policies/policy-details", Privileges: []string{"Read Patch Policies"}},
+	{Method: "GET", Path: "/api/v2/patch-software-title-configurations", Privileges: []string{"Read Patch Management Software Titles"}},
+	{Method: "POST", Path: "/api/v2/patch-software-title-configurations", Privileges: []string{"Create Patch Management Software Titles"}},
+	{Method: "DELETE", Path: "/api/v2/patch-software-title-configurations/{param}", Privileges: []string{"Delete Patch Management Software Titles"}},
+	{Method: "GET", Path: "/api/v2/patch-software-title-configurations/{param}", Privileges: []string{"Read Patch Management Software Titles"}},
+	{Method: "PATCH", Path: "/api/v2/patch-software-title-configurations/{param}", Privileges: []string{"Update Patch Management Software Titles"}},
+	{Method: "GET", Path: "/api/v2/sso", Privileges: []string{"Read SSO Settings"}},
+	{Method: "PUT", Path: "/api/v2/sso", Privileges: []string{"Update SSO Settings"}},
+	{Method: "DELETE", Path: "/api/v2/sso/cert", Privileges: []string{"Delete SSO Settings"}},
+	{Method: "GET", Path: "/api/v2/sso/cert", Privileges: []string{"Read SSO Settings"}},
+	{Method: "POST", Path: "/api/v2/sso/cert", Privileges: []string{"Create SSO Settings"}},
+	{Method: "PUT", Path: "/api/v2/sso/cert", Privileges: []string{"Update SSO Settings"}},
+	{Method: "GET", Path: "/api/v2/sso/cert/download", Privileges: []string{"Read SSO Settings"}},
+	{Method: "POST", Path: "/api/v2/sso/cert/parse", Privileges: []string{"Read SSO Settings"}},
+	{Method: "GET", Path: "/api/v2/sso/dependencies", Privileges: []string{"Read SSO Settings"}},
+	{Method: "GET", Path: "/api/v3/computer-prestages", Privileges: []string{"Read Computer PreStage Enrollments"}},
+	{Method: "POST", Path: "/api/v3/computer-prestages", Privileges: []string{"Create Computer PreStage Enrollments"}},
+	{Method: "DELETE", Path: "/api/v3/computer-prestages/{param}", Privileges: []string{"Delete Computer PreStage Enrollments"}},
+	{Method: "GET", Path: "/api/v3/computer-prestages/{param}", Privileges: []string{"Read Computer PreStage Enrollments"}},
+	{Method: "PUT", Path: "/api/v3/computer-prestages/{param}", Privileges: []string{"Update Computer PreStage Enrollments"}},
+	{Method: "GET", Path: "/api/v3/enrollment", Privileges: []string{"Read User-Initiated Enrollment"}},
+	{Method: "PUT", Path: "/api/v3/enrollment", Privileges: []string{"Update User-Initiated Enrollment"}},
+	{Method: "DELETE", Path: "/api/v3/enrollment/access-groups/{param}", Privileges: []string{"Delete User-Initiated Enrollment"}},
+	{Method: "GET", Path: "/api/v3/enrollment/access-groups/{param}", Privileges: []string{"Read User-Initiated Enrollment"}},
+	{Method: "PUT", Path: "/api/v3/enrollment/access-groups/{param}", Privileges: []string{"Update User-Initiated Enrollment"}},
+}
@@ -4,4 +4,6 @@ const (
 	maxPageSize        = 2000 // Maximum number of items per page
 	startingPageNumber = 0
 	standardPageSize   = 200
+
+	staticGroupMembershipBatchSize = 500 // Maximum number of members added or removed per static group request
 )
@@ -1,5 +1,7 @@
 package jamfpro
 
+import "fmt"
+
 // Type refers to string representation of target object type. I.e buildings, policies, computergroups
 
 const (
@@ -49,3 +51,31 @@ const (
 	// Cloud LDAP Verify Keystore
 	errMsgFailedValidateCloudLdapKeystore = "failed to validate keystore, error: %v"
 )
+
+// apiErrorf formats the error of an http request with one of the messages above. The request error stays
+// reachable with errors.As, and a 403 response is enriched with the privileges the requested endpoint needs,
+// see EnrichAccessDeniedError. Errors that do not come from a request use fmt.Errorf.
+func apiErrorf(format string, a ...interface{}) error {
+	err := &requestError{message: fmt.Sprintf(format, a...)}
+	for _, arg := range a {
+		if cause, ok := arg.(error); ok {
+			err.cause = cause
+			break
+		}
+	}
+	return EnrichAccessDeniedError(err)
+}
+
+// requestError is an error formatted by apiErrorf, wrapping the error of the request.
+type requestError struct {
+	message string
+	cause   error
+}
+
+func (e *requestError) Error() string {
+	return e.message
+}
+
+func (e *requestError) Unwrap() error {
+	return e.cause
+}
@@ -0,0 +1,78 @@
+package jamfpro
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-http-client/response"
+)
+
+func TestAPIErrorf(t *testing.T) {
+	denied := &response.APIError{StatusCode: 403, Method: "GET", URL: "https://example.jamfcloud.com/api/v1/buildings/1"}
+	notFound := &response.APIError{StatusCode: 404, Method: "GET", URL: "https://example.jamfcloud.com/api/v1/buildings/1"}
+	unmapped := &response.APIError{StatusCode: 403, Method: "GET", URL: "https://example.jamfcloud.com/api/v1/unknown"}
+
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantMissing bool
+	}{
+		{
+			name:        "403 response is enriched",
+			err:         apiErrorf(errMsgFailedGetByID, "building", "1", denied),
+			wantStatus:  403,
+			wantMissing: true,
+		},
+		{
+			name:       "other responses are wrapped",
+			err:        apiErrorf(errMsgFailedGetByID, "building", "1", notFound),
+			wantStatus: 404,
+		},
+		{
+			name:       "unmapped endpoints are wrapped",
+			err:        apiErrorf(errMsgFailedGetByID, "building", "1", unmapped),
+			wantStatus: 403,
+		},
+		{
+			name:        "wrapped errors are enriched once",
+			err:         apiErrorf(errMsgFailedGetByName, "building", "HQ", apiErrorf(errMsgFailedGetByID, "building", "1", denied)),
+			wantStatus:  403,
+			wantMissing: true,
+		},
+		{
+			name:        "errors wrapped with %w are enriched",
+			err:         apiErrorf(errMsgFailedUpdateByID, "building", "1", fmt.Errorf("members 1-10 of 20: %w", denied)),
+			wantStatus:  403,
+			wantMissing: true,
+		},
+		{
+			name: "errors without a response are wrapped",
+			err:  apiErrorf(errMsgFailedGet, "building", errors.New("connection refused")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var apiErr *response.APIError
+			if errors.As(tt.err, &apiErr) != (tt.wantStatus != 0) || tt.wantStatus != 0 && apiErr.StatusCode != tt.wantStatus {
+				t.Fatalf("unexpected api error in %v", tt.err)
+			}
+
+			var missing *MissingPrivilegeError
+			if errors.As(tt.err, &missing) != tt.wantMissing {
+				t.Fatalf("missing privilege error = %v, want %v: %v", !tt.wantMissing, tt.wantMissing, tt.err)
+			}
+			if tt.wantMissing {
+				if missing.Path != "/api/v1/buildings/1" || len(missing.Privileges) == 0 {
+					t.Errorf("unexpected missing privileges %+v", missing)
+				}
+				var nested *MissingPrivilegeError
+				if errors.As(missing.Err, &nested) {
+					t.Errorf("error enriched twice: %v", tt.err)
+				}
+			}
+		})
+	}
+}
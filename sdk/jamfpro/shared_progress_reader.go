@@ -1,29 +1,283 @@
 // shared_progress_reader.go
+// Progress reporting for package uploads, icon uploads, file attachments and downloads. Transfers report to
+// a ProgressListener, set on Client.Progress or per upload, instead of printing to stdout. A nil listener
+// reports nothing.
 package jamfpro
 
-import "io"
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
 
-// ProgressReader wraps an io.Reader to report progress on read operations
+// progressReportInterval limits how often a transfer reports progress; the final report is always sent.
+const progressReportInterval = 200 * time.Millisecond
+
+// ProgressListener receives the progress of a transfer. OnProgress may be called from several goroutines
+// when a transfer runs in parallel parts.
+type ProgressListener interface {
+	OnProgress(progress TransferProgress)
+}
+
+// ProgressListenerFunc adapts a function to a ProgressListener.
+type ProgressListenerFunc func(progress TransferProgress)
+
+// OnProgress calls f.
+func (f ProgressListenerFunc) OnProgress(progress TransferProgress) {
+	f(progress)
+}
+
+// NoopProgressListener discards progress. It is used when no listener is set.
+type NoopProgressListener struct{}
+
+// OnProgress does nothing.
+func (NoopProgressListener) OnProgress(TransferProgress) {}
+
+// TransferProgress is a snapshot of an upload or download.
+type TransferProgress struct {
+	// Operation is "upload", "download" or "checksum".
+	Operation string `json:"operation"`
+	// Name is the file name or endpoint being transferred.
+	Name string `json:"name"`
+	// BytesTransferred includes bytes transferred by an earlier, resumed attempt.
+	BytesTransferred int64 `json:"bytesTransferred"`
+	// TotalBytes is -1 when the size is not known.
+	TotalBytes int64 `json:"totalBytes"`
+	// BytesPerSecond is the average rate of this attempt.
+	BytesPerSecond float64 `json:"bytesPerSecond"`
+	// ETA is zero when the remaining time cannot be estimated.
+	ETA  time.Duration `json:"eta"`
+	Done bool          `json:"done"`
+}
+
+// Percent returns the completed percentage, or -1 when the total size is not known.
+func (p TransferProgress) Percent() float64 {
+	if p.TotalBytes < 0 {
+		return -1
+	}
+	if p.TotalBytes == 0 {
+		return 100
+	}
+	return float64(p.BytesTransferred) / float64(p.TotalBytes) * 100
+}
+
+// progressTracker aggregates the bytes of one transfer, which may be split across parallel readers.
+type progressTracker struct {
+	listener  ProgressListener
+	operation string
+	name      string
+	total     int64
+	start     time.Time
+
+	mu          sync.Mutex
+	initial     int64
+	transferred int64
+	lastReport  time.Time
+}
+
+// newProgressTracker starts tracking a transfer. A nil listener discards progress.
+func newProgressTracker(listener ProgressListener, operation, name string, total int64) *progressTracker {
+	if listener == nil {
+		listener = NoopProgressListener{}
+	}
+	return &progressTracker{
+		listener:  listener,
+		operation: operation,
+		name:      name,
+		total:     total,
+		start:     time.Now(),
+	}
+}
+
+// resume records bytes transferred by an earlier attempt, so they count towards progress but not the rate.
+func (t *progressTracker) resume(n int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.initial += n
+	t.transferred += n
+}
+
+// add records n transferred bytes. n is negative when a reader is rewound for a retry.
+func (t *progressTracker) add(n int64) {
+	t.mu.Lock()
+	t.transferred += n
+	now := time.Now()
+	if now.Sub(t.lastReport) < progressReportInterval {
+		t.mu.Unlock()
+		return
+	}
+	t.lastReport = now
+	progress := t.snapshot(now, false)
+	t.mu.Unlock()
+
+	t.listener.OnProgress(progress)
+}
+
+// finish sends the final report of the transfer.
+func (t *progressTracker) finish() {
+	t.mu.Lock()
+	progress := t.snapshot(time.Now(), true)
+	t.mu.Unlock()
+
+	t.listener.OnProgress(progress)
+}
+
+func (t *progressTracker) snapshot(now time.Time, done bool) TransferProgress {
+	progress := TransferProgress{
+		Operation:        t.operation,
+		Name:             t.name,
+		BytesTransferred: t.transferred,
+		TotalBytes:       t.total,
+		Done:             done,
+	}
+	if elapsed := now.Sub(t.start).Seconds(); elapsed > 0 {
+		progress.BytesPerSecond = float64(t.transferred-t.initial) / elapsed
+	}
+	if !done && t.total > 0 && progress.BytesPerSecond > 0 {
+		remaining := float64(t.total - t.transferred)
+		progress.ETA = time.Duration(remaining / progress.BytesPerSecond * float64(time.Second))
+	}
+	return progress
+}
+
+// ProgressReader wraps an io.Reader to report progress on read operations. When the wrapped reader is an
+// io.Seeker, rewinding it for a retry moves the reported progress back accordingly.
 type ProgressReader struct {
-	reader     io.Reader
-	totalBytes int64
-	readBytes  int64
-	progressFn func(readBytes, totalBytes int64, unit string)
+	reader   io.Reader
+	tracker  *progressTracker
+	position int64
+}
+
+// NewProgressReader returns a reader that reports reading totalBytes from reader as an upload of name.
+func NewProgressReader(reader io.Reader, name string, totalBytes int64, listener ProgressListener) *ProgressReader {
+	return &ProgressReader{
+		reader:  reader,
+		tracker: newProgressTracker(listener, "upload", name, totalBytes),
+	}
+}
+
+// newTrackedReader returns a reader that reports to a tracker shared by the parts of one transfer.
+func newTrackedReader(reader io.Reader, tracker *progressTracker) *ProgressReader {
+	return &ProgressReader{reader: reader, tracker: tracker}
 }
 
 // Read implements the io.Reader interface.
 func (r *ProgressReader) Read(p []byte) (int, error) {
 	n, err := r.reader.Read(p)
-	r.readBytes += int64(n)
+	r.position += int64(n)
+	r.tracker.add(int64(n))
+	return n, err
+}
+
+// Seek implements the io.Seeker interface when the wrapped reader supports it.
+func (r *ProgressReader) Seek(offset int64, whence int) (int64, error) {
+	seeker, ok := r.reader.(io.Seeker)
+	if !ok {
+		return 0, fmt.Errorf("progress reader: underlying reader does not support seeking")
+	}
+	position, err := seeker.Seek(offset, whence)
+	if err != nil {
+		return position, err
+	}
+	r.tracker.add(position - r.position)
+	r.position = position
+	return position, nil
+}
 
-	const kb = 1024
-	const mb = 1024 * kb
+// Finish sends the final progress report.
+func (r *ProgressReader) Finish() {
+	r.tracker.finish()
+}
+
+// ProgressWriter wraps an io.Writer to report progress on write operations, e.g. for downloads.
+type ProgressWriter struct {
+	writer  io.Writer
+	tracker *progressTracker
+}
 
-	if r.totalBytes > mb { // report in MB if file is larger than 1MB
-		r.progressFn(r.readBytes/mb, r.totalBytes/mb, "MB")
-	} else { // otherwise, report in KB
-		r.progressFn(r.readBytes/kb, r.totalBytes/kb, "KB")
+// NewProgressWriter returns a writer that reports writing totalBytes to writer as a download of name.
+// Use -1 when the total size is not known.
+func NewProgressWriter(writer io.Writer, name string, totalBytes int64, listener ProgressListener) *ProgressWriter {
+	return &ProgressWriter{
+		writer:  writer,
+		tracker: newProgressTracker(listener, "download", name, totalBytes),
 	}
+}
 
+// Write implements the io.Writer interface.
+func (w *ProgressWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.tracker.add(int64(n))
 	return n, err
 }
+
+// Finish sends the final progress report.
+func (w *ProgressWriter) Finish() {
+	w.tracker.finish()
+}
+
+// progressListener returns the listener for a transfer: the override when set, otherwise Client.Progress.
+func (c *Client) progressListener(override ProgressListener) ProgressListener {
+	if override != nil {
+		return override
+	}
+	return c.Progress
+}
+
+// TerminalProgressBar renders transfers as a single updating line, for interactive use.
+type TerminalProgressBar struct {
+	out   io.Writer
+	width int
+	mu    sync.Mutex
+}
+
+// NewTerminalProgressBar returns a progress bar that writes to out, usually os.Stderr so that the bar does
+// not mix with output on stdout.
+func NewTerminalProgressBar(out io.Writer) *TerminalProgressBar {
+	return &TerminalProgressBar{out: out, width: 30}
+}
+
+// OnProgress redraws the progress line and ends it once the transfer is done.
+func (b *TerminalProgressBar) OnProgress(p TransferProgress) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var line strings.Builder
+	fmt.Fprintf(&line, "\r%s %s ", p.Operation, p.Name)
+	if percent := p.Percent(); percent >= 0 {
+		filled := int(percent / 100 * float64(b.width))
+		if filled > b.width {
+			filled = b.width
+		}
+		fmt.Fprintf(&line, "[%s%s] %5.1f%% %s/%s", strings.Repeat("=", filled), strings.Repeat(" ", b.width-filled), percent, formatBytes(p.BytesTransferred), formatBytes(p.TotalBytes))
+	} else {
+		line.WriteString(formatBytes(p.BytesTransferred))
+	}
+	fmt.Fprintf(&line, " %s/s", formatBytes(int64(p.BytesPerSecond)))
+	if p.ETA > 0 {
+		fmt.Fprintf(&line, " ETA %s", p.ETA.Round(time.Second))
+	}
+	// Clear what is left of a longer previous line
+	line.WriteString("\x1b[K")
+	if p.Done {
+		line.WriteString("\n")
+	}
+
+	fmt.Fprint(b.out, line.String())
+}
+
+// formatBytes renders a byte count with a binary unit.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
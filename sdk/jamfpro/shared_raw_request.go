@@ -0,0 +1,455 @@
+// shared_raw_request.go
+// Raw HTTP requests against Jamf Pro for content the http client cannot decode, such as images, PEM files
+// and ranged downloads. Responses are streamed to the caller rather than unmarshalled, and multipart uploads
+// are streamed from disk rather than buffered, with progress reported to the client's ProgressListener. Like
+// the http client's own requests, they validate the auth token, respect the concurrency limit and the
+// configured timeout, retry and redirect settings, and are logged with the client's logger.
+
+package jamfpro
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/cookiejar"
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/go-api-http-client/logger"
+	"github.com/deploymenttheory/go-api-http-client/ratehandler"
+	"github.com/deploymenttheory/go-api-http-client/redirecthandler"
+	"github.com/deploymenttheory/go-api-http-client/response"
+	"github.com/deploymenttheory/go-api-http-client/status"
+	"github.com/google/uuid"
+)
+
+// rawMultipartFile is a file part of a streamed multipart upload.
+type rawMultipartFile struct {
+	FieldName   string
+	FileName    string
+	ContentType string
+	Reader      io.Reader
+	// Size is the length of Reader, or -1 when it is not known.
+	Size int64
+}
+
+// multipartQuoteEscaper escapes quotes and backslashes in Content-Disposition parameters.
+var multipartQuoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// doRawDownload performs an authenticated GET against a Jamf Pro endpoint and streams the response body to w.
+// Extra headers, e.g. Accept or Range, are added to the request. Rate limited and transient failures are
+// retried like the http client retries GET requests, before anything is written to w. The returned response
+// body has been consumed.
+func (c *Client) doRawDownload(endpoint string, headers map[string]string, w io.Writer) (*http.Response, error) {
+	options := c.rawClientOptions()
+	deadline := time.Now().Add(options.TotalRetryDuration)
+
+	for retry := 0; ; retry++ {
+		resp, err := c.doRawRequest("GET", endpoint, nil, 0, headers, func(resp *http.Response, body io.Reader) error {
+			progress := NewProgressWriter(w, endpoint, resp.ContentLength, c.Progress)
+			if _, err := io.Copy(progress, body); err != nil {
+				return fmt.Errorf("failed to read response from %s: %v", endpoint, err)
+			}
+			progress.Finish()
+			return nil
+		})
+		if err == nil || resp == nil || resp.StatusCode < 300 {
+			return resp, err
+		}
+
+		wait, retryable := rawRequestRetryWait(resp, retry, options, c.HTTP.Logger)
+		if !retryable || time.Now().Add(wait).After(deadline) {
+			return resp, err
+		}
+		c.HTTP.Logger.LogRetryAttempt("raw_request_retry", "GET", endpoint, retry+1, resp.Status, wait, err)
+		time.Sleep(wait)
+	}
+}
+
+// doRawMultipartUpload streams files as a multipart/form-data request to a Jamf Pro endpoint and decodes a
+// JSON or XML response into out when out is not nil. The request body is assembled while it is sent, so
+// files are never held in memory. When every file can be rewound, responses saying that Jamf Pro is rate
+// limiting or unavailable are retried, as the upload was not processed. The returned response body has been
+// consumed.
+func (c *Client) doRawMultipartUpload(method, endpoint string, files []rawMultipartFile, out interface{}) (*http.Response, error) {
+	// The multipart framing before each file and after the last is prepared up front so the body length is
+	// known before it is sent.
+	var (
+		framing  bytes.Buffer
+		prefixes [][]byte
+		length   int64
+		total    int64
+		names    []string
+		seekable = true
+	)
+	writer := multipart.NewWriter(&framing)
+	for _, file := range files {
+		switch {
+		case file.Size < 0:
+			total = -1
+		case total >= 0:
+			total += file.Size
+		}
+		names = append(names, file.FileName)
+		if _, ok := file.Reader.(io.Seeker); !ok {
+			seekable = false
+		}
+	}
+	if total < 0 {
+		length = -1
+	}
+	tracker := newProgressTracker(c.Progress, "upload", strings.Join(names, ", "), total)
+
+	readers := make([]*ProgressReader, 0, len(files))
+	for _, file := range files {
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			multipartQuoteEscaper.Replace(file.FieldName), multipartQuoteEscaper.Replace(file.FileName)))
+		header.Set("Content-Type", contentType)
+		if _, err := writer.CreatePart(header); err != nil {
+			return nil, fmt.Errorf("failed to create multipart request for %s: %v", endpoint, err)
+		}
+
+		prefixes = append(prefixes, append([]byte(nil), framing.Bytes()...))
+		readers = append(readers, newTrackedReader(file.Reader, tracker))
+		if length >= 0 {
+			length += int64(framing.Len()) + file.Size
+		}
+		framing.Reset()
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to create multipart request for %s: %v", endpoint, err)
+	}
+	closing := framing.Bytes()
+	if length >= 0 {
+		length += int64(len(closing))
+	}
+
+	headers := map[string]string{"Content-Type": writer.FormDataContentType()}
+	options := c.rawClientOptions()
+	deadline := time.Now().Add(options.TotalRetryDuration)
+
+	for retry := 0; ; retry++ {
+		segments := make([]io.Reader, 0, 2*len(files)+1)
+		for i, reader := range readers {
+			if retry > 0 {
+				if _, err := reader.Seek(0, io.SeekStart); err != nil {
+					return nil, fmt.Errorf("failed to rewind %s for retry: %v", files[i].FileName, err)
+				}
+			}
+			segments = append(segments, bytes.NewReader(prefixes[i]), reader)
+		}
+		segments = append(segments, bytes.NewReader(closing))
+
+		resp, err := c.doRawRequest(method, endpoint, io.MultiReader(segments...), length, headers, func(resp *http.Response, body io.Reader) error {
+			tracker.finish()
+			return decodeRawResponse(resp, body, endpoint, out)
+		})
+		if err == nil || resp == nil || resp.StatusCode < 300 || !seekable {
+			return resp, err
+		}
+		if !status.IsRateLimitError(resp) && resp.StatusCode != http.StatusServiceUnavailable {
+			return resp, err
+		}
+
+		wait, retryable := rawRequestRetryWait(resp, retry, options, c.HTTP.Logger)
+		if !retryable || time.Now().Add(wait).After(deadline) {
+			return resp, err
+		}
+		c.HTTP.Logger.LogRetryAttempt("raw_request_retry", method, endpoint, retry+1, resp.Status, wait, err)
+		time.Sleep(wait)
+	}
+}
+
+// decodeRawResponse decodes a JSON or XML response body into out when out is not nil and the body is not
+// empty.
+func decodeRawResponse(resp *http.Response, body io.Reader, endpoint string, out interface{}) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return fmt.Errorf("failed to read response from %s: %v", endpoint, err)
+	}
+
+	if out == nil || len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	if strings.Contains(resp.Header.Get("Content-Type"), "xml") {
+		err = xml.Unmarshal(data, out)
+	} else {
+		err = json.Unmarshal(data, out)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal response from %s: %v", endpoint, err)
+	}
+
+	return nil
+}
+
+// doRawRequest sends a request built by the SDK rather than the http client. It validates the auth token the
+// same way the http client does, holds one of the client's concurrency slots and cancels the request when no
+// data has moved for the configured timeout. For a successful response, handle is called to consume the
+// body; other responses are returned with an error holding the start of their body.
+func (c *Client) doRawRequest(method, endpoint string, body io.Reader, contentLength int64, headers map[string]string, handle func(resp *http.Response, body io.Reader) error) (*http.Response, error) {
+	token, err := c.rawRequestToken()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	if concurrency := c.HTTP.ConcurrencyHandler; concurrency != nil {
+		var requestID uuid.UUID
+		ctx, requestID, err = concurrency.AcquireConcurrencyToken(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire concurrency token for %s: %v", endpoint, err)
+		}
+		defer concurrency.ReleaseConcurrencyToken(requestID)
+	}
+
+	options := c.rawClientOptions()
+	ctx, guard, stop := newStallGuard(ctx, options.CustomTimeout)
+	defer stop()
+
+	url := c.HTTP.APIHandler.ConstructAPIResourceEndpoint(endpoint, c.HTTP.Logger)
+	if body != nil {
+		body = guard.Reader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for %s: %v", endpoint, err)
+	}
+	if body != nil {
+		req.ContentLength = contentLength
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", c.HTTP.APIHandler.GetAcceptHeader())
+	req.Header.Set("User-Agent", "go-api-sdk-jamfpro")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	start := time.Now()
+	resp, err := c.rawHTTPClient().Do(req)
+	if err != nil {
+		err = guard.Err(err)
+		c.HTTP.Logger.LogError("raw_request_error", method, endpoint, 0, "", err, "")
+		return nil, fmt.Errorf("failed to request %s: %v", endpoint, err)
+	}
+	defer resp.Body.Close()
+	c.HTTP.Logger.LogRequestEnd("raw_request", method, endpoint, resp.StatusCode, time.Since(start))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		apiErr := newRawStatusError(resp, url, data)
+		c.HTTP.Logger.LogError("raw_request_error", method, endpoint, resp.StatusCode, resp.Status, apiErr, string(data))
+		return resp, apiErr
+	}
+
+	if err := handle(resp, guard.Reader(resp.Body)); err != nil {
+		return resp, guard.Err(err)
+	}
+
+	return resp, nil
+}
+
+// newRawStatusError returns the error the http client returns for a non-success response, so that raw
+// requests are enriched and inspected like the SDK's other requests.
+func newRawStatusError(resp *http.Response, requestURL string, body []byte) *response.APIError {
+	return &response.APIError{
+		StatusCode:  resp.StatusCode,
+		Method:      resp.Request.Method,
+		URL:         requestURL,
+		Message:     fmt.Sprintf("received non-success status code %d", resp.StatusCode),
+		RawResponse: string(body),
+	}
+}
+
+// rawRequestRetryWait reports whether a failed raw request may be retried and how long to wait first. Like
+// the http client, rate limited responses wait for the time the server asks for and transient server errors
+// back off exponentially, up to the configured number of retries.
+func rawRequestRetryWait(resp *http.Response, retry int, options httpclient.ClientOptions, log logger.Logger) (time.Duration, bool) {
+	if retry >= options.MaxRetryAttempts {
+		return 0, false
+	}
+	if status.IsRateLimitError(resp) {
+		if wait := ratehandler.ParseRateLimitHeaders(resp, log); wait > 0 {
+			return wait, true
+		}
+		return ratehandler.CalculateBackoff(retry + 1), true
+	}
+	if status.IsTransientError(resp) {
+		return ratehandler.CalculateBackoff(retry + 1), true
+	}
+	return 0, false
+}
+
+// rawRequestToken returns a bearer token for raw requests. The token is checked, and obtained or refreshed
+// when missing or within the configured refresh buffer of expiry, by the auth token handler exactly as the
+// http client does before each of its requests.
+func (c *Client) rawRequestToken() (string, error) {
+	handler := c.HTTP.AuthTokenHandler
+	if handler == nil {
+		return "", fmt.Errorf("http client has no auth token handler")
+	}
+
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	valid, err := handler.ValidAuthTokenCheck(c.HTTP.APIHandler, c.rawHTTPClient(), handler.Credentials, c.rawClientOptions().TokenRefreshBufferPeriod)
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh auth token: %v", err)
+	}
+	if !valid || handler.Token == "" {
+		return "", fmt.Errorf("no auth token available")
+	}
+
+	return handler.Token, nil
+}
+
+// rawClientOptions returns the options the client was built with, using the http client's defaults for
+// the timeouts a client built without them leaves unset.
+func (c *Client) rawClientOptions() httpclient.ClientOptions {
+	options := c.options
+	if options.CustomTimeout <= 0 {
+		options.CustomTimeout = httpclient.DefaultTimeout
+	}
+	if options.TokenRefreshBufferPeriod <= 0 {
+		options.TokenRefreshBufferPeriod = httpclient.DefaultTokenBufferPeriod
+	}
+	if options.TotalRetryDuration <= 0 {
+		options.TotalRetryDuration = httpclient.DefaultTotalRetryDuration
+	}
+	return options
+}
+
+// rawHTTPClient returns the http.Client used for raw requests. It is set up like the http client's own, with
+// the default transport and its proxy settings, the configured cookie jar and redirect handling. It has no
+// overall timeout, so that large transfers are not cut off; newStallGuard ends transfers that stall instead.
+func (c *Client) rawHTTPClient() *http.Client {
+	c.rawClientOnce.Do(func() {
+		options := c.rawClientOptions()
+		client := &http.Client{}
+		if err := cookiejar.SetupCookieJar(client, options.EnableCookieJar, c.HTTP.Logger); err != nil {
+			c.HTTP.Logger.Warn("Raw requests will not use a cookie jar")
+		}
+		if err := redirecthandler.SetupRedirectHandler(client, options.FollowRedirects, options.MaxRedirects, c.HTTP.Logger); err != nil {
+			c.HTTP.Logger.Warn("Raw requests will follow redirects with the default policy")
+		}
+		c.rawClient = client
+	})
+	return c.rawClient
+}
+
+// stallGuard cancels a request when no data has been sent or received for its timeout, so that a stalled
+// connection fails instead of blocking forever while a slow but moving transfer can take as long as it needs.
+type stallGuard struct {
+	ctx     context.Context
+	timeout time.Duration
+	timer   *time.Timer
+}
+
+// errTransferStalled is the cause of requests cancelled by a stallGuard.
+var errTransferStalled = errors.New("transfer stalled")
+
+// newStallGuard returns a context cancelled once timeout passes without the guard's readers moving data.
+// The returned function releases the guard and must be called when the request is done.
+func newStallGuard(ctx context.Context, timeout time.Duration) (context.Context, *stallGuard, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	guard := &stallGuard{ctx: ctx, timeout: timeout}
+	guard.timer = time.AfterFunc(timeout, func() {
+		cancel(fmt.Errorf("%w: no data transferred for %s", errTransferStalled, timeout))
+	})
+	return ctx, guard, func() {
+		guard.timer.Stop()
+		cancel(nil)
+	}
+}
+
+// Reader returns r with each read that moves data extending the guard's timeout.
+func (g *stallGuard) Reader(r io.Reader) io.Reader {
+	return &stallGuardReader{reader: r, guard: g}
+}
+
+// Err returns the reason the guard cancelled the request in place of err, or err when it did not.
+func (g *stallGuard) Err(err error) error {
+	if cause := context.Cause(g.ctx); errors.Is(cause, errTransferStalled) {
+		return cause
+	}
+	return err
+}
+
+// stallGuardReader extends the timeout of its guard whenever data is read.
+type stallGuardReader struct {
+	reader io.Reader
+	guard  *stallGuard
+}
+
+// Read reads from the underlying reader and extends the guard's timeout when data was read.
+func (r *stallGuardReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.guard.timer.Reset(r.guard.timeout)
+	}
+	return n, err
+}
+
+// openRawMultipartFiles opens files keyed by form field name for doRawMultipartUpload. The returned function
+// closes them.
+func openRawMultipartFiles(files map[string]string) ([]rawMultipartFile, func(), error) {
+	var opened []*os.File
+	closeAll := func() {
+		for _, file := range opened {
+			file.Close()
+		}
+	}
+
+	fields := make([]string, 0, len(files))
+	for field := range files {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	parts := make([]rawMultipartFile, 0, len(files))
+	for _, field := range fields {
+		path := filepath.Clean(files[field])
+		file, err := os.Open(path)
+		if err != nil {
+			closeAll()
+			return nil, nil, fmt.Errorf("failed to open file %s: %v", path, err)
+		}
+		opened = append(opened, file)
+
+		info, err := file.Stat()
+		if err != nil {
+			closeAll()
+			return nil, nil, fmt.Errorf("failed to stat file %s: %v", path, err)
+		}
+		if !info.Mode().IsRegular() {
+			closeAll()
+			return nil, nil, fmt.Errorf("file %s is not a regular file", path)
+		}
+
+		parts = append(parts, rawMultipartFile{
+			FieldName: field,
+			FileName:  filepath.Base(path),
+			Reader:    file,
+			Size:      info.Size(),
+		})
+	}
+
+	return parts, closeAll, nil
+}
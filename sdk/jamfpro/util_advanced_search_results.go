@@ -0,0 +1,253 @@
+// util_advanced_search_results.go
+// Typed access to the result rows returned with Classic API advanced computer, mobile device and user searches,
+// plus CSV and JSON export helpers.
+// Jamf Pro returns one element per display field in each result row, named after the display field with
+// spaces and punctuation replaced by underscores (e.g. "Operating System Version" -> <Operating_System_Version>).
+// Rows are therefore decoded dynamically and keyed by the SharedAdvancedSearchSubsetDisplayField name.
+
+package jamfpro
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// advancedSearchResultContainers maps the result container element of each advanced search type to its row element.
+var advancedSearchResultContainers = map[string]string{
+	"computers":      "computer",
+	"mobile_devices": "mobile_device",
+	"users":          "user",
+}
+
+// ResponseAdvancedSearchResults represents the result set of an advanced computer, mobile device or user search.
+type ResponseAdvancedSearchResults struct {
+	ID            int                       `json:"id"`
+	Name          string                    `json:"name"`
+	DisplayFields []string                  `json:"display_fields"`
+	Size          int                       `json:"size"`
+	Results       []AdvancedSearchResultRow `json:"results"`
+}
+
+// AdvancedSearchResultRow is a single result row. Fields is keyed by display field name; any additional
+// columns returned by Jamf Pro that do not match a display field are keyed by their XML element name.
+type AdvancedSearchResultRow struct {
+	ID     int               `json:"id"`
+	Name   string            `json:"name"`
+	Fields map[string]string `json:"fields"`
+}
+
+// UnmarshalXML decodes an advanced search response, collecting the display fields and the dynamic result rows.
+func (r *ResponseAdvancedSearchResults) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			switch element.Name.Local {
+			case "id":
+				var value string
+				if err := d.DecodeElement(&value, &element); err != nil {
+					return err
+				}
+				r.ID, _ = strconv.Atoi(strings.TrimSpace(value))
+			case "name":
+				if err := d.DecodeElement(&r.Name, &element); err != nil {
+					return err
+				}
+			case "display_fields":
+				var displayFields SharedAdvancedSearchContainerDisplayField
+				if err := d.DecodeElement(&displayFields, &element); err != nil {
+					return err
+				}
+				for _, field := range displayFields.DisplayField {
+					r.DisplayFields = append(r.DisplayFields, field.Name)
+				}
+			default:
+				rowElement, ok := advancedSearchResultContainers[element.Name.Local]
+				if !ok {
+					if err := d.Skip(); err != nil {
+						return err
+					}
+					continue
+				}
+				if err := r.decodeRows(d, rowElement); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			if element.Name.Local == start.Name.Local {
+				r.mapDisplayFields()
+				return nil
+			}
+		}
+	}
+}
+
+// decodeRows decodes the children of a result container into result rows.
+func (r *ResponseAdvancedSearchResults) decodeRows(d *xml.Decoder, rowElement string) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			switch element.Name.Local {
+			case "size":
+				var value string
+				if err := d.DecodeElement(&value, &element); err != nil {
+					return err
+				}
+				r.Size, _ = strconv.Atoi(strings.TrimSpace(value))
+			case rowElement:
+				row, err := decodeAdvancedSearchResultRow(d)
+				if err != nil {
+					return err
+				}
+				r.Results = append(r.Results, row)
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// decodeAdvancedSearchResultRow decodes the column elements of a single result row.
+func decodeAdvancedSearchResultRow(d *xml.Decoder) (AdvancedSearchResultRow, error) {
+	row := AdvancedSearchResultRow{Fields: map[string]string{}}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return row, err
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			var value string
+			if err := d.DecodeElement(&value, &element); err != nil {
+				return row, err
+			}
+			switch element.Name.Local {
+			case "id":
+				row.ID, _ = strconv.Atoi(strings.TrimSpace(value))
+			case "name":
+				row.Name = value
+			default:
+				row.Fields[element.Name.Local] = value
+			}
+		case xml.EndElement:
+			return row, nil
+		}
+	}
+}
+
+// mapDisplayFields re-keys each row's columns from XML element names to display field names.
+func (r *ResponseAdvancedSearchResults) mapDisplayFields() {
+	lookup := make(map[string]string, len(r.DisplayFields))
+	for _, field := range r.DisplayFields {
+		lookup[normaliseAdvancedSearchFieldName(field)] = field
+	}
+
+	for i, row := range r.Results {
+		fields := make(map[string]string, len(row.Fields))
+		for element, value := range row.Fields {
+			if field, ok := lookup[normaliseAdvancedSearchFieldName(element)]; ok {
+				fields[field] = value
+			} else {
+				fields[element] = value
+			}
+		}
+		r.Results[i].Fields = fields
+	}
+
+	if r.Size == 0 {
+		r.Size = len(r.Results)
+	}
+}
+
+// normaliseAdvancedSearchFieldName reduces a display field or element name to lower case letters and digits
+// so that "Operating System Version" and "Operating_System_Version" compare equal.
+func normaliseAdvancedSearchFieldName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Columns returns the export column order: ID, Name, then each display field in search order.
+func (r *ResponseAdvancedSearchResults) Columns() []string {
+	columns := []string{"ID", "Name"}
+	for _, field := range r.DisplayFields {
+		if normaliseAdvancedSearchFieldName(field) == "name" {
+			continue
+		}
+		columns = append(columns, field)
+	}
+	return columns
+}
+
+// WriteCSV writes the result rows as CSV with a header row taken from Columns.
+func (r *ResponseAdvancedSearchResults) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	columns := r.Columns()
+	if err := writer.Write(columns); err != nil {
+		return fmt.Errorf("failed to write advanced search results csv header, error: %v", err)
+	}
+
+	for _, row := range r.Results {
+		record := []string{strconv.Itoa(row.ID), row.Name}
+		for _, column := range columns[2:] {
+			record = append(record, row.Fields[column])
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write advanced search results csv row for id: %d, error: %v", row.ID, err)
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteJSON writes the full result set, including display fields and rows, as indented JSON.
+func (r *ResponseAdvancedSearchResults) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "    ")
+	if err := encoder.Encode(r); err != nil {
+		return fmt.Errorf(errMsgFailedJsonMarshal, "advanced search results", err)
+	}
+	return nil
+}
+
+// getAdvancedSearchResults retrieves an advanced search by endpoint and decodes its result rows.
+func (c *Client) getAdvancedSearchResults(endpoint string) (*ResponseAdvancedSearchResults, error) {
+	var results ResponseAdvancedSearchResults
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &results)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return &results, nil
+}
@@ -0,0 +1,126 @@
+package jamfpro
+
+import (
+	"bytes"
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+func TestResponseAdvancedSearchResultsUnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name string
+		xml  string
+		want ResponseAdvancedSearchResults
+	}{
+		{
+			name: "computer search",
+			xml: `<advanced_computer_search>
+				<id>12</id>
+				<name>Outdated Macs</name>
+				<view_as>Standard Web Page</view_as>
+				<criteria><size>1</size><criterion><name>Model</name></criterion></criteria>
+				<display_fields>
+					<size>3</size>
+					<display_field><name>Computer Name</name></display_field>
+					<display_field><name>Operating System Version</name></display_field>
+					<display_field><name>Last Check-in</name></display_field>
+				</display_fields>
+				<computers>
+					<size>2</size>
+					<computer>
+						<id>1</id>
+						<name>mac-01</name>
+						<Computer_Name>mac-01</Computer_Name>
+						<Operating_System_Version>13.6</Operating_System_Version>
+						<Last_Check_in>2024-02-01 08:00:00</Last_Check_in>
+					</computer>
+					<computer>
+						<id>2</id>
+						<name>mac-02</name>
+						<Operating_System_Version>14.3</Operating_System_Version>
+						<udid>ABC</udid>
+					</computer>
+				</computers>
+			</advanced_computer_search>`,
+			want: ResponseAdvancedSearchResults{
+				ID:            12,
+				Name:          "Outdated Macs",
+				DisplayFields: []string{"Computer Name", "Operating System Version", "Last Check-in"},
+				Size:          2,
+				Results: []AdvancedSearchResultRow{
+					{ID: 1, Name: "mac-01", Fields: map[string]string{
+						"Computer Name":            "mac-01",
+						"Operating System Version": "13.6",
+						"Last Check-in":            "2024-02-01 08:00:00",
+					}},
+					{ID: 2, Name: "mac-02", Fields: map[string]string{
+						"Operating System Version": "14.3",
+						"udid":                     "ABC",
+					}},
+				},
+			},
+		},
+		{
+			name: "mobile device search without size",
+			xml: `<advanced_mobile_device_search>
+				<id>3</id>
+				<name>iPads</name>
+				<display_fields><display_field><name>Serial Number</name></display_field></display_fields>
+				<mobile_devices>
+					<mobile_device><id>9</id><name>ipad-09</name><Serial_Number>F9</Serial_Number></mobile_device>
+				</mobile_devices>
+			</advanced_mobile_device_search>`,
+			want: ResponseAdvancedSearchResults{
+				ID:            3,
+				Name:          "iPads",
+				DisplayFields: []string{"Serial Number"},
+				Size:          1,
+				Results: []AdvancedSearchResultRow{
+					{ID: 9, Name: "ipad-09", Fields: map[string]string{"Serial Number": "F9"}},
+				},
+			},
+		},
+		{
+			name: "user search without results",
+			xml: `<advanced_user_search>
+				<id>4</id>
+				<name>Nobody</name>
+				<users><size>0</size></users>
+			</advanced_user_search>`,
+			want: ResponseAdvancedSearchResults{ID: 4, Name: "Nobody"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ResponseAdvancedSearchResults
+			if err := xml.Unmarshal([]byte(tt.xml), &got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("results = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResponseAdvancedSearchResultsWriteCSV(t *testing.T) {
+	results := ResponseAdvancedSearchResults{
+		DisplayFields: []string{"Computer Name", "Operating System Version"},
+		Results: []AdvancedSearchResultRow{
+			{ID: 1, Name: "mac-01", Fields: map[string]string{"Operating System Version": "13.6"}},
+			{ID: 2, Name: "mac, 02", Fields: map[string]string{}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := results.WriteCSV(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "ID,Name,Computer Name,Operating System Version\n1,mac-01,,13.6\n2,\"mac, 02\",,\n"
+	if buf.String() != want {
+		t.Errorf("csv = %q, want %q", buf.String(), want)
+	}
+}
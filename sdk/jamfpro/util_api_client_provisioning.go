@@ -0,0 +1,233 @@
+// util_api_client_provisioning.go
+// Least-privilege provisioning of API roles and API clients. Given the SDK methods an automation tool calls,
+// the privileges it needs are computed from the SDK privilege table, an API role with exactly those
+// privileges is created or updated, and an API client bound to that role is created with fresh credentials.
+// The role and API client share the display name of the tool so re-running provisioning is idempotent.
+
+package jamfpro
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// APIClientProvisioningRequest describes the identity an automation tool needs.
+type APIClientProvisioningRequest struct {
+	// DisplayName names both the API role and the API client.
+	DisplayName string
+	// Methods are the Client methods the tool calls, e.g. "GetScripts" or "CreatePolicy".
+	Methods []string
+	// AdditionalPrivileges are added as-is, for endpoints the tool calls outside the SDK.
+	AdditionalPrivileges []string
+	// AccessTokenLifetimeSeconds is the access token lifetime of the API client. Zero keeps the Jamf Pro
+	// default, or the current lifetime of an existing API client.
+	AccessTokenLifetimeSeconds int
+}
+
+// APIClientProvisioningPlan is what ProvisionAPIClient will do for a request.
+type APIClientProvisioningPlan struct {
+	DisplayName           string   `json:"displayName"`
+	Privileges            []string `json:"privileges"`
+	UnknownMethods        []string `json:"unknownMethods,omitempty"`
+	UnavailablePrivileges []string `json:"unavailablePrivileges,omitempty"`
+	RoleID                string   `json:"roleId,omitempty"`
+	IntegrationID         int      `json:"integrationId,omitempty"`
+	PrivilegesToAdd       []string `json:"privilegesToAdd,omitempty"`
+	PrivilegesToRemove    []string `json:"privilegesToRemove,omitempty"`
+}
+
+// APIClientProvisioningResult is the outcome of ProvisionAPIClient. Credentials are only set when the API
+// client was created; use RotateAPIClientCredentials to issue new credentials for an existing client.
+type APIClientProvisioningResult struct {
+	Plan        *APIClientProvisioningPlan `json:"plan"`
+	Role        *ResourceAPIRole           `json:"role"`
+	Integration *ResourceApiIntegration    `json:"integration"`
+	Credentials *ResourceClientCredentials `json:"-"`
+}
+
+// RoleExists reports whether an API role with the display name already exists.
+func (p *APIClientProvisioningPlan) RoleExists() bool {
+	return p.RoleID != ""
+}
+
+// IntegrationExists reports whether an API client with the display name already exists.
+func (p *APIClientProvisioningPlan) IntegrationExists() bool {
+	return p.IntegrationID != 0
+}
+
+// Valid reports whether every method is known and every privilege is offered by the Jamf Pro server.
+func (p *APIClientProvisioningPlan) Valid() bool {
+	return len(p.UnknownMethods) == 0 && len(p.UnavailablePrivileges) == 0
+}
+
+// PlanAPIClientProvisioning computes the privileges for a request and compares them with the existing API
+// role and API client of the same name without changing anything.
+func (c *Client) PlanAPIClientProvisioning(request APIClientProvisioningRequest) (*APIClientProvisioningPlan, error) {
+	if request.DisplayName == "" {
+		return nil, fmt.Errorf("api client provisioning requires a display name")
+	}
+
+	privileges, unknown := RequiredPrivilegesForMethods(request.Methods...)
+	privileges = mergePrivileges(privileges, request.AdditionalPrivileges)
+	if len(privileges) == 0 {
+		return nil, fmt.Errorf("api client provisioning for %s requires at least one privilege", request.DisplayName)
+	}
+
+	plan := &APIClientProvisioningPlan{
+		DisplayName:    request.DisplayName,
+		Privileges:     privileges,
+		UnknownMethods: unknown,
+	}
+
+	available, err := c.GetJamfAPIPrivileges()
+	if err != nil {
+		return nil, err
+	}
+	offered := make(map[string]bool, len(available.Privileges))
+	for _, privilege := range available.Privileges {
+		offered[privilege] = true
+	}
+	for _, privilege := range privileges {
+		if !offered[privilege] {
+			plan.UnavailablePrivileges = append(plan.UnavailablePrivileges, privilege)
+		}
+	}
+
+	roles, err := c.GetJamfAPIRoles("")
+	if err != nil {
+		return nil, err
+	}
+	plan.PrivilegesToAdd = privileges
+	for _, role := range roles.Results {
+		if role.DisplayName == request.DisplayName {
+			plan.RoleID = role.ID
+			plan.PrivilegesToAdd = subtractPrivileges(privileges, role.Privileges)
+			plan.PrivilegesToRemove = subtractPrivileges(role.Privileges, privileges)
+			break
+		}
+	}
+
+	integrations, err := c.GetApiIntegrations("")
+	if err != nil {
+		return nil, err
+	}
+	for _, integration := range integrations.Results {
+		if integration.DisplayName == request.DisplayName {
+			plan.IntegrationID = integration.ID
+			break
+		}
+	}
+
+	return plan, nil
+}
+
+// ProvisionAPIClient creates or updates the API role and API client for a request so that the role holds
+// exactly the computed privileges. Provisioning is refused when the plan contains unknown methods or
+// privileges the server does not offer, as the resulting identity would not work as intended. An existing API
+// client keeps its state; only its scopes and requested token lifetime are updated.
+func (c *Client) ProvisionAPIClient(request APIClientProvisioningRequest) (*APIClientProvisioningResult, error) {
+	plan, err := c.PlanAPIClientProvisioning(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(plan.UnknownMethods) > 0 {
+		return nil, fmt.Errorf("no privilege mapping for methods: %v, add their privileges with AdditionalPrivileges", plan.UnknownMethods)
+	}
+	if len(plan.UnavailablePrivileges) > 0 {
+		return nil, fmt.Errorf("privileges not offered by this jamf pro server: %v", plan.UnavailablePrivileges)
+	}
+
+	result := &APIClientProvisioningResult{Plan: plan}
+
+	role := &ResourceAPIRole{
+		DisplayName: plan.DisplayName,
+		Privileges:  plan.Privileges,
+	}
+	switch {
+	case !plan.RoleExists():
+		result.Role, err = c.CreateJamfApiRole(role)
+	case len(plan.PrivilegesToAdd) > 0 || len(plan.PrivilegesToRemove) > 0:
+		result.Role, err = c.UpdateJamfApiRoleByID(plan.RoleID, role)
+	default:
+		result.Role, err = c.GetJamfApiRoleByID(plan.RoleID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if plan.IntegrationExists() {
+		// Only the scopes, and the lifetime when one is requested, change on an existing API client
+		integration, err := c.GetApiIntegrationByID(plan.IntegrationID)
+		if err != nil {
+			return nil, err
+		}
+		integration.AuthorizationScopes = []string{plan.DisplayName}
+		if request.AccessTokenLifetimeSeconds > 0 {
+			integration.AccessTokenLifetimeSeconds = request.AccessTokenLifetimeSeconds
+		}
+		result.Integration, err = c.UpdateApiIntegrationByID(plan.IntegrationID, integration)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	integration := &ResourceApiIntegration{
+		DisplayName:                plan.DisplayName,
+		AuthorizationScopes:        []string{plan.DisplayName},
+		Enabled:                    true,
+		AccessTokenLifetimeSeconds: request.AccessTokenLifetimeSeconds,
+	}
+	result.Integration, err = c.CreateApiIntegration(integration)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Credentials, err = c.RefreshClientCredentialsByApiRoleID(strconv.Itoa(result.Integration.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RotateAPIClientCredentials issues new credentials for the API client with the given display name. The
+// previous client secret stops working once the new one is issued.
+func (c *Client) RotateAPIClientCredentials(displayName string) (*ResourceClientCredentials, error) {
+	integration, err := c.GetApiIntegrationByName(displayName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.RefreshClientCredentialsByApiRoleID(strconv.Itoa(integration.ID))
+}
+
+// mergePrivileges returns the sorted union of two privilege lists.
+func mergePrivileges(a, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	var out []string
+	for _, privilege := range append(append([]string{}, a...), b...) {
+		if privilege != "" && !seen[privilege] {
+			seen[privilege] = true
+			out = append(out, privilege)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// subtractPrivileges returns the privileges in a that are not in b.
+func subtractPrivileges(a, b []string) []string {
+	exclude := make(map[string]bool, len(b))
+	for _, privilege := range b {
+		exclude[privilege] = true
+	}
+	var out []string
+	for _, privilege := range a {
+		if !exclude[privilege] {
+			out = append(out, privilege)
+		}
+	}
+	return out
+}
@@ -0,0 +1,330 @@
+// util_criteria_builder.go
+// Fluent builder and validator for smart group and advanced search criteria.
+// The builder produces SharedContainerCriteria / []SharedSubsetCriteria with priorities,
+// and/or joins and parentheses assigned, so it can be used with computer, mobile device
+// and user smart groups as well as advanced computer, mobile device and user searches.
+
+/*
+Example:
+
+	criteria, err := jamfpro.Where("Operating System Version").GreaterThanOrEqual("14.0").
+		And("Computer Group").MemberOf("All Managed Clients").
+		AndGroup(jamfpro.Where("Model").Like("MacBook").Or("Model").Like("iMac")).
+		Build()
+*/
+
+package jamfpro
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Criteria join operators
+const (
+	CriteriaAndOrAnd = "and"
+	CriteriaAndOrOr  = "or"
+)
+
+// Criteria search types supported by Jamf Pro smart groups and advanced searches.
+const (
+	SearchTypeIs                 = "is"
+	SearchTypeIsNot              = "is not"
+	SearchTypeLike               = "like"
+	SearchTypeNotLike            = "not like"
+	SearchTypeHas                = "has"
+	SearchTypeDoesNotHave        = "does not have"
+	SearchTypeMatchesRegex       = "matches regex"
+	SearchTypeDoesNotMatchRegex  = "does not match regex"
+	SearchTypeGreaterThan        = "greater than"
+	SearchTypeLessThan           = "less than"
+	SearchTypeGreaterThanOrEqual = "greater than or equal"
+	SearchTypeLessThanOrEqual    = "less than or equal"
+	SearchTypeMemberOf           = "member of"
+	SearchTypeNotMemberOf        = "not member of"
+	SearchTypeMoreThanXDaysAgo   = "more than x days ago"
+	SearchTypeLessThanXDaysAgo   = "less than x days ago"
+	SearchTypeBeforeDate         = "before (yyyy-mm-dd)"
+	SearchTypeAfterDate          = "after (yyyy-mm-dd)"
+	SearchTypeCurrent            = "current"
+	SearchTypeNotCurrent         = "not current"
+)
+
+// validCriteriaSearchTypes is the set of search types accepted by ValidateCriteria.
+var validCriteriaSearchTypes = map[string]bool{
+	SearchTypeIs:                 true,
+	SearchTypeIsNot:              true,
+	SearchTypeLike:               true,
+	SearchTypeNotLike:            true,
+	SearchTypeHas:                true,
+	SearchTypeDoesNotHave:        true,
+	SearchTypeMatchesRegex:       true,
+	SearchTypeDoesNotMatchRegex:  true,
+	SearchTypeGreaterThan:        true,
+	SearchTypeLessThan:           true,
+	SearchTypeGreaterThanOrEqual: true,
+	SearchTypeLessThanOrEqual:    true,
+	SearchTypeMemberOf:           true,
+	SearchTypeNotMemberOf:        true,
+	SearchTypeMoreThanXDaysAgo:   true,
+	SearchTypeLessThanXDaysAgo:   true,
+	SearchTypeBeforeDate:         true,
+	SearchTypeAfterDate:          true,
+	SearchTypeCurrent:            true,
+	SearchTypeNotCurrent:         true,
+}
+
+// CriteriaBuilder accumulates criteria in order. Priorities are assigned when Build is called.
+type CriteriaBuilder struct {
+	criteria []SharedSubsetCriteria
+	errs     []string
+}
+
+// CriteriaCondition is a pending criterion that is completed by calling one of its search type methods.
+type CriteriaCondition struct {
+	builder *CriteriaBuilder
+	name    string
+	andOr   string
+}
+
+// Where starts a new set of criteria with the named criterion.
+func Where(name string) *CriteriaCondition {
+	return &CriteriaCondition{builder: &CriteriaBuilder{}, name: name, andOr: CriteriaAndOrAnd}
+}
+
+// And adds a criterion joined to the previous one with "and".
+func (b *CriteriaBuilder) And(name string) *CriteriaCondition {
+	return &CriteriaCondition{builder: b, name: name, andOr: CriteriaAndOrAnd}
+}
+
+// Or adds a criterion joined to the previous one with "or".
+func (b *CriteriaBuilder) Or(name string) *CriteriaCondition {
+	return &CriteriaCondition{builder: b, name: name, andOr: CriteriaAndOrOr}
+}
+
+// AndGroup appends the criteria of group wrapped in parentheses and joined with "and".
+func (b *CriteriaBuilder) AndGroup(group *CriteriaBuilder) *CriteriaBuilder {
+	return b.appendGroup(group, CriteriaAndOrAnd)
+}
+
+// OrGroup appends the criteria of group wrapped in parentheses and joined with "or".
+func (b *CriteriaBuilder) OrGroup(group *CriteriaBuilder) *CriteriaBuilder {
+	return b.appendGroup(group, CriteriaAndOrOr)
+}
+
+// appendGroup wraps the criteria of group in parentheses. Jamf Pro only supports a single
+// opening or closing parenthesis per criterion, so groups cannot be nested at their edges.
+func (b *CriteriaBuilder) appendGroup(group *CriteriaBuilder, andOr string) *CriteriaBuilder {
+	if group == nil || len(group.criteria) == 0 {
+		b.errs = append(b.errs, "criteria group is empty")
+		return b
+	}
+
+	b.errs = append(b.errs, group.errs...)
+
+	criteria := make([]SharedSubsetCriteria, len(group.criteria))
+	copy(criteria, group.criteria)
+
+	first, last := &criteria[0], &criteria[len(criteria)-1]
+	if first.OpeningParen || last.ClosingParen {
+		b.errs = append(b.errs, fmt.Sprintf("criteria group starting with %q cannot be nested directly inside another group", first.Name))
+	}
+	if len(criteria) == 1 {
+		b.errs = append(b.errs, fmt.Sprintf("criteria group containing only %q does not need parentheses", first.Name))
+	}
+
+	first.AndOr = andOr
+	first.OpeningParen = true
+	last.ClosingParen = true
+
+	b.criteria = append(b.criteria, criteria...)
+	return b
+}
+
+// Build validates the accumulated criteria and returns them as a SharedContainerCriteria
+// for use with computer groups, mobile device groups and advanced searches.
+func (b *CriteriaBuilder) Build() (SharedContainerCriteria, error) {
+	criteria, err := b.BuildCriteria()
+	if err != nil {
+		return SharedContainerCriteria{}, err
+	}
+
+	return SharedContainerCriteria{Size: len(criteria), Criterion: criteria}, nil
+}
+
+// BuildCriteria validates the accumulated criteria and returns them as a slice, for use
+// with resources such as ResourceUserGroup that take the criteria list directly.
+func (b *CriteriaBuilder) BuildCriteria() ([]SharedSubsetCriteria, error) {
+	if len(b.errs) > 0 {
+		return nil, fmt.Errorf("invalid criteria: %s", strings.Join(b.errs, "; "))
+	}
+
+	criteria := make([]SharedSubsetCriteria, len(b.criteria))
+	copy(criteria, b.criteria)
+
+	for i := range criteria {
+		criteria[i].Priority = i
+	}
+	if len(criteria) > 0 {
+		criteria[0].AndOr = CriteriaAndOrAnd
+	}
+
+	if err := ValidateCriteria(criteria); err != nil {
+		return nil, err
+	}
+
+	return criteria, nil
+}
+
+// add completes a pending condition and appends it to the builder.
+func (cond *CriteriaCondition) add(searchType, value string) *CriteriaBuilder {
+	cond.builder.criteria = append(cond.builder.criteria, SharedSubsetCriteria{
+		Name:       cond.name,
+		AndOr:      cond.andOr,
+		SearchType: searchType,
+		Value:      value,
+	})
+	return cond.builder
+}
+
+// Is adds an "is" criterion.
+func (cond *CriteriaCondition) Is(value string) *CriteriaBuilder {
+	return cond.add(SearchTypeIs, value)
+}
+
+// IsNot adds an "is not" criterion.
+func (cond *CriteriaCondition) IsNot(value string) *CriteriaBuilder {
+	return cond.add(SearchTypeIsNot, value)
+}
+
+// Like adds a "like" criterion.
+func (cond *CriteriaCondition) Like(value string) *CriteriaBuilder {
+	return cond.add(SearchTypeLike, value)
+}
+
+// NotLike adds a "not like" criterion.
+func (cond *CriteriaCondition) NotLike(value string) *CriteriaBuilder {
+	return cond.add(SearchTypeNotLike, value)
+}
+
+// Has adds a "has" criterion.
+func (cond *CriteriaCondition) Has(value string) *CriteriaBuilder {
+	return cond.add(SearchTypeHas, value)
+}
+
+// DoesNotHave adds a "does not have" criterion.
+func (cond *CriteriaCondition) DoesNotHave(value string) *CriteriaBuilder {
+	return cond.add(SearchTypeDoesNotHave, value)
+}
+
+// MatchesRegex adds a "matches regex" criterion.
+func (cond *CriteriaCondition) MatchesRegex(pattern string) *CriteriaBuilder {
+	return cond.add(SearchTypeMatchesRegex, pattern)
+}
+
+// DoesNotMatchRegex adds a "does not match regex" criterion.
+func (cond *CriteriaCondition) DoesNotMatchRegex(pattern string) *CriteriaBuilder {
+	return cond.add(SearchTypeDoesNotMatchRegex, pattern)
+}
+
+// GreaterThan adds a "greater than" criterion.
+func (cond *CriteriaCondition) GreaterThan(value string) *CriteriaBuilder {
+	return cond.add(SearchTypeGreaterThan, value)
+}
+
+// LessThan adds a "less than" criterion.
+func (cond *CriteriaCondition) LessThan(value string) *CriteriaBuilder {
+	return cond.add(SearchTypeLessThan, value)
+}
+
+// GreaterThanOrEqual adds a "greater than or equal" criterion.
+func (cond *CriteriaCondition) GreaterThanOrEqual(value string) *CriteriaBuilder {
+	return cond.add(SearchTypeGreaterThanOrEqual, value)
+}
+
+// LessThanOrEqual adds a "less than or equal" criterion.
+func (cond *CriteriaCondition) LessThanOrEqual(value string) *CriteriaBuilder {
+	return cond.add(SearchTypeLessThanOrEqual, value)
+}
+
+// MemberOf adds a "member of" criterion, typically used with the "Computer Group" criterion.
+func (cond *CriteriaCondition) MemberOf(group string) *CriteriaBuilder {
+	return cond.add(SearchTypeMemberOf, group)
+}
+
+// NotMemberOf adds a "not member of" criterion.
+func (cond *CriteriaCondition) NotMemberOf(group string) *CriteriaBuilder {
+	return cond.add(SearchTypeNotMemberOf, group)
+}
+
+// MoreThanXDaysAgo adds a "more than x days ago" criterion.
+func (cond *CriteriaCondition) MoreThanXDaysAgo(days int) *CriteriaBuilder {
+	return cond.add(SearchTypeMoreThanXDaysAgo, fmt.Sprintf("%d", days))
+}
+
+// LessThanXDaysAgo adds a "less than x days ago" criterion.
+func (cond *CriteriaCondition) LessThanXDaysAgo(days int) *CriteriaBuilder {
+	return cond.add(SearchTypeLessThanXDaysAgo, fmt.Sprintf("%d", days))
+}
+
+// Before adds a "before (yyyy-mm-dd)" criterion.
+func (cond *CriteriaCondition) Before(date string) *CriteriaBuilder {
+	return cond.add(SearchTypeBeforeDate, date)
+}
+
+// After adds an "after (yyyy-mm-dd)" criterion.
+func (cond *CriteriaCondition) After(date string) *CriteriaBuilder {
+	return cond.add(SearchTypeAfterDate, date)
+}
+
+// SearchType adds a criterion with an explicit search type, for search types not covered by the helpers above.
+// The search type is still checked when the criteria are built.
+func (cond *CriteriaCondition) SearchType(searchType, value string) *CriteriaBuilder {
+	return cond.add(searchType, value)
+}
+
+// ValidateCriteria checks hand built or builder generated criteria for the mistakes that Jamf Pro
+// otherwise reports as a 409 conflict: missing names, unknown search types or and/or values,
+// out of order priorities and unbalanced or nested parentheses.
+func ValidateCriteria(criteria []SharedSubsetCriteria) error {
+	var errs []string
+	open := false
+
+	for i, criterion := range criteria {
+		if criterion.Name == "" {
+			errs = append(errs, fmt.Sprintf("criterion %d has no name", i))
+		}
+		if !validCriteriaSearchTypes[strings.ToLower(criterion.SearchType)] {
+			errs = append(errs, fmt.Sprintf("criterion %d (%s) has unsupported search type %q", i, criterion.Name, criterion.SearchType))
+		}
+		if andOr := strings.ToLower(criterion.AndOr); andOr != CriteriaAndOrAnd && andOr != CriteriaAndOrOr && !(i == 0 && andOr == "") {
+			errs = append(errs, fmt.Sprintf("criterion %d (%s) has invalid and_or value %q", i, criterion.Name, criterion.AndOr))
+		}
+		if criterion.Priority != i {
+			errs = append(errs, fmt.Sprintf("criterion %d (%s) has priority %d, expected %d", i, criterion.Name, criterion.Priority, i))
+		}
+
+		if criterion.OpeningParen {
+			if open {
+				errs = append(errs, fmt.Sprintf("criterion %d (%s) opens a parenthesis inside an open parenthesis", i, criterion.Name))
+			}
+			open = true
+		}
+		if criterion.ClosingParen {
+			if !open {
+				errs = append(errs, fmt.Sprintf("criterion %d (%s) closes a parenthesis that was never opened", i, criterion.Name))
+			}
+			open = false
+		}
+	}
+
+	if open {
+		errs = append(errs, "criteria have an unclosed parenthesis")
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid criteria: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
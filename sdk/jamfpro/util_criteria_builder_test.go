@@ -0,0 +1,177 @@
+package jamfpro
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateCriteria(t *testing.T) {
+	criterion := func(priority int, name, andOr, searchType string) SharedSubsetCriteria {
+		return SharedSubsetCriteria{Name: name, Priority: priority, AndOr: andOr, SearchType: searchType, Value: "value"}
+	}
+	paren := func(c SharedSubsetCriteria, opening, closing bool) SharedSubsetCriteria {
+		c.OpeningParen, c.ClosingParen = opening, closing
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		criteria []SharedSubsetCriteria
+		wantErrs []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "valid criteria",
+			criteria: []SharedSubsetCriteria{
+				criterion(0, "Operating System Version", "and", SearchTypeGreaterThanOrEqual),
+				paren(criterion(1, "Model", "and", SearchTypeLike), true, false),
+				paren(criterion(2, "Model", "or", SearchTypeLike), false, true),
+			},
+		},
+		{
+			name: "case insensitive values and empty first and_or",
+			criteria: []SharedSubsetCriteria{
+				criterion(0, "Computer Group", "", "Member Of"),
+				criterion(1, "Model", "OR", "LIKE"),
+			},
+		},
+		{
+			name:     "missing name",
+			criteria: []SharedSubsetCriteria{criterion(0, "", "and", SearchTypeIs)},
+			wantErrs: []string{"criterion 0 has no name"},
+		},
+		{
+			name:     "unsupported search type",
+			criteria: []SharedSubsetCriteria{criterion(0, "Model", "and", "equals")},
+			wantErrs: []string{`unsupported search type "equals"`},
+		},
+		{
+			name: "invalid and_or",
+			criteria: []SharedSubsetCriteria{
+				criterion(0, "Model", "and", SearchTypeIs),
+				criterion(1, "Serial Number", "", SearchTypeIs),
+				criterion(2, "Building", "xor", SearchTypeIs),
+			},
+			wantErrs: []string{`criterion 1 (Serial Number) has invalid and_or value ""`, `criterion 2 (Building) has invalid and_or value "xor"`},
+		},
+		{
+			name: "out of order priorities",
+			criteria: []SharedSubsetCriteria{
+				criterion(0, "Model", "and", SearchTypeIs),
+				criterion(2, "Building", "and", SearchTypeIs),
+			},
+			wantErrs: []string{"has priority 2, expected 1"},
+		},
+		{
+			name: "nested parentheses",
+			criteria: []SharedSubsetCriteria{
+				paren(criterion(0, "Model", "and", SearchTypeIs), true, false),
+				paren(criterion(1, "Building", "and", SearchTypeIs), true, false),
+				paren(criterion(2, "Department", "and", SearchTypeIs), false, true),
+			},
+			wantErrs: []string{"opens a parenthesis inside an open parenthesis"},
+		},
+		{
+			name: "closing parenthesis never opened",
+			criteria: []SharedSubsetCriteria{
+				paren(criterion(0, "Model", "and", SearchTypeIs), false, true),
+			},
+			wantErrs: []string{"closes a parenthesis that was never opened"},
+		},
+		{
+			name: "unclosed parenthesis",
+			criteria: []SharedSubsetCriteria{
+				paren(criterion(0, "Model", "and", SearchTypeIs), true, false),
+				criterion(1, "Building", "and", SearchTypeIs),
+			},
+			wantErrs: []string{"unclosed parenthesis"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCriteria(tt.criteria)
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCriteriaBuilder(t *testing.T) {
+	tests := []struct {
+		name      string
+		builder   *CriteriaBuilder
+		want      []SharedSubsetCriteria
+		wantError string
+	}{
+		{
+			name:    "priorities and joins",
+			builder: Where("Operating System Version").GreaterThanOrEqual("14.0").Or("Computer Group").MemberOf("All Managed Clients"),
+			want: []SharedSubsetCriteria{
+				{Name: "Operating System Version", Priority: 0, AndOr: "and", SearchType: SearchTypeGreaterThanOrEqual, Value: "14.0"},
+				{Name: "Computer Group", Priority: 1, AndOr: "or", SearchType: SearchTypeMemberOf, Value: "All Managed Clients"},
+			},
+		},
+		{
+			name:    "group",
+			builder: Where("Building").Is("HQ").AndGroup(Where("Model").Like("MacBook").Or("Model").Like("iMac")),
+			want: []SharedSubsetCriteria{
+				{Name: "Building", Priority: 0, AndOr: "and", SearchType: SearchTypeIs, Value: "HQ"},
+				{Name: "Model", Priority: 1, AndOr: "and", SearchType: SearchTypeLike, Value: "MacBook", OpeningParen: true},
+				{Name: "Model", Priority: 2, AndOr: "or", SearchType: SearchTypeLike, Value: "iMac", ClosingParen: true},
+			},
+		},
+		{
+			name:      "single criterion group",
+			builder:   Where("Building").Is("HQ").OrGroup(Where("Model").Like("MacBook")),
+			wantError: "does not need parentheses",
+		},
+		{
+			name:      "nested group",
+			builder:   Where("Building").Is("HQ").AndGroup(Where("Model").Is("a").AndGroup(Where("Model").Is("b").Or("Model").Is("c"))),
+			wantError: "cannot be nested directly inside another group",
+		},
+		{
+			name:      "unsupported search type",
+			builder:   Where("Model").SearchType("equals", "MacBook"),
+			wantError: `unsupported search type "equals"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria, err := tt.builder.BuildCriteria()
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("expected an error containing %q, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(criteria) != len(tt.want) {
+				t.Fatalf("got %d criteria, want %d", len(criteria), len(tt.want))
+			}
+			for i := range criteria {
+				if criteria[i] != tt.want[i] {
+					t.Errorf("criterion %d = %+v, want %+v", i, criteria[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
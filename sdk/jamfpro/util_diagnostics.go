@@ -0,0 +1,302 @@
+// util_diagnostics.go
+// Tenant health and diagnostics report. Diagnose gathers the Jamf Pro version, startup status, health check,
+// JCDS properties, certificate authority expiry, SMTP and LDAP configuration hints and API privilege coverage
+// into a single report that can be written as JSON for monitoring or as text for people.
+// Each section is gathered independently so that a missing privilege or failing endpoint is reported as
+// a finding rather than aborting the whole report.
+
+package jamfpro
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client/response"
+)
+
+// Diagnostic check statuses, ordered from best to worst.
+const (
+	DiagnosticStatusOK      = "ok"
+	DiagnosticStatusWarning = "warning"
+	DiagnosticStatusError   = "error"
+	DiagnosticStatusDenied  = "denied"
+)
+
+// certificateAuthorityExpiryWarning is how far ahead of expiry the certificate authority check warns.
+const certificateAuthorityExpiryWarning = 90 * 24 * time.Hour
+
+var diagnosticStatusSeverity = map[string]int{
+	DiagnosticStatusOK:      0,
+	DiagnosticStatusWarning: 1,
+	DiagnosticStatusDenied:  2,
+	DiagnosticStatusError:   3,
+}
+
+// DiagnosticsReport is the result of Diagnose.
+type DiagnosticsReport struct {
+	GeneratedAt          time.Time                        `json:"generatedAt"`
+	Status               string                           `json:"status"`
+	Version              string                           `json:"version,omitempty"`
+	StartupStatus        *ResponseStartupStatus           `json:"startupStatus,omitempty"`
+	HealthCheck          *ResponseHealthCheck             `json:"healthCheck,omitempty"`
+	Information          *ResponseJamfProInformation      `json:"information,omitempty"`
+	JCDS                 *JCDS2Properties                 `json:"jcds,omitempty"`
+	CertificateAuthority *DiagnosticsCertificateAuthority `json:"certificateAuthority,omitempty"`
+	SMTP                 *ResourceSMTPServer              `json:"smtp,omitempty"`
+	LDAPServers          []LDAPServerSubsetConnection     `json:"ldapServers,omitempty"`
+	PrivilegeCoverage    DiagnosticsPrivilegeCoverage     `json:"privilegeCoverage"`
+	Checks               []DiagnosticCheck                `json:"checks"`
+}
+
+// DiagnosticsCertificateAuthority summarises the active Jamf Pro certificate authority.
+type DiagnosticsCertificateAuthority struct {
+	Subject       string    `json:"subject"`
+	NotAfter      time.Time `json:"notAfter"`
+	DaysRemaining int       `json:"daysRemaining"`
+}
+
+// DiagnosticsPrivilegeCoverage lists which report sections the client could and could not read.
+type DiagnosticsPrivilegeCoverage struct {
+	Accessible []string `json:"accessible"`
+	Denied     []string `json:"denied"`
+}
+
+// DiagnosticCheck is a single finding in the report.
+type DiagnosticCheck struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// Diagnose gathers a tenant health report. The returned error is only set when Jamf Pro could not be reached
+// at all; individual failures are recorded as checks on the report.
+func (c *Client) Diagnose() (*DiagnosticsReport, error) {
+	report := &DiagnosticsReport{GeneratedAt: time.Now().UTC()}
+
+	version, err := c.GetJamfProVersion()
+	if report.record("version", err) && version.Version != nil {
+		report.Version = *version.Version
+		report.addCheck("version", DiagnosticStatusOK, "Jamf Pro "+report.Version)
+	}
+
+	startup, err := c.GetStartupStatus()
+	if report.record("startup status", err) {
+		report.StartupStatus = startup
+		switch {
+		case startup.Error != "":
+			report.addCheck("startup status", DiagnosticStatusError, fmt.Sprintf("%s (%s)", startup.Error, startup.ErrorCode))
+		case startup.Warning != "":
+			report.addCheck("startup status", DiagnosticStatusWarning, fmt.Sprintf("%s (%s)", startup.Warning, startup.WarningCode))
+		case startup.Percentage < 100:
+			report.addCheck("startup status", DiagnosticStatusWarning, fmt.Sprintf("starting up: %s %d%%", startup.Step, startup.Percentage))
+		default:
+			report.addCheck("startup status", DiagnosticStatusOK, startup.Step)
+		}
+	}
+
+	health, err := c.GetHealthCheck()
+	if report.record("health check", err) {
+		report.HealthCheck = health
+		if health.Healthy {
+			report.addCheck("health check", DiagnosticStatusOK, "healthy")
+		} else {
+			report.addCheck("health check", DiagnosticStatusError, fmt.Sprintf("unhealthy, status code %d", health.StatusCode))
+		}
+	}
+
+	information, err := c.GetJamfProInformation()
+	if report.record("information", err) {
+		report.Information = information
+	}
+
+	jcds, err := c.GetJCDS2Properties()
+	if report.record("jcds", err) {
+		report.JCDS = jcds
+		if jcds.JCDS2Enabled {
+			report.addCheck("jcds", DiagnosticStatusOK, fmt.Sprintf("JCDS 2 enabled, max chunk size %d", jcds.MaxChunkSize))
+		} else {
+			report.addCheck("jcds", DiagnosticStatusWarning, "JCDS 2 is not enabled")
+		}
+	}
+
+	certificateAuthority, err := c.GetActiveCertificateAuthority()
+	if report.record("certificate authority", err) {
+		report.diagnoseCertificateAuthority(certificateAuthority)
+	}
+
+	smtp, err := c.GetSMTPServerInformation()
+	if report.record("smtp", err) {
+		report.SMTP = smtp
+		report.diagnoseSMTP(smtp)
+	}
+
+	ldapServers, err := c.GetLDAPServers()
+	if report.record("ldap", err) {
+		for _, server := range ldapServers.LDAPServers {
+			detail, err := c.GetLDAPServerByID(server.ID)
+			if err != nil {
+				report.addCheck("ldap "+server.Name, DiagnosticStatusError, err.Error())
+				continue
+			}
+			detail.Connection.Account.Password = ""
+			report.LDAPServers = append(report.LDAPServers, detail.Connection)
+			report.diagnoseLDAP(detail.Connection)
+		}
+	}
+
+	report.Status = DiagnosticStatusOK
+	for _, check := range report.Checks {
+		if diagnosticStatusSeverity[check.Status] > diagnosticStatusSeverity[report.Status] {
+			report.Status = check.Status
+		}
+	}
+
+	if report.Version == "" && report.HealthCheck == nil && len(report.PrivilegeCoverage.Accessible) == 0 {
+		return report, fmt.Errorf("failed to reach jamf pro, every diagnostic check failed")
+	}
+
+	return report, nil
+}
+
+// record tracks privilege coverage for a section and reports whether its data can be used.
+func (r *DiagnosticsReport) record(section string, err error) bool {
+	if err == nil {
+		r.PrivilegeCoverage.Accessible = append(r.PrivilegeCoverage.Accessible, section)
+		return true
+	}
+	if isAccessDeniedError(err) {
+		r.PrivilegeCoverage.Denied = append(r.PrivilegeCoverage.Denied, section)
+		message := "the api client lacks the privilege to read " + section
+		var missing *MissingPrivilegeError
+		if errors.As(EnrichAccessDeniedError(err), &missing) {
+			message += ", missing privilege: " + strings.Join(missing.Privileges, ", ")
+		}
+		r.addCheck(section, DiagnosticStatusDenied, message)
+		return false
+	}
+	r.addCheck(section, DiagnosticStatusError, err.Error())
+	return false
+}
+
+func (r *DiagnosticsReport) addCheck(name, status, message string) {
+	r.Checks = append(r.Checks, DiagnosticCheck{Name: name, Status: status, Message: message})
+}
+
+// diagnoseCertificateAuthority records the expiry of the active certificate authority. Jamf Pro reports
+// the validity dates in milliseconds since the epoch.
+func (r *DiagnosticsReport) diagnoseCertificateAuthority(ca *ResponseActiveCertificateAuthority) {
+	notAfter := time.UnixMilli(ca.NotAfter).UTC()
+	remaining := time.Until(notAfter)
+	r.CertificateAuthority = &DiagnosticsCertificateAuthority{
+		Subject:       ca.SubjectX500Principal,
+		NotAfter:      notAfter,
+		DaysRemaining: int(remaining.Hours() / 24),
+	}
+
+	message := fmt.Sprintf("%s expires %s (%d days)", ca.SubjectX500Principal, notAfter.Format("2006-01-02"), r.CertificateAuthority.DaysRemaining)
+	switch {
+	case remaining <= 0:
+		r.addCheck("certificate authority", DiagnosticStatusError, message)
+	case remaining <= certificateAuthorityExpiryWarning:
+		r.addCheck("certificate authority", DiagnosticStatusWarning, message)
+	default:
+		r.addCheck("certificate authority", DiagnosticStatusOK, message)
+	}
+}
+
+// diagnoseSMTP adds configuration hints for common SMTP misconfigurations.
+func (r *DiagnosticsReport) diagnoseSMTP(smtp *ResourceSMTPServer) {
+	var hints []string
+	switch {
+	case !smtp.Enabled:
+		r.addCheck("smtp", DiagnosticStatusWarning, "SMTP is disabled, Jamf Pro cannot send email notifications")
+		return
+	case smtp.Server == "":
+		hints = append(hints, "no server configured")
+	}
+	if smtp.Port == 25 && (smtp.EncryptionType == "" || strings.EqualFold(smtp.EncryptionType, "NONE")) {
+		hints = append(hints, "port 25 without encryption is often blocked by cloud providers, use 587 with TLS")
+	}
+	if smtp.RequiresAuthentication && smtp.Username == "" {
+		hints = append(hints, "authentication is required but no username is set")
+	}
+	if smtp.SenderEmailAddress == "" {
+		hints = append(hints, "no sender email address set")
+	}
+
+	if len(hints) > 0 {
+		r.addCheck("smtp", DiagnosticStatusWarning, strings.Join(hints, "; "))
+		return
+	}
+	r.addCheck("smtp", DiagnosticStatusOK, fmt.Sprintf("%s:%d", smtp.Server, smtp.Port))
+}
+
+// diagnoseLDAP adds configuration hints for an LDAP server connection.
+func (r *DiagnosticsReport) diagnoseLDAP(connection LDAPServerSubsetConnection) {
+	name := "ldap " + connection.Name
+	var hints []string
+	if connection.Hostname == "" {
+		hints = append(hints, "no hostname configured")
+	}
+	if !connection.UseSSL {
+		hints = append(hints, "connection does not use SSL")
+	}
+	if connection.Port == 389 && connection.UseSSL {
+		hints = append(hints, "SSL enabled on port 389, LDAPS usually listens on 636")
+	}
+	if connection.AuthenticationType != "none" && connection.Account.DistinguishedUsername == "" {
+		hints = append(hints, "no service account configured")
+	}
+
+	if len(hints) > 0 {
+		r.addCheck(name, DiagnosticStatusWarning, strings.Join(hints, "; "))
+		return
+	}
+	r.addCheck(name, DiagnosticStatusOK, fmt.Sprintf("%s:%d", connection.Hostname, connection.Port))
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *DiagnosticsReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "    ")
+	if err := encoder.Encode(r); err != nil {
+		return fmt.Errorf(errMsgFailedJsonMarshal, "diagnostics report", err)
+	}
+	return nil
+}
+
+// WriteText writes a human readable summary of the report with one line per check.
+func (r *DiagnosticsReport) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Jamf Pro diagnostics (%s)\n", r.GeneratedAt.Format(time.RFC3339))
+	fmt.Fprintf(tw, "Overall status:\t%s\n", strings.ToUpper(r.Status))
+	if r.Version != "" {
+		fmt.Fprintf(tw, "Version:\t%s\n", r.Version)
+	}
+	fmt.Fprintln(tw)
+	for _, check := range r.Checks {
+		fmt.Fprintf(tw, "[%s]\t%s\t%s\n", strings.ToUpper(check.Status), check.Name, check.Message)
+	}
+	if len(r.PrivilegeCoverage.Denied) > 0 {
+		fmt.Fprintf(tw, "\nNot readable with the current api privileges: %s\n", strings.Join(r.PrivilegeCoverage.Denied, ", "))
+	}
+	return tw.Flush()
+}
+
+// String returns the human readable summary of the report.
+func (r *DiagnosticsReport) String() string {
+	var b strings.Builder
+	_ = r.WriteText(&b)
+	return b.String()
+}
+
+// isAccessDeniedError reports whether an error returned by an SDK method is a 401 or 403 response.
+func isAccessDeniedError(err error) bool {
+	var apiErr *response.APIError
+	return errors.As(err, &apiErr) && (apiErr.StatusCode == 401 || apiErr.StatusCode == 403)
+}
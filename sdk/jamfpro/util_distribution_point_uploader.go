@@ -0,0 +1,384 @@
+// util_distribution_point_uploader.go
+// Package uploads to every distribution point a tenant uses. A DistributionPointUploader copies a package to
+// one distribution point; implementations exist for Jamf Cloud Distribution Service 2.0, for file share
+// distribution points whose SMB or AFP share is mounted locally, and for S3 buckets such as an Amazon S3
+// cloud distribution point. GetDistributionPointUploaders picks the implementation for each configured
+// distribution point, and reports the ones that cannot be uploaded to with the reason. Whether the cloud
+// distribution point accepts direct uploads is decided by Jamf Pro's upload capability.
+
+package jamfpro
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/helpers"
+)
+
+// Distribution point types reported in DistributionPointTarget.Type.
+const (
+	DistributionPointTypeJCDS2     = "jcds2"
+	DistributionPointTypeFileShare = "file_share"
+	DistributionPointTypeCloud     = "cloud"
+)
+
+// fileSharePackagesDirectory is the folder of a file share distribution point that holds packages.
+const fileSharePackagesDirectory = "Packages"
+
+// DistributionPointUploader copies packages to a distribution point.
+type DistributionPointUploader interface {
+	// Name identifies the distribution point in results.
+	Name() string
+	// Upload stores size bytes from reader as fileName on the distribution point. Cancelling ctx stops the
+	// upload.
+	Upload(ctx context.Context, fileName string, reader io.ReaderAt, size int64) error
+}
+
+// JCDS2DistributionPointUploader uploads packages to Jamf Cloud Distribution Service 2.0.
+type JCDS2DistributionPointUploader struct {
+	Client  *Client
+	Options *JCDS2UploadOptions
+}
+
+// Name returns the name of the distribution point.
+func (u *JCDS2DistributionPointUploader) Name() string {
+	return "Jamf Cloud Distribution Service"
+}
+
+// Upload streams the package to JCDS 2.0 with a resumable multipart upload.
+func (u *JCDS2DistributionPointUploader) Upload(ctx context.Context, fileName string, reader io.ReaderAt, size int64) error {
+	_, err := u.Client.uploadJCDS2Package(ctx, fileName, reader, size, u.Options)
+	return err
+}
+
+// LocalDistributionPointUploader copies packages into a local directory, typically the Packages folder of a
+// mounted file share distribution point.
+type LocalDistributionPointUploader struct {
+	DistributionPointName string
+	Directory             string
+	Progress              ProgressListener
+}
+
+// Name returns the name of the distribution point.
+func (u *LocalDistributionPointUploader) Name() string {
+	return u.DistributionPointName
+}
+
+// Upload copies the package into the directory. The copy is written under a temporary name and renamed when
+// complete, so clients never download a partial package.
+func (u *LocalDistributionPointUploader) Upload(ctx context.Context, fileName string, reader io.ReaderAt, size int64) error {
+	destination := filepath.Join(u.Directory, filepath.Base(fileName))
+
+	tmp, err := os.CreateTemp(u.Directory, "."+filepath.Base(fileName)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create file on distribution point %s: %v", u.DistributionPointName, err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	progress := NewProgressWriter(tmp, fileName, size, u.Progress)
+	if _, err := io.Copy(progress, &contextReader{ctx: ctx, reader: io.NewSectionReader(reader, 0, size)}); err != nil {
+		return fmt.Errorf("failed to copy %s to distribution point %s: %v", fileName, u.DistributionPointName, err)
+	}
+	progress.Finish()
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write %s to distribution point %s: %v", fileName, u.DistributionPointName, err)
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return fmt.Errorf("failed to set permissions of %s on distribution point %s: %v", fileName, u.DistributionPointName, err)
+	}
+	if err := os.Rename(tmp.Name(), destination); err != nil {
+		return fmt.Errorf("failed to move %s into place on distribution point %s: %v", fileName, u.DistributionPointName, err)
+	}
+
+	return nil
+}
+
+// S3DistributionPointUploader uploads packages to an S3 bucket, such as an Amazon S3 cloud distribution point
+// or an S3 compatible store.
+type S3DistributionPointUploader struct {
+	DistributionPointName string
+	Bucket                string
+	// Prefix is prepended to the file name to form the object key.
+	Prefix          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// Endpoint is the URL of an S3 compatible store. Path style addressing is used when it is set.
+	Endpoint string
+	// PartSize and Concurrency tune the multipart upload. Zero uses the AWS SDK defaults.
+	PartSize    int64
+	Concurrency int
+	Progress    ProgressListener
+}
+
+// Name returns the name of the distribution point.
+func (u *S3DistributionPointUploader) Name() string {
+	return u.DistributionPointName
+}
+
+// Upload streams the package to the bucket with a multipart upload.
+func (u *S3DistributionPointUploader) Upload(ctx context.Context, fileName string, reader io.ReaderAt, size int64) error {
+	region := u.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	s3Client := s3.New(s3.Options{
+		Region:      region,
+		Credentials: credentials.NewStaticCredentialsProvider(u.AccessKeyID, u.SecretAccessKey, u.SessionToken),
+	}, func(o *s3.Options) {
+		if u.Endpoint != "" {
+			o.BaseEndpoint = aws.String(u.Endpoint)
+			o.UsePathStyle = true
+		}
+	})
+
+	uploader := manager.NewUploader(s3Client, func(m *manager.Uploader) {
+		if u.PartSize > 0 {
+			m.PartSize = u.PartSize
+		}
+		if u.Concurrency > 0 {
+			m.Concurrency = u.Concurrency
+		}
+	})
+
+	body := NewProgressReader(io.NewSectionReader(reader, 0, size), fileName, size, u.Progress)
+	_, err := uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(u.Bucket),
+		Key:    aws.String(u.Prefix + filepath.Base(fileName)),
+		Body:   body,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload %s to distribution point %s: %v", fileName, u.DistributionPointName, err)
+	}
+	body.Finish()
+
+	return nil
+}
+
+// contextReader stops reading once its context is done.
+type contextReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+// Read implements the io.Reader interface.
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.reader.Read(p)
+}
+
+// DistributionPointUploaderOptions supplies what Jamf Pro does not report about distribution points.
+type DistributionPointUploaderOptions struct {
+	// MountPoints maps file share distribution point names to the local directory their share is mounted at.
+	// Shares without an entry are looked for at /Volumes/<share name>.
+	MountPoints map[string]string
+	// S3 uploads to an Amazon S3 cloud distribution point. Jamf Pro does not return the secret access key or
+	// bucket, so they must be set; an empty AccessKeyID is taken from the distribution point.
+	S3 *S3DistributionPointUploader
+	// JCDS2 tunes uploads to Jamf Cloud Distribution Service 2.0.
+	JCDS2 *JCDS2UploadOptions
+	// Progress receives the progress of uploads to local and S3 distribution points. When nil, Client.Progress
+	// is used.
+	Progress ProgressListener
+}
+
+// DistributionPointTarget is a distribution point configured in Jamf Pro with the uploader selected for it.
+type DistributionPointTarget struct {
+	Name     string                    `json:"name"`
+	Type     string                    `json:"type"`
+	Uploader DistributionPointUploader `json:"-"`
+	// Unavailable explains why the distribution point has no uploader.
+	Unavailable string `json:"unavailable,omitempty"`
+}
+
+// DistributionPointUploadResult is the outcome of uploading a package to one distribution point.
+type DistributionPointUploadResult struct {
+	DistributionPoint string `json:"distributionPoint"`
+	Type              string `json:"type"`
+	Uploaded          bool   `json:"uploaded"`
+	Error             string `json:"error,omitempty"`
+}
+
+// GetDistributionPointUploaders returns the cloud and file share distribution points of the tenant, each with
+// the uploader that can reach it. Distribution points that cannot be uploaded to are returned with the reason
+// in Unavailable.
+func (c *Client) GetDistributionPointUploaders(options *DistributionPointUploaderOptions) ([]DistributionPointTarget, error) {
+	if options == nil {
+		options = &DistributionPointUploaderOptions{}
+	}
+	listener := c.progressListener(options.Progress)
+
+	var targets []DistributionPointTarget
+
+	cloud, err := c.GetCloudDistributionPoint()
+	if err != nil {
+		return nil, err
+	}
+	if cloud.CdnType != "" && cloud.CdnType != "NONE" {
+		target, err := c.cloudDistributionPointTarget(cloud, options, listener)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, *target)
+	}
+
+	fileShares, err := c.GetDistributionPoints()
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range fileShares.DistributionPoint {
+		distributionPoint, err := c.GetDistributionPointByID(item.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		target := DistributionPointTarget{Name: distributionPoint.Name, Type: DistributionPointTypeFileShare}
+		mountPoint, ok := options.MountPoints[distributionPoint.Name]
+		if !ok {
+			mountPoint = filepath.Join("/Volumes", distributionPoint.ShareName)
+		}
+		packagesDirectory := filepath.Join(mountPoint, fileSharePackagesDirectory)
+		if info, err := os.Stat(packagesDirectory); err != nil || !info.IsDir() {
+			target.Unavailable = fmt.Sprintf("share %s is not mounted at %s, set DistributionPointUploaderOptions.MountPoints", distributionPoint.ShareName, mountPoint)
+		} else {
+			target.Uploader = &LocalDistributionPointUploader{
+				DistributionPointName: distributionPoint.Name,
+				Directory:             packagesDirectory,
+				Progress:              listener,
+			}
+		}
+		targets = append(targets, target)
+	}
+
+	return targets, nil
+}
+
+// cloudDistributionPointTarget selects the uploader for the cloud distribution point. Jamf Pro's upload
+// capability decides whether packages can be uploaded to it directly; of those, JCDS 2.0 and Amazon S3 have an
+// uploader.
+func (c *Client) cloudDistributionPointTarget(cloud *ResourceCloudDistributionPoint, options *DistributionPointUploaderOptions, listener ProgressListener) (*DistributionPointTarget, error) {
+	target := &DistributionPointTarget{Name: cloudDistributionPointName(cloud.CdnType), Type: DistributionPointTypeCloud}
+	if cloud.CdnType == "JAMF_CLOUD" {
+		target.Type = DistributionPointTypeJCDS2
+	}
+
+	capability, err := c.GetCloudDistributionPointUploadCapability()
+	if err != nil {
+		return nil, err
+	}
+	if !capability.DirectUploadCapable {
+		target.Unavailable = fmt.Sprintf("Jamf Pro reports that packages cannot be uploaded directly to the %s distribution point", target.Name)
+		return target, nil
+	}
+
+	switch cloud.CdnType {
+	case "JAMF_CLOUD":
+		properties, err := c.GetJCDS2Properties()
+		if err != nil {
+			return nil, err
+		}
+		if properties.JCDS2Enabled {
+			target.Uploader = &JCDS2DistributionPointUploader{Client: c, Options: options.JCDS2}
+		} else {
+			target.Unavailable = "JCDS 2.0 is not enabled on this tenant"
+		}
+	case "AMAZON_S3":
+		if options.S3 == nil || options.S3.Bucket == "" || options.S3.SecretAccessKey == "" {
+			target.Unavailable = "the bucket and secret access key of the Amazon S3 distribution point are not set in DistributionPointUploaderOptions.S3"
+			break
+		}
+		uploader := *options.S3
+		if uploader.DistributionPointName == "" {
+			uploader.DistributionPointName = target.Name
+		}
+		if uploader.AccessKeyID == "" {
+			uploader.AccessKeyID = cloud.Username
+		}
+		if uploader.Progress == nil {
+			uploader.Progress = listener
+		}
+		target.Uploader = &uploader
+	default:
+		target.Unavailable = fmt.Sprintf("the SDK has no uploader for %s distribution points, upload through the Jamf Pro web interface", target.Name)
+	}
+
+	return target, nil
+}
+
+// cloudDistributionPointName returns the display name of a cloud distribution point type.
+func cloudDistributionPointName(cdnType string) string {
+	switch cdnType {
+	case "JAMF_CLOUD":
+		return "Jamf Cloud Distribution Service"
+	case "AMAZON_S3":
+		return "Amazon S3"
+	case "AKAMAI":
+		return "Akamai"
+	case "RACKSPACE":
+		return "Rackspace Cloud Files"
+	}
+	return cdnType
+}
+
+// UploadPackageToDistributionPoints uploads a package file to every distribution point of the tenant that an
+// uploader is available for, in parallel. Package metadata is not created in Jamf Pro.
+func (c *Client) UploadPackageToDistributionPoints(filePath string, options *DistributionPointUploaderOptions) ([]DistributionPointUploadResult, error) {
+	return c.UploadPackageToDistributionPointsWithContext(context.Background(), filePath, options)
+}
+
+// UploadPackageToDistributionPointsWithContext is UploadPackageToDistributionPoints with a context that stops
+// the uploads when it is cancelled.
+func (c *Client) UploadPackageToDistributionPointsWithContext(ctx context.Context, filePath string, options *DistributionPointUploaderOptions) ([]DistributionPointUploadResult, error) {
+	file, size, err := helpers.OpenJCDSPackageTypes(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open package file securely: %v", err)
+	}
+	defer file.Close()
+
+	targets, err := c.GetDistributionPointUploaders(options)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := filepath.Base(filePath)
+	results := make([]DistributionPointUploadResult, len(targets))
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		results[i] = DistributionPointUploadResult{DistributionPoint: target.Name, Type: target.Type}
+		if target.Uploader == nil {
+			results[i].Error = target.Unavailable
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *DistributionPointUploadResult, uploader DistributionPointUploader) {
+			defer wg.Done()
+			if err := uploader.Upload(ctx, fileName, file, size); err != nil {
+				result.Error = err.Error()
+				return
+			}
+			result.Uploaded = true
+		}(&results[i], target.Uploader)
+	}
+	wg.Wait()
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].DistributionPoint < results[j].DistributionPoint
+	})
+	return results, nil
+}
@@ -0,0 +1,411 @@
+// util_extension_attribute_values.go
+// Helpers for reading and writing extension attribute values on computers, mobile devices and users.
+// Values are written with a minimal Classic API XML payload containing only the extension attributes
+// being changed, so the rest of the inventory record is left untouched.
+
+package jamfpro
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Extension attribute input and data types that affect whether and how a value can be written.
+const (
+	extensionAttributeInputTypeTextField = "text field"
+	extensionAttributeInputTypePopupMenu = "pop-up menu"
+
+	extensionAttributeDataTypeString  = "string"
+	extensionAttributeDataTypeInteger = "integer"
+	extensionAttributeDataTypeDate    = "date"
+)
+
+// extensionAttributeDateLayouts lists the date formats accepted by Jamf Pro for date extension attributes.
+var extensionAttributeDateLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// Request payloads
+
+// SharedSubsetExtensionAttributeValue is the minimal representation of an extension attribute value
+// sent when updating a computer, mobile device or user through the Classic API.
+type SharedSubsetExtensionAttributeValue struct {
+	ID    int    `xml:"id"`
+	Value string `xml:"value"`
+}
+
+type requestComputerExtensionAttributeValues struct {
+	XMLName             xml.Name                              `xml:"computer"`
+	ExtensionAttributes []SharedSubsetExtensionAttributeValue `xml:"extension_attributes>extension_attribute"`
+}
+
+type requestMobileDeviceExtensionAttributeValues struct {
+	XMLName             xml.Name                              `xml:"mobile_device"`
+	ExtensionAttributes []SharedSubsetExtensionAttributeValue `xml:"extension_attributes>extension_attribute"`
+}
+
+type requestUserExtensionAttributeValues struct {
+	XMLName             xml.Name                              `xml:"user"`
+	ExtensionAttributes []SharedSubsetExtensionAttributeValue `xml:"extension_attributes>extension_attribute"`
+}
+
+// Computers
+
+// GetComputerExtensionAttributeValue retrieves the current value of the named extension attribute for a computer.
+func (c *Client) GetComputerExtensionAttributeValue(computerID int, eaName string) (string, error) {
+	endpoint := fmt.Sprintf("%s/id/%d/subset/ExtensionAttributes", uriComputers, computerID)
+
+	var computer ResponseComputer
+	resp, err := c.HTTP.DoRequest("GET", endpoint, nil, &computer)
+	if err != nil {
+		return "", apiErrorf(errMsgFailedGetByID, "computer extension attributes", computerID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	for _, attribute := range computer.ExtensionAttributes {
+		if attribute.Name == eaName {
+			return attribute.Value, nil
+		}
+	}
+
+	return "", fmt.Errorf(errMsgFailedGetByName, "computer extension attribute", eaName, errMsgNoName)
+}
+
+// SetComputerExtensionAttributeValue sets the value of a single extension attribute on a computer.
+// The extension attribute is resolved by name and the value is validated against its input type,
+// data type and pop-up menu choices before any update is sent.
+func (c *Client) SetComputerExtensionAttributeValue(computerID int, eaName, value string) error {
+	return c.SetComputerExtensionAttributeValues(computerID, map[string]string{eaName: value})
+}
+
+// SetComputerExtensionAttributeValues sets several extension attribute values on a computer in a single request.
+// The map is keyed by extension attribute name.
+func (c *Client) SetComputerExtensionAttributeValues(computerID int, values map[string]string) error {
+	payload, err := c.buildComputerExtensionAttributeValues(values)
+	if err != nil {
+		return apiErrorf(errMsgFailedUpdateByID, "computer extension attribute values", computerID, err)
+	}
+
+	endpoint := fmt.Sprintf("%s/id/%d", uriComputers, computerID)
+
+	requestBody := requestComputerExtensionAttributeValues{ExtensionAttributes: payload}
+
+	var response ResponseComputer
+	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	if err != nil {
+		return apiErrorf(errMsgFailedUpdateByID, "computer extension attribute values", computerID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// SetComputerExtensionAttributeValueForComputers sets the same extension attribute value on multiple computers.
+// The extension attribute is resolved and validated once. Every computer is attempted and the IDs of any
+// computers that could not be updated are returned in the error.
+func (c *Client) SetComputerExtensionAttributeValueForComputers(computerIDs []int, eaName, value string) error {
+	payload, err := c.buildComputerExtensionAttributeValues(map[string]string{eaName: value})
+	if err != nil {
+		return apiErrorf(errMsgFailedUpdateByName, "computer extension attribute value", eaName, err)
+	}
+
+	var failed []string
+	for _, id := range computerIDs {
+		endpoint := fmt.Sprintf("%s/id/%d", uriComputers, id)
+		requestBody := requestComputerExtensionAttributeValues{ExtensionAttributes: payload}
+
+		var response ResponseComputer
+		resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%d (%v)", id, err))
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf(errMsgFailedUpdateByName, "computer extension attribute value", eaName, "computers: "+strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+// buildComputerExtensionAttributeValues resolves computer extension attribute names to IDs and validates each value.
+func (c *Client) buildComputerExtensionAttributeValues(values map[string]string) ([]SharedSubsetExtensionAttributeValue, error) {
+	var payload []SharedSubsetExtensionAttributeValue
+	for _, name := range sortedExtensionAttributeNames(values) {
+		attribute, err := c.GetComputerExtensionAttributeByName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validateExtensionAttributeValue(name, attribute.InputType.Type, attribute.DataType, attribute.InputType.Choices, values[name]); err != nil {
+			return nil, err
+		}
+
+		payload = append(payload, SharedSubsetExtensionAttributeValue{ID: attribute.ID, Value: values[name]})
+	}
+
+	return payload, nil
+}
+
+// Mobile Devices
+
+// GetMobileDeviceExtensionAttributeValue retrieves the current value of the named extension attribute for a mobile device.
+func (c *Client) GetMobileDeviceExtensionAttributeValue(mobileDeviceID int, eaName string) (string, error) {
+	device, err := c.GetMobileDeviceByIDAndDataSubset(mobileDeviceID, "ExtensionAttributes")
+	if err != nil {
+		return "", err
+	}
+
+	for _, attribute := range device.ExtensionAttributes {
+		if attribute.Name == eaName {
+			return attribute.Value, nil
+		}
+	}
+
+	return "", fmt.Errorf(errMsgFailedGetByName, "mobile device extension attribute", eaName, errMsgNoName)
+}
+
+// SetMobileDeviceExtensionAttributeValue sets the value of a single extension attribute on a mobile device.
+func (c *Client) SetMobileDeviceExtensionAttributeValue(mobileDeviceID int, eaName, value string) error {
+	return c.SetMobileDeviceExtensionAttributeValues(mobileDeviceID, map[string]string{eaName: value})
+}
+
+// SetMobileDeviceExtensionAttributeValues sets several extension attribute values on a mobile device in a single request.
+// The map is keyed by extension attribute name.
+func (c *Client) SetMobileDeviceExtensionAttributeValues(mobileDeviceID int, values map[string]string) error {
+	payload, err := c.buildMobileDeviceExtensionAttributeValues(values)
+	if err != nil {
+		return apiErrorf(errMsgFailedUpdateByID, "mobile device extension attribute values", mobileDeviceID, err)
+	}
+
+	endpoint := fmt.Sprintf("%s/id/%d", uriMobileDevices, mobileDeviceID)
+
+	requestBody := requestMobileDeviceExtensionAttributeValues{ExtensionAttributes: payload}
+
+	var response ResourceMobileDevice
+	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	if err != nil {
+		return apiErrorf(errMsgFailedUpdateByID, "mobile device extension attribute values", mobileDeviceID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// SetMobileDeviceExtensionAttributeValueForMobileDevices sets the same extension attribute value on multiple mobile devices.
+func (c *Client) SetMobileDeviceExtensionAttributeValueForMobileDevices(mobileDeviceIDs []int, eaName, value string) error {
+	payload, err := c.buildMobileDeviceExtensionAttributeValues(map[string]string{eaName: value})
+	if err != nil {
+		return apiErrorf(errMsgFailedUpdateByName, "mobile device extension attribute value", eaName, err)
+	}
+
+	var failed []string
+	for _, id := range mobileDeviceIDs {
+		endpoint := fmt.Sprintf("%s/id/%d", uriMobileDevices, id)
+		requestBody := requestMobileDeviceExtensionAttributeValues{ExtensionAttributes: payload}
+
+		var response ResourceMobileDevice
+		resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%d (%v)", id, err))
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf(errMsgFailedUpdateByName, "mobile device extension attribute value", eaName, "mobile devices: "+strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+// buildMobileDeviceExtensionAttributeValues resolves mobile device extension attribute names to IDs and validates each value.
+func (c *Client) buildMobileDeviceExtensionAttributeValues(values map[string]string) ([]SharedSubsetExtensionAttributeValue, error) {
+	var payload []SharedSubsetExtensionAttributeValue
+	for _, name := range sortedExtensionAttributeNames(values) {
+		attribute, err := c.GetMobileExtensionAttributeByName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validateExtensionAttributeValue(name, attribute.InputType.Type, attribute.DataType, attribute.InputType.Choices, values[name]); err != nil {
+			return nil, err
+		}
+
+		payload = append(payload, SharedSubsetExtensionAttributeValue{ID: attribute.ID, Value: values[name]})
+	}
+
+	return payload, nil
+}
+
+// Users
+
+// GetUserExtensionAttributeValue retrieves the current value of the named extension attribute for a user.
+func (c *Client) GetUserExtensionAttributeValue(userID int, eaName string) (string, error) {
+	user, err := c.GetUserByID(userID)
+	if err != nil {
+		return "", err
+	}
+
+	for _, attribute := range user.ExtensionAttributes.Attributes {
+		if attribute.Name == eaName {
+			return attribute.Value, nil
+		}
+	}
+
+	return "", fmt.Errorf(errMsgFailedGetByName, "user extension attribute", eaName, errMsgNoName)
+}
+
+// SetUserExtensionAttributeValue sets the value of a single extension attribute on a user.
+func (c *Client) SetUserExtensionAttributeValue(userID int, eaName, value string) error {
+	return c.SetUserExtensionAttributeValues(userID, map[string]string{eaName: value})
+}
+
+// SetUserExtensionAttributeValues sets several extension attribute values on a user in a single request.
+// The map is keyed by extension attribute name.
+func (c *Client) SetUserExtensionAttributeValues(userID int, values map[string]string) error {
+	payload, err := c.buildUserExtensionAttributeValues(values)
+	if err != nil {
+		return apiErrorf(errMsgFailedUpdateByID, "user extension attribute values", userID, err)
+	}
+
+	endpoint := fmt.Sprintf("%s/id/%d", uriUsers, userID)
+
+	requestBody := requestUserExtensionAttributeValues{ExtensionAttributes: payload}
+
+	var response ResourceUser
+	resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+	if err != nil {
+		return apiErrorf(errMsgFailedUpdateByID, "user extension attribute values", userID, err)
+	}
+
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	return nil
+}
+
+// SetUserExtensionAttributeValueForUsers sets the same extension attribute value on multiple users.
+func (c *Client) SetUserExtensionAttributeValueForUsers(userIDs []int, eaName, value string) error {
+	payload, err := c.buildUserExtensionAttributeValues(map[string]string{eaName: value})
+	if err != nil {
+		return apiErrorf(errMsgFailedUpdateByName, "user extension attribute value", eaName, err)
+	}
+
+	var failed []string
+	for _, id := range userIDs {
+		endpoint := fmt.Sprintf("%s/id/%d", uriUsers, id)
+		requestBody := requestUserExtensionAttributeValues{ExtensionAttributes: payload}
+
+		var response ResourceUser
+		resp, err := c.HTTP.DoRequest("PUT", endpoint, &requestBody, &response)
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%d (%v)", id, err))
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf(errMsgFailedUpdateByName, "user extension attribute value", eaName, "users: "+strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+// buildUserExtensionAttributeValues resolves user extension attribute names to IDs and validates each value.
+func (c *Client) buildUserExtensionAttributeValues(values map[string]string) ([]SharedSubsetExtensionAttributeValue, error) {
+	var payload []SharedSubsetExtensionAttributeValue
+	for _, name := range sortedExtensionAttributeNames(values) {
+		attribute, err := c.GetUserExtensionAttributeByName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validateExtensionAttributeValue(name, attribute.InputType.Type, attribute.DataType, attribute.InputType.Choices, values[name]); err != nil {
+			return nil, err
+		}
+
+		payload = append(payload, SharedSubsetExtensionAttributeValue{ID: attribute.ID, Value: values[name]})
+	}
+
+	return payload, nil
+}
+
+// Validation
+
+// validateExtensionAttributeValue checks that a value can be written to an extension attribute.
+// Only text field and pop-up menu attributes accept values; script and directory mapped attributes
+// are populated by Jamf Pro itself. An empty value is always accepted and clears the attribute.
+func validateExtensionAttributeValue(name, inputType, dataType string, choices []string, value string) error {
+	switch strings.ToLower(inputType) {
+	case extensionAttributeInputTypeTextField, "":
+	case extensionAttributeInputTypePopupMenu:
+		if value == "" {
+			return nil
+		}
+		for _, choice := range choices {
+			if choice == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("value %q is not a valid choice for extension attribute %s, valid choices: %s", value, name, strings.Join(choices, ", "))
+	default:
+		return fmt.Errorf("extension attribute %s has input type %q and cannot be set manually", name, inputType)
+	}
+
+	if value == "" {
+		return nil
+	}
+
+	switch strings.ToLower(dataType) {
+	case extensionAttributeDataTypeInteger:
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("value %q is not a valid integer for extension attribute %s", value, name)
+		}
+	case extensionAttributeDataTypeDate:
+		for _, layout := range extensionAttributeDateLayouts {
+			if _, err := time.Parse(layout, value); err == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("value %q is not a valid date for extension attribute %s, expected YYYY-MM-DD or YYYY-MM-DD hh:mm:ss", value, name)
+	case extensionAttributeDataTypeString, "":
+	default:
+		return fmt.Errorf("extension attribute %s has unsupported data type %q", name, dataType)
+	}
+
+	return nil
+}
+
+// sortedExtensionAttributeNames returns the keys of a value map in a stable order so that
+// requests and errors are deterministic.
+func sortedExtensionAttributeNames(values map[string]string) []string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,246 @@
+// util_flat_package.go
+// Reads the metadata of macOS flat packages. A flat package is a xar archive whose table of contents lists a
+// Distribution file for product archives and a PackageInfo file for each component package. Only the table
+// of contents and those small XML files are read, so large packages are inspected without reading the
+// payload.
+
+package jamfpro
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+const (
+	// xarMagic starts the header of every xar archive.
+	xarMagic = 0x78617221
+	// xarMaxTOCSize and xarMaxMetadataFileSize bound what is read into memory from an untrusted archive.
+	xarMaxTOCSize          = 16 << 20
+	xarMaxMetadataFileSize = 4 << 20
+)
+
+// localizationKeyPattern matches Distribution titles that are keys into localized strings, e.g. SU_TITLE.
+var localizationKeyPattern = regexp.MustCompile(`^[A-Z0-9_]+$`)
+
+// FlatPackageInfo is the metadata of a flat package.
+type FlatPackageInfo struct {
+	// Identifier and Version are those of the product archive, or of the first component package when the
+	// archive does not declare a product.
+	Identifier string `json:"identifier"`
+	Version    string `json:"version"`
+	// Title is the title shown by Installer. It is empty when the package is not a product archive or the
+	// title is localized.
+	Title string `json:"title,omitempty"`
+	// MinimumOSVersion is the lowest macOS version the Distribution allows, when it sets one.
+	MinimumOSVersion string                 `json:"minimumOSVersion,omitempty"`
+	Components       []FlatPackageComponent `json:"components"`
+}
+
+// FlatPackageComponent is a component package within a flat package.
+type FlatPackageComponent struct {
+	Identifier string `json:"identifier"`
+	Version    string `json:"version"`
+}
+
+// xarHeader is the fixed part of a xar header.
+type xarHeader struct {
+	Magic                 uint32
+	Size                  uint16
+	Version               uint16
+	TOCLengthCompressed   uint64
+	TOCLengthUncompressed uint64
+	ChecksumAlgorithm     uint32
+}
+
+// xarTOC is the table of contents of a xar archive.
+type xarTOC struct {
+	Files []xarFile `xml:"toc>file"`
+}
+
+// xarFile is an entry of a xar table of contents. Directories hold their entries in Files.
+type xarFile struct {
+	Name  string    `xml:"name"`
+	Type  string    `xml:"type"`
+	Files []xarFile `xml:"file"`
+	Data  struct {
+		Offset   int64 `xml:"offset"`
+		Length   int64 `xml:"length"`
+		Size     int64 `xml:"size"`
+		Encoding struct {
+			Style string `xml:"style,attr"`
+		} `xml:"encoding"`
+	} `xml:"data"`
+}
+
+// distributionXML is the part of a Distribution file used for package metadata.
+type distributionXML struct {
+	Title   string `xml:"title"`
+	Product struct {
+		ID      string `xml:"id,attr"`
+		Version string `xml:"version,attr"`
+	} `xml:"product"`
+	PkgRefs []struct {
+		ID      string `xml:"id,attr"`
+		Version string `xml:"version,attr"`
+	} `xml:"pkg-ref"`
+	OSVersions []struct {
+		Min string `xml:"min,attr"`
+	} `xml:"allowed-os-versions>os-version"`
+}
+
+// packageInfoXML is the part of a PackageInfo file used for package metadata.
+type packageInfoXML struct {
+	Identifier string `xml:"identifier,attr"`
+	Version    string `xml:"version,attr"`
+}
+
+// ReadFlatPackageInfo reads the bundle identifier, version and title of a flat package from its Distribution
+// and PackageInfo files.
+func ReadFlatPackageInfo(filePath string) (*FlatPackageInfo, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open package %s: %v", filePath, err)
+	}
+	defer file.Close()
+
+	var header xarHeader
+	if err := binary.Read(file, binary.BigEndian, &header); err != nil {
+		return nil, fmt.Errorf("failed to read header of %s: %v", filePath, err)
+	}
+	if header.Magic != xarMagic {
+		return nil, fmt.Errorf("%s is not a flat package", filePath)
+	}
+	if header.TOCLengthCompressed > xarMaxTOCSize || header.TOCLengthUncompressed > xarMaxTOCSize {
+		return nil, fmt.Errorf("table of contents of %s is too large", filePath)
+	}
+
+	tocReader, err := zlib.NewReader(io.NewSectionReader(file, int64(header.Size), int64(header.TOCLengthCompressed)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read table of contents of %s: %v", filePath, err)
+	}
+	var toc xarTOC
+	if err := xml.NewDecoder(io.LimitReader(tocReader, xarMaxTOCSize)).Decode(&toc); err != nil {
+		return nil, fmt.Errorf("failed to parse table of contents of %s: %v", filePath, err)
+	}
+
+	archive := &xarArchive{file: file, heap: int64(header.Size) + int64(header.TOCLengthCompressed)}
+	info := &FlatPackageInfo{}
+
+	for _, entry := range toc.Files {
+		if entry.Name != "Distribution" || entry.Type != "file" {
+			continue
+		}
+		var distribution distributionXML
+		if err := archive.decode(entry, &distribution); err != nil {
+			return nil, fmt.Errorf("failed to parse Distribution of %s: %v", filePath, err)
+		}
+		info.applyDistribution(&distribution)
+	}
+
+	for _, packageInfo := range findXarFiles(toc.Files, "PackageInfo") {
+		var component packageInfoXML
+		if err := archive.decode(packageInfo, &component); err != nil {
+			return nil, fmt.Errorf("failed to parse PackageInfo of %s: %v", filePath, err)
+		}
+		info.addComponent(FlatPackageComponent{Identifier: component.Identifier, Version: component.Version})
+	}
+
+	if info.Identifier == "" && len(info.Components) > 0 {
+		info.Identifier = info.Components[0].Identifier
+		info.Version = info.Components[0].Version
+	}
+	if info.Identifier == "" {
+		return nil, fmt.Errorf("%s has no Distribution or PackageInfo identifying it", filePath)
+	}
+
+	return info, nil
+}
+
+// applyDistribution takes the product, title and component versions declared by a Distribution file.
+func (i *FlatPackageInfo) applyDistribution(distribution *distributionXML) {
+	i.Identifier = distribution.Product.ID
+	i.Version = distribution.Product.Version
+	if title := strings.TrimSpace(distribution.Title); !localizationKeyPattern.MatchString(title) {
+		i.Title = title
+	}
+	for _, osVersion := range distribution.OSVersions {
+		if osVersion.Min != "" {
+			i.MinimumOSVersion = osVersion.Min
+			break
+		}
+	}
+	// pkg-ref elements are repeated for choices and contents; only the ones with a version describe components
+	for _, ref := range distribution.PkgRefs {
+		if ref.ID != "" && ref.Version != "" {
+			i.addComponent(FlatPackageComponent{Identifier: ref.ID, Version: ref.Version})
+		}
+	}
+}
+
+// addComponent adds a component package unless it is already listed.
+func (i *FlatPackageInfo) addComponent(component FlatPackageComponent) {
+	if component.Identifier == "" {
+		return
+	}
+	for _, existing := range i.Components {
+		if existing.Identifier == component.Identifier {
+			return
+		}
+	}
+	i.Components = append(i.Components, component)
+}
+
+// xarArchive reads files from the heap of a xar archive.
+type xarArchive struct {
+	file *os.File
+	heap int64
+}
+
+// decode extracts a small XML file from the archive and decodes it into v.
+func (a *xarArchive) decode(entry xarFile, v interface{}) error {
+	if entry.Data.Length > xarMaxMetadataFileSize || entry.Data.Size > xarMaxMetadataFileSize {
+		return fmt.Errorf("%s is too large", entry.Name)
+	}
+
+	var reader io.Reader = io.NewSectionReader(a.file, a.heap+entry.Data.Offset, entry.Data.Length)
+	switch entry.Data.Encoding.Style {
+	case "", "application/octet-stream":
+	case "application/x-gzip":
+		zlibReader, err := zlib.NewReader(reader)
+		if err != nil {
+			return err
+		}
+		defer zlibReader.Close()
+		reader = zlibReader
+	case "application/x-bzip2":
+		reader = bzip2.NewReader(reader)
+	default:
+		return fmt.Errorf("%s uses unsupported encoding %s", entry.Name, entry.Data.Encoding.Style)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(reader, xarMaxMetadataFileSize))
+	if err != nil {
+		return err
+	}
+	return xml.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// findXarFiles returns the files with the given name at any depth of the table of contents.
+func findXarFiles(files []xarFile, name string) []xarFile {
+	var found []xarFile
+	for _, file := range files {
+		if file.Type == "file" && file.Name == name {
+			found = append(found, file)
+		}
+		found = append(found, findXarFiles(file.Files, name)...)
+	}
+	return found
+}
@@ -0,0 +1,454 @@
+// util_jcds2_download.go
+// Downloads from Jamf Cloud Distribution Service (JCDS) 2.0 and mirroring of the JCDS 2.0 inventory.
+// Packages are fetched through the presigned URI returned by GetJCDS2PackageURIByName. Interrupted transfers
+// continue with a ranged request from the last byte received, with a fresh URI in case the previous one
+// expired, and every download is verified against the MD5 reported by JCDS 2.0.
+// Mirroring copies every JCDS 2.0 file to a local directory or to the JCDS 2.0 of another tenant, e.g. to
+// promote packages from a staging to a production instance.
+
+package jamfpro
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-http-client/response"
+)
+
+// maxJCDS2DownloadAttempts is how often a download is continued after the connection breaks.
+const maxJCDS2DownloadAttempts = 5
+
+// DefaultJCDS2MirrorConcurrency is the number of files mirrored at the same time when
+// JCDS2MirrorOptions.Concurrency is not set.
+const DefaultJCDS2MirrorConcurrency = 2
+
+// JCDS2DownloadResult is the outcome of a JCDS 2.0 download.
+type JCDS2DownloadResult struct {
+	FileName string `json:"fileName"`
+	Size     int64  `json:"size"`
+	MD5      string `json:"md5"`
+	// Resumed is the number of bytes kept from an earlier, interrupted download.
+	Resumed int64 `json:"resumed,omitempty"`
+}
+
+// JCDS2MirrorOptions tunes MirrorJCDS2PackagesToDirectory and MirrorJCDS2PackagesToClient.
+type JCDS2MirrorOptions struct {
+	// Concurrency is the number of files mirrored at the same time.
+	Concurrency int
+	// DryRun reports what would be copied without transferring anything.
+	DryRun bool
+	// TempDir holds packages in transit between tenants. Defaults to the system temporary directory.
+	TempDir string
+}
+
+// JCDS2MirrorResult lists the files a mirror run copied, skipped because they were already identical, and
+// failed to copy.
+type JCDS2MirrorResult struct {
+	Copied  []string          `json:"copied"`
+	Skipped []string          `json:"skipped"`
+	Failed  map[string]string `json:"failed,omitempty"`
+}
+
+// errJCDS2WriteFailed marks errors writing to the destination, which are not retried.
+var errJCDS2WriteFailed = errors.New("failed to write download")
+
+// DownloadJCDS2Package streams a JCDS 2.0 file to w and verifies it against the MD5 reported by JCDS 2.0.
+// A broken connection is continued with a ranged request, so w only receives each byte once.
+func (c *Client) DownloadJCDS2Package(fileName string, w io.Writer) (*JCDS2DownloadResult, error) {
+	file, err := c.getJCDS2File(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.downloadJCDS2Package(file, w)
+}
+
+// downloadJCDS2Package streams the JCDS 2.0 file of an inventory entry to w and verifies it.
+func (c *Client) downloadJCDS2Package(file *ResponseJCDS2List, w io.Writer) (*JCDS2DownloadResult, error) {
+	hasher := md5.New()
+	if err := c.downloadJCDS2Range(file, io.MultiWriter(w, hasher), 0); err != nil {
+		return nil, err
+	}
+
+	return verifyJCDS2Download(file, hasher, 0)
+}
+
+// DownloadJCDS2PackageToFile downloads a JCDS 2.0 file to filePath. The download is written to filePath with
+// a .part suffix and renamed once verified; when an earlier download left a .part file behind, the download
+// continues where it stopped, also across process restarts.
+func (c *Client) DownloadJCDS2PackageToFile(fileName, filePath string) (*JCDS2DownloadResult, error) {
+	file, err := c.getJCDS2File(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.downloadJCDS2PackageToFile(file, filePath)
+}
+
+// downloadJCDS2PackageToFile downloads the JCDS 2.0 file of an inventory entry to filePath, resuming from a
+// .part file left by an earlier download.
+func (c *Client) downloadJCDS2PackageToFile(file *ResponseJCDS2List, filePath string) (*JCDS2DownloadResult, error) {
+	partPath := filePath + ".part"
+	part, err := os.OpenFile(partPath, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %v", partPath, err)
+	}
+	defer part.Close()
+
+	// The bytes kept from an earlier attempt are hashed first so the whole file is verified.
+	hasher := md5.New()
+	offset, err := io.Copy(hasher, part)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", partPath, err)
+	}
+	if offset > file.Length {
+		if err := part.Truncate(0); err != nil {
+			return nil, fmt.Errorf("failed to truncate %s: %v", partPath, err)
+		}
+		if _, err := part.Seek(0, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("failed to truncate %s: %v", partPath, err)
+		}
+		hasher.Reset()
+		offset = 0
+	}
+
+	if err := c.downloadJCDS2Range(file, io.MultiWriter(part, hasher), offset); err != nil {
+		return nil, err
+	}
+
+	result, err := verifyJCDS2Download(file, hasher, offset)
+	if err != nil {
+		// A corrupt partial download would fail every resume, so start over next time.
+		part.Close()
+		os.Remove(partPath)
+		return nil, err
+	}
+
+	if err := part.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %v", partPath, err)
+	}
+	if err := os.Rename(partPath, filePath); err != nil {
+		return nil, fmt.Errorf("failed to move download to %s: %v", filePath, err)
+	}
+
+	return result, nil
+}
+
+// MirrorJCDS2PackagesToDirectory downloads every JCDS 2.0 file to dir, keeping its path relative to dir. Files
+// that already exist with the same size and MD5 are skipped, and interrupted downloads are resumed. Files whose
+// name is not a relative path within dir, or that would share a local path with another file, e.g. on a case
+// insensitive file system, are reported as failed without downloading them.
+func (c *Client) MirrorJCDS2PackagesToDirectory(dir string, options *JCDS2MirrorOptions) (*JCDS2MirrorResult, error) {
+	if options == nil {
+		options = &JCDS2MirrorOptions{}
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create mirror directory %s: %v", dir, err)
+	}
+
+	files, err := c.GetJCDS2Packages()
+	if err != nil {
+		return nil, err
+	}
+
+	rejected := rejectJCDS2MirrorPaths(files)
+	return mirrorJCDS2Files(files, options, func(file ResponseJCDS2List) (bool, error) {
+		if err, ok := rejected[file.FileName]; ok {
+			return false, err
+		}
+		filePath := filepath.Join(dir, filepath.FromSlash(file.FileName))
+		if localFileMatchesJCDS2(filePath, file) {
+			return false, nil
+		}
+		if options.DryRun {
+			return true, nil
+		}
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			return true, fmt.Errorf("failed to create directory for %s: %v", filePath, err)
+		}
+		_, err := c.downloadJCDS2PackageToFile(&file, filePath)
+		return true, err
+	}), nil
+}
+
+// rejectJCDS2MirrorPaths returns the files that cannot be mirrored to a directory: names that are not a
+// relative path within it, and names that map to the same local path, as their concurrent downloads would
+// share a .part file. Paths are compared case insensitively, as on the default file systems of macOS and
+// Windows.
+func rejectJCDS2MirrorPaths(files []ResponseJCDS2List) map[string]error {
+	rejected := make(map[string]error)
+	byPath := make(map[string][]string)
+	for _, file := range files {
+		localPath := filepath.FromSlash(file.FileName)
+		if !filepath.IsLocal(localPath) {
+			rejected[file.FileName] = fmt.Errorf("file name %s is not a relative path", file.FileName)
+			continue
+		}
+		key := strings.ToLower(filepath.Clean(localPath))
+		byPath[key] = append(byPath[key], file.FileName)
+	}
+
+	for _, names := range byPath {
+		if len(names) < 2 {
+			continue
+		}
+		for _, name := range names {
+			rejected[name] = fmt.Errorf("files %s map to the same local path", strings.Join(names, ", "))
+		}
+	}
+	return rejected
+}
+
+// MirrorJCDS2PackagesToClient copies every JCDS 2.0 file of this tenant to the JCDS 2.0 of target. Files the
+// target already holds under the same name with the same MD5 are skipped. Each file is staged in
+// options.TempDir while it is copied.
+func (c *Client) MirrorJCDS2PackagesToClient(target *Client, options *JCDS2MirrorOptions) (*JCDS2MirrorResult, error) {
+	if options == nil {
+		options = &JCDS2MirrorOptions{}
+	}
+
+	files, err := c.GetJCDS2Packages()
+	if err != nil {
+		return nil, err
+	}
+	targetFiles, err := target.GetJCDS2Packages()
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]string, len(targetFiles))
+	for _, file := range targetFiles {
+		existing[file.FileName] = strings.ToLower(file.MD5)
+	}
+
+	return mirrorJCDS2Files(files, options, func(file ResponseJCDS2List) (bool, error) {
+		if targetMD5, ok := existing[file.FileName]; ok && targetMD5 == strings.ToLower(file.MD5) {
+			return false, nil
+		}
+		if options.DryRun {
+			return true, nil
+		}
+
+		staged, err := os.CreateTemp(options.TempDir, "jcds2-mirror-*")
+		if err != nil {
+			return true, fmt.Errorf("failed to create staging file: %v", err)
+		}
+		defer os.Remove(staged.Name())
+		defer staged.Close()
+
+		if _, err := c.downloadJCDS2Package(&file, staged); err != nil {
+			return true, err
+		}
+
+		// The name check above already decided to copy, so content stored under another name on the
+		// target must not cause the upload to be skipped.
+		_, err = target.UploadJCDS2PackageFromReader(file.FileName, staged, file.Length, &JCDS2UploadOptions{ForceUpload: true})
+		return true, err
+	}), nil
+}
+
+// getJCDS2File returns the JCDS 2.0 inventory entry of a file, which holds its size and MD5.
+func (c *Client) getJCDS2File(fileName string) (*ResponseJCDS2List, error) {
+	files, err := c.GetJCDS2Packages()
+	if err != nil {
+		return nil, err
+	}
+	for i := range files {
+		if files[i].FileName == fileName {
+			return &files[i], nil
+		}
+	}
+	return nil, fmt.Errorf(errMsgFailedGetByName, "JCDS 2.0 file", fileName, "not found")
+}
+
+// downloadJCDS2Range writes a JCDS 2.0 file from offset to w. When the connection breaks, a new presigned
+// URI is requested and the download continues from the last byte written.
+func (c *Client) downloadJCDS2Range(file *ResponseJCDS2List, w io.Writer, offset int64) error {
+	progress := NewProgressWriter(w, file.FileName, file.Length, c.Progress)
+	progress.tracker.resume(offset)
+
+	var (
+		lastErr  error
+		attempts int
+	)
+	for offset < file.Length && attempts < maxJCDS2DownloadAttempts {
+		attempts++
+		written, err := c.downloadJCDS2Attempt(file.FileName, progress, offset)
+		offset += written
+		if err == nil {
+			lastErr = nil
+			break
+		}
+		if errors.Is(err, errJCDS2WriteFailed) || !isJCDS2DownloadRetryable(err) {
+			return fmt.Errorf("failed to download %s from JCDS 2.0: %w", file.FileName, err)
+		}
+		lastErr = err
+	}
+	if lastErr != nil {
+		return fmt.Errorf("failed to download %s from JCDS 2.0 after %d attempts: %w", file.FileName, attempts, lastErr)
+	}
+
+	progress.Finish()
+	return nil
+}
+
+// isJCDS2DownloadRetryable reports whether a failed download attempt may be continued. Each attempt requests a
+// fresh presigned URI, so a client error such as 403 or 404 is not an expired URI and fails the download,
+// except for timeouts and rate limiting.
+func isJCDS2DownloadRetryable(err error) bool {
+	var apiErr *response.APIError
+	if !errors.As(err, &apiErr) {
+		return true
+	}
+	switch {
+	case apiErr.StatusCode == http.StatusRequestTimeout, apiErr.StatusCode == http.StatusTooManyRequests:
+		return true
+	case apiErr.StatusCode >= 400 && apiErr.StatusCode < 500:
+		return false
+	}
+	return true
+}
+
+// downloadJCDS2Attempt requests a JCDS 2.0 file from offset and copies it to w, returning the bytes written.
+// The attempt fails when no data arrives for the client's configured timeout, so a stalled connection is
+// continued by the next attempt.
+func (c *Client) downloadJCDS2Attempt(fileName string, w io.Writer, offset int64) (int64, error) {
+	uri, err := c.GetJCDS2PackageURIByName(fileName)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, guard, stop := newStallGuard(context.Background(), c.rawClientOptions().CustomTimeout)
+	defer stop()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", uri.URI, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request for %s: %v", fileName, err)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := c.rawHTTPClient().Do(req)
+	if err != nil {
+		return 0, guard.Err(err)
+	}
+	defer resp.Body.Close()
+	body := guard.Reader(resp.Body)
+
+	switch {
+	case resp.StatusCode == http.StatusPartialContent:
+	case resp.StatusCode == http.StatusOK:
+		// The range was ignored, so the bytes already written are skipped.
+		if _, err := io.CopyN(io.Discard, body, offset); err != nil {
+			return 0, guard.Err(err)
+		}
+	default:
+		data, _ := io.ReadAll(io.LimitReader(body, 4096))
+		// The query of a presigned uri holds its signature
+		return 0, newRawStatusError(resp, strings.SplitN(uri.URI, "?", 2)[0], data)
+	}
+
+	written, err := io.Copy(&jcds2DestinationWriter{w}, body)
+	if err != nil && !errors.Is(err, errJCDS2WriteFailed) {
+		err = guard.Err(err)
+	}
+	return written, err
+}
+
+// jcds2DestinationWriter marks write errors so that downloadJCDS2Range does not retry them.
+type jcds2DestinationWriter struct {
+	w io.Writer
+}
+
+func (d *jcds2DestinationWriter) Write(p []byte) (int, error) {
+	n, err := d.w.Write(p)
+	if err != nil {
+		return n, fmt.Errorf("%w: %v", errJCDS2WriteFailed, err)
+	}
+	return n, nil
+}
+
+// verifyJCDS2Download compares the MD5 of a completed download with the MD5 reported by JCDS 2.0.
+func verifyJCDS2Download(file *ResponseJCDS2List, hasher hash.Hash, resumed int64) (*JCDS2DownloadResult, error) {
+	actual := hex.EncodeToString(hasher.Sum(nil))
+	if file.MD5 != "" && !strings.EqualFold(actual, file.MD5) {
+		return nil, fmt.Errorf("downloaded %s has MD5 %s but JCDS 2.0 reports %s", file.FileName, actual, file.MD5)
+	}
+
+	return &JCDS2DownloadResult{
+		FileName: file.FileName,
+		Size:     file.Length,
+		MD5:      actual,
+		Resumed:  resumed,
+	}, nil
+}
+
+// localFileMatchesJCDS2 reports whether a local file has the size and MD5 of a JCDS 2.0 file.
+func localFileMatchesJCDS2(filePath string, file ResponseJCDS2List) bool {
+	info, err := os.Stat(filePath)
+	if err != nil || info.Size() != file.Length {
+		return false
+	}
+
+	local, err := os.Open(filePath)
+	if err != nil {
+		return false
+	}
+	defer local.Close()
+
+	checksums, err := ComputePackageChecksums(local)
+	return err == nil && checksums.Matches(file)
+}
+
+// mirrorJCDS2Files runs mirror for every file, at most options.Concurrency at a time. mirror reports whether the
+// file needed copying.
+func mirrorJCDS2Files(files []ResponseJCDS2List, options *JCDS2MirrorOptions, mirror func(ResponseJCDS2List) (bool, error)) *JCDS2MirrorResult {
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultJCDS2MirrorConcurrency
+	}
+
+	result := &JCDS2MirrorResult{Failed: make(map[string]string)}
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, concurrency)
+	)
+	for _, file := range files {
+		wg.Add(1)
+		go func(file ResponseJCDS2List) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			copied, err := mirror(file)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err != nil:
+				result.Failed[file.FileName] = err.Error()
+			case copied:
+				result.Copied = append(result.Copied, file.FileName)
+			default:
+				result.Skipped = append(result.Skipped, file.FileName)
+			}
+		}(file)
+	}
+	wg.Wait()
+
+	sort.Strings(result.Copied)
+	sort.Strings(result.Skipped)
+	return result
+}
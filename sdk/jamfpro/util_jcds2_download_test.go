@@ -0,0 +1,80 @@
+package jamfpro
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-http-client/response"
+)
+
+func TestRejectJCDS2MirrorPaths(t *testing.T) {
+	tests := []struct {
+		name         string
+		fileNames    []string
+		wantRejected []string
+	}{
+		{
+			name:      "distinct names",
+			fileNames: []string{"app.pkg", "tools/app.pkg", "tools/other.pkg"},
+		},
+		{
+			name:         "same name in another case",
+			fileNames:    []string{"App.pkg", "app.pkg", "other.pkg"},
+			wantRejected: []string{"App.pkg", "app.pkg"},
+		},
+		{
+			name:         "same path after cleaning",
+			fileNames:    []string{"tools/app.pkg", "tools//app.pkg"},
+			wantRejected: []string{"tools//app.pkg", "tools/app.pkg"},
+		},
+		{
+			name:         "names outside the directory",
+			fileNames:    []string{"../app.pkg", "/etc/app.pkg", "app.pkg"},
+			wantRejected: []string{"../app.pkg", "/etc/app.pkg"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := make([]ResponseJCDS2List, len(tt.fileNames))
+			for i, fileName := range tt.fileNames {
+				files[i] = ResponseJCDS2List{FileName: fileName}
+			}
+
+			var rejected []string
+			for fileName := range rejectJCDS2MirrorPaths(files) {
+				rejected = append(rejected, fileName)
+			}
+			sort.Strings(rejected)
+
+			if fmt.Sprint(rejected) != fmt.Sprint(tt.wantRejected) {
+				t.Errorf("rejected = %v, want %v", rejected, tt.wantRejected)
+			}
+		})
+	}
+}
+
+func TestIsJCDS2DownloadRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "connection error", err: errors.New("connection reset by peer"), want: true},
+		{name: "forbidden", err: &response.APIError{StatusCode: 403}, want: false},
+		{name: "not found", err: &response.APIError{StatusCode: 404}, want: false},
+		{name: "wrapped not found", err: fmt.Errorf("failed: %w", &response.APIError{StatusCode: 404}), want: false},
+		{name: "rate limited", err: &response.APIError{StatusCode: 429}, want: true},
+		{name: "server error", err: &response.APIError{StatusCode: 503}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isJCDS2DownloadRetryable(tt.err); got != tt.want {
+				t.Errorf("isJCDS2DownloadRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
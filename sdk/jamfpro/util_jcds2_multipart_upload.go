@@ -0,0 +1,652 @@
+// util_jcds2_multipart_upload.go
+// Streaming multipart uploads to Jamf Cloud Distribution Service (JCDS) 2.0.
+// Packages are read part by part from an io.ReaderAt, so multi-GB packages never need to fit in memory; only
+// the parts being uploaded are held, at most Concurrency parts of PartSize bytes.
+// When a state file is configured, the S3 upload ID and completed parts are recorded after every part so
+// an interrupted upload can continue where it stopped, also after the process restarts. The STS credentials
+// issued by Jamf Pro are renewed with RenewJCDS2Credentials when they expire during the upload.
+// Parts are read in order and checksummed as they are read, so the checksums come from the upload itself.
+// The upload is skipped when JCDS 2.0 already holds the same content. That decision needs the MD5 before the
+// first part is sent, so the package is only checksummed up front when JCDS 2.0 holds a file of the same size.
+// Ref: https://docs.aws.amazon.com/AmazonS3/latest/userguide/mpuoverview.html
+
+package jamfpro
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/helpers"
+)
+
+const (
+	// DefaultJCDS2UploadPartSize is the multipart part size used when JCDS2UploadOptions.PartSize is not set.
+	DefaultJCDS2UploadPartSize int64 = 64 * 1024 * 1024
+	// DefaultJCDS2UploadConcurrency is the number of parts uploaded at the same time when
+	// JCDS2UploadOptions.Concurrency is not set.
+	DefaultJCDS2UploadConcurrency = 4
+
+	minJCDS2UploadPartSize int64 = 5 * 1024 * 1024
+	maxJCDS2UploadParts    int64 = 10000
+	maxJCDS2PartAttempts         = 3
+)
+
+// JCDS2UploadOptions tunes streaming uploads to JCDS 2.0. A nil *JCDS2UploadOptions uses the defaults.
+type JCDS2UploadOptions struct {
+	// PartSize is the size of each multipart part in bytes. Values below the S3 minimum of 5 MiB are raised,
+	// and the size is increased when the file would otherwise need more than 10,000 parts.
+	PartSize int64
+	// Concurrency is the number of parts uploaded at the same time.
+	Concurrency int
+	// StateFilePath records the progress of the upload. When the file exists and describes the same upload,
+	// the upload is resumed. The file is removed once the upload completes. Without a state file a failed
+	// upload is aborted.
+	StateFilePath string
+	// Progress receives the progress of the upload, and of the checksum pass when one is needed to skip
+	// duplicates. When nil, Client.Progress is used.
+	Progress ProgressListener
+	// ForceUpload uploads the package even when JCDS 2.0 already holds a file with the same content.
+	ForceUpload bool
+}
+
+// JCDS2UploadResult is the outcome of a streaming upload to JCDS 2.0.
+type JCDS2UploadResult struct {
+	// File is the s3:// URI of the object holding the package: the uploaded object, or the existing object
+	// when the upload was skipped.
+	File ResponseJCDS2File `json:"file"`
+	// FileName is the JCDS 2.0 file holding the package. It differs from the uploaded name when the same
+	// content already existed under another name.
+	FileName string `json:"fileName"`
+	// Checksums of the package.
+	Checksums PackageChecksums `json:"checksums"`
+	// Skipped reports that JCDS 2.0 already held the same content, so nothing was uploaded.
+	Skipped bool `json:"skipped"`
+}
+
+// jcds2UploadState is the persisted progress of a multipart upload.
+type jcds2UploadState struct {
+	FileName string           `json:"fileName"`
+	Size     int64            `json:"size"`
+	PartSize int64            `json:"partSize"`
+	Bucket   string           `json:"bucket"`
+	Key      string           `json:"key"`
+	UploadID string           `json:"uploadId"`
+	Parts    map[int32]string `json:"parts"`
+}
+
+// jcds2Session holds the S3 client and the STS credentials issued by Jamf Pro for an upload.
+type jcds2Session struct {
+	client      *Client
+	mu          sync.Mutex
+	credentials ResponseJCDS2UploadCredentials
+	generation  int
+	cache       *aws.CredentialsCache
+	s3          *s3.Client
+}
+
+// UploadJCDS2PackageFromFile streams a package file to JCDS 2.0 with an S3 multipart upload without creating
+// package metadata in Jamf Pro.
+func (c *Client) UploadJCDS2PackageFromFile(filePath string, options *JCDS2UploadOptions) (*JCDS2UploadResult, error) {
+	file, size, err := helpers.OpenJCDSPackageTypes(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open package file securely: %v", err)
+	}
+	defer file.Close()
+
+	return c.UploadJCDS2PackageFromReader(filepath.Base(filePath), file, size, options)
+}
+
+// UploadJCDS2PackageFromReader streams size bytes from reader to JCDS 2.0 as fileName with an S3 multipart
+// upload without creating package metadata in Jamf Pro. Parts are read concurrently, so reader must support
+// concurrent ReadAt calls, as *os.File does.
+func (c *Client) UploadJCDS2PackageFromReader(fileName string, reader io.ReaderAt, size int64, options *JCDS2UploadOptions) (*JCDS2UploadResult, error) {
+	return c.uploadJCDS2Package(context.Background(), fileName, reader, size, options)
+}
+
+// uploadJCDS2Package implements UploadJCDS2PackageFromReader. Cancelling ctx stops the upload of the parts.
+func (c *Client) uploadJCDS2Package(ctx context.Context, fileName string, reader io.ReaderAt, size int64, options *JCDS2UploadOptions) (*JCDS2UploadResult, error) {
+	if fileName == "" {
+		return nil, fmt.Errorf("a file name is required to upload to JCDS 2.0")
+	}
+	if options == nil {
+		options = &JCDS2UploadOptions{}
+	}
+	listener := c.progressListener(options.Progress)
+	result := &JCDS2UploadResult{FileName: fileName}
+
+	// The checksums are computed while uploading unless the dedup check already needed them
+	var checksums *PackageChecksums
+	if !options.ForceUpload {
+		existing, computed, err := c.findJCDS2Duplicate(fileName, reader, size, listener)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			credentials, err := c.requestJCDS2UploadCredentials()
+			if err != nil {
+				return nil, err
+			}
+			result.File.URI = jcds2FileURI(credentials.BucketName, credentials.Path+existing.FileName)
+			result.FileName = existing.FileName
+			result.Checksums = *computed
+			result.Skipped = true
+			return result, nil
+		}
+		checksums = computed
+	}
+
+	partSize := jcds2UploadPartSize(options.PartSize, size)
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultJCDS2UploadConcurrency
+	}
+
+	state, err := loadJCDS2UploadState(options.StateFilePath, fileName, size, partSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// A resumed upload keeps its bucket and key, so the credentials of the existing session are renewed
+	// rather than starting a new file upload.
+	session, err := c.newJCDS2Session(state != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if state != nil {
+		if err := session.syncUploadedParts(ctx, state); err != nil {
+			state = nil
+		}
+	}
+
+	if state == nil {
+		state = &jcds2UploadState{
+			FileName: fileName,
+			Size:     size,
+			PartSize: partSize,
+			Bucket:   session.credentials.BucketName,
+			Key:      session.credentials.Path + fileName,
+			Parts:    make(map[int32]string),
+		}
+		output, err := session.do(ctx, func(s3Client *s3.Client) (interface{}, error) {
+			return s3Client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+				Bucket: aws.String(state.Bucket),
+				Key:    aws.String(state.Key),
+			})
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to start multipart upload: %v", err)
+		}
+		state.UploadID = aws.ToString(output.(*s3.CreateMultipartUploadOutput).UploadId)
+		if err := saveJCDS2UploadState(options.StateFilePath, state); err != nil {
+			return nil, err
+		}
+	}
+
+	tracker := newProgressTracker(listener, "upload", fileName, size)
+	for partNumber := range state.Parts {
+		tracker.resume(state.partLength(partNumber))
+	}
+
+	var hasher *packageHasher
+	if checksums == nil {
+		hasher = newPackageHasher()
+	}
+
+	if err := session.uploadParts(ctx, reader, state, concurrency, options.StateFilePath, tracker, hasher); err != nil {
+		if options.StateFilePath == "" {
+			session.abort(state)
+			return nil, fmt.Errorf("failed to upload file: %v", err)
+		}
+		return nil, fmt.Errorf("failed to upload file, resume with state file %s: %v", options.StateFilePath, err)
+	}
+
+	_, err = session.do(ctx, func(s3Client *s3.Client) (interface{}, error) {
+		return s3Client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+			Bucket:          aws.String(state.Bucket),
+			Key:             aws.String(state.Key),
+			UploadId:        aws.String(state.UploadID),
+			MultipartUpload: &types.CompletedMultipartUpload{Parts: state.completedParts()},
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete multipart upload: %v", err)
+	}
+
+	tracker.finish()
+
+	if options.StateFilePath != "" {
+		if err := os.Remove(options.StateFilePath); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to remove upload state file: %v", err)
+		}
+	}
+
+	if hasher != nil {
+		checksums = hasher.checksums()
+	}
+	result.Checksums = *checksums
+	result.File.URI = jcds2FileURI(state.Bucket, state.Key)
+	return result, nil
+}
+
+// findJCDS2Duplicate returns the JCDS 2.0 file with the same content as the package, or nil when there is
+// none. The package is only checksummed when JCDS 2.0 holds a file of the same size; the checksums are
+// returned so the upload does not compute them again.
+func (c *Client) findJCDS2Duplicate(fileName string, reader io.ReaderAt, size int64, listener ProgressListener) (*ResponseJCDS2List, *PackageChecksums, error) {
+	files, err := c.GetJCDS2Packages()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sameSize := false
+	for _, file := range files {
+		if file.Length == size {
+			sameSize = true
+			break
+		}
+	}
+	if !sameSize {
+		return nil, nil, nil
+	}
+
+	tracker := newProgressTracker(listener, "checksum", fileName, size)
+	checksums, err := ComputePackageChecksums(newTrackedReader(io.NewSectionReader(reader, 0, size), tracker))
+	if err != nil {
+		return nil, nil, err
+	}
+	tracker.finish()
+
+	return matchJCDS2Package(files, checksums, fileName), checksums, nil
+}
+
+// jcds2FileURI returns the s3:// URI of a JCDS 2.0 object.
+func jcds2FileURI(bucket, key string) string {
+	return fmt.Sprintf("s3://%s/%s", bucket, key)
+}
+
+// newJCDS2Session obtains STS credentials from Jamf Pro and builds an S3 client that uses them. When renew is
+// true, the credentials of the existing upload session are renewed instead of starting a new file upload.
+func (c *Client) newJCDS2Session(renew bool) (*jcds2Session, error) {
+	session := &jcds2Session{client: c}
+
+	if renew {
+		credentials, err := c.RenewJCDS2Credentials()
+		if err != nil {
+			return nil, fmt.Errorf("failed to renew upload credentials: %v", err)
+		}
+		session.credentials = *credentials
+	} else {
+		credentials, err := c.requestJCDS2UploadCredentials()
+		if err != nil {
+			return nil, err
+		}
+		session.credentials = *credentials
+	}
+
+	session.cache = aws.NewCredentialsCache(aws.CredentialsProviderFunc(session.retrieve))
+	cfg, err := config.LoadDefaultConfig(context.TODO(),
+		config.WithRegion(session.credentials.Region),
+		config.WithCredentialsProvider(session.cache),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS config: %v", err)
+	}
+
+	// Parts are sent over TLS, so the payload is not hashed a second time for signing.
+	session.s3 = s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.APIOptions = append(o.APIOptions, v4.SwapComputePayloadSHA256ForUnsignedPayloadMiddleware)
+	})
+
+	return session, nil
+}
+
+// requestJCDS2UploadCredentials obtains STS credentials for a new file upload, which also name the bucket and
+// path holding the JCDS 2.0 files.
+func (c *Client) requestJCDS2UploadCredentials() (*ResponseJCDS2UploadCredentials, error) {
+	var credentials ResponseJCDS2UploadCredentials
+	resp, err := c.HTTP.DoRequest("POST", uriJCDS2+"/files", nil, &credentials)
+	if err != nil {
+		return nil, apiErrorf(errMsgFailedCreate, "JCDS 2.0 upload credentials", err)
+	}
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+
+	// Validate if we received necessary details
+	if credentials.Region == "" || credentials.BucketName == "" || credentials.Path == "" {
+		return nil, fmt.Errorf("incomplete upload credentials received")
+	}
+
+	return &credentials, nil
+}
+
+// retrieve provides the current STS credentials to the S3 client.
+func (s *jcds2Session) retrieve(ctx context.Context) (aws.Credentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return aws.Credentials{
+		AccessKeyID:     s.credentials.AccessKeyID,
+		SecretAccessKey: s.credentials.SecretAccessKey,
+		SessionToken:    s.credentials.SessionToken,
+		Source:          "JamfProJCDS2",
+	}, nil
+}
+
+// renew replaces expired STS credentials. Concurrent parts that fail with the same credentials generation
+// trigger a single renewal.
+func (s *jcds2Session) renew(generation int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.generation != generation {
+		return nil
+	}
+
+	credentials, err := s.client.RenewJCDS2Credentials()
+	if err != nil {
+		return err
+	}
+	s.credentials.AccessKeyID = credentials.AccessKeyID
+	s.credentials.SecretAccessKey = credentials.SecretAccessKey
+	s.credentials.SessionToken = credentials.SessionToken
+	s.generation++
+	s.cache.Invalidate()
+	return nil
+}
+
+// do runs an S3 call and retries it with renewed credentials when the STS credentials have expired.
+func (s *jcds2Session) do(ctx context.Context, call func(*s3.Client) (interface{}, error)) (interface{}, error) {
+	var lastErr error
+	for attempt := 0; attempt < maxJCDS2PartAttempts; attempt++ {
+		s.mu.Lock()
+		generation := s.generation
+		s.mu.Unlock()
+
+		output, err := call(s.s3)
+		if err == nil {
+			return output, nil
+		}
+		if !isJCDS2CredentialsExpired(err) {
+			return nil, err
+		}
+		lastErr = err
+		if err := s.renew(generation); err != nil {
+			return nil, fmt.Errorf("failed to renew expired upload credentials: %v", err)
+		}
+	}
+	return nil, lastErr
+}
+
+// syncUploadedParts replaces the recorded parts of a resumed upload with those S3 holds, which also covers
+// parts that finished after the state file was last written.
+func (s *jcds2Session) syncUploadedParts(ctx context.Context, state *jcds2UploadState) error {
+	parts := make(map[int32]string)
+	paginator := s3.NewListPartsPaginator(s.s3, &s3.ListPartsInput{
+		Bucket:   aws.String(state.Bucket),
+		Key:      aws.String(state.Key),
+		UploadId: aws.String(state.UploadID),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, part := range page.Parts {
+			partNumber := aws.ToInt32(part.PartNumber)
+			if aws.ToInt64(part.Size) == state.partLength(partNumber) {
+				parts[partNumber] = aws.ToString(part.ETag)
+			}
+		}
+	}
+	state.Parts = parts
+	return nil
+}
+
+// uploadParts uploads every part that is not yet recorded in state. Parts are read in order and written to
+// hasher, when not nil, before they are handed to the concurrent uploads; parts recorded by an earlier run are
+// read for the hasher only. The first failure stops the upload.
+func (s *jcds2Session) uploadParts(ctx context.Context, reader io.ReaderAt, state *jcds2UploadState, concurrency int, stateFilePath string, tracker *progressTracker, hasher *packageHasher) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	uploaded := make(map[int32]bool, len(state.Parts))
+	for partNumber := range state.Parts {
+		uploaded[partNumber] = true
+	}
+
+	type part struct {
+		number int32
+		data   []byte
+	}
+	parts := make(chan part)
+	// Each buffer holds one part, which bounds the memory of the upload to concurrency parts.
+	buffers := make(chan []byte, concurrency)
+	for i := 0; i < concurrency; i++ {
+		buffers <- nil
+	}
+
+	var (
+		wg       sync.WaitGroup
+		stateMu  sync.Mutex
+		firstErr error
+	)
+	fail := func(err error) {
+		stateMu.Lock()
+		defer stateMu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range parts {
+				output, err := s.do(ctx, func(s3Client *s3.Client) (interface{}, error) {
+					body := newTrackedReader(bytes.NewReader(p.data), tracker)
+					output, err := s3Client.UploadPart(ctx, &s3.UploadPartInput{
+						Bucket:        aws.String(state.Bucket),
+						Key:           aws.String(state.Key),
+						UploadId:      aws.String(state.UploadID),
+						PartNumber:    aws.Int32(p.number),
+						ContentLength: aws.Int64(int64(len(p.data))),
+						Body:          body,
+					})
+					if err != nil {
+						// The part is sent again in full, so its bytes no longer count as transferred.
+						tracker.add(-body.position)
+					}
+					return output, err
+				})
+				buffers <- p.data
+				if err != nil {
+					fail(fmt.Errorf("part %d: %v", p.number, err))
+					continue
+				}
+
+				stateMu.Lock()
+				state.Parts[p.number] = aws.ToString(output.(*s3.UploadPartOutput).ETag)
+				err = saveJCDS2UploadState(stateFilePath, state)
+				stateMu.Unlock()
+				if err != nil {
+					fail(err)
+				}
+			}
+		}()
+	}
+
+	for partNumber := int32(1); partNumber <= state.partCount() && ctx.Err() == nil; partNumber++ {
+		if uploaded[partNumber] && hasher == nil {
+			continue
+		}
+
+		var buffer []byte
+		select {
+		case buffer = <-buffers:
+		case <-ctx.Done():
+			continue
+		}
+
+		offset := int64(partNumber-1) * state.PartSize
+		length := state.partLength(partNumber)
+		if int64(cap(buffer)) < length {
+			buffer = make([]byte, length)
+		}
+		buffer = buffer[:length]
+		if _, err := io.ReadFull(io.NewSectionReader(reader, offset, length), buffer); err != nil {
+			fail(fmt.Errorf("part %d: failed to read package: %v", partNumber, err))
+			break
+		}
+		if hasher != nil {
+			hasher.Write(buffer)
+		}
+
+		if uploaded[partNumber] {
+			buffers <- buffer
+			continue
+		}
+		select {
+		case parts <- part{number: partNumber, data: buffer}:
+		case <-ctx.Done():
+		}
+	}
+	close(parts)
+	wg.Wait()
+
+	return firstErr
+}
+
+// abort discards the uploaded parts of a failed upload so they do not linger in the bucket.
+func (s *jcds2Session) abort(state *jcds2UploadState) {
+	_, _ = s.s3.AbortMultipartUpload(context.TODO(), &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(state.Bucket),
+		Key:      aws.String(state.Key),
+		UploadId: aws.String(state.UploadID),
+	})
+}
+
+// partCount returns the number of parts of the upload. An empty file is uploaded as a single empty part.
+func (st *jcds2UploadState) partCount() int32 {
+	if st.Size == 0 {
+		return 1
+	}
+	return int32((st.Size + st.PartSize - 1) / st.PartSize)
+}
+
+// partLength returns the size of a part; the last part holds the remainder.
+func (st *jcds2UploadState) partLength(partNumber int32) int64 {
+	offset := int64(partNumber-1) * st.PartSize
+	if remaining := st.Size - offset; remaining < st.PartSize {
+		return remaining
+	}
+	return st.PartSize
+}
+
+// completedParts returns the uploaded parts in part number order for CompleteMultipartUpload.
+func (st *jcds2UploadState) completedParts() []types.CompletedPart {
+	parts := make([]types.CompletedPart, 0, len(st.Parts))
+	for partNumber, etag := range st.Parts {
+		parts = append(parts, types.CompletedPart{
+			PartNumber: aws.Int32(partNumber),
+			ETag:       aws.String(etag),
+		})
+	}
+	sort.Slice(parts, func(i, j int) bool {
+		return aws.ToInt32(parts[i].PartNumber) < aws.ToInt32(parts[j].PartNumber)
+	})
+	return parts
+}
+
+// jcds2UploadPartSize returns the part size to use for a file, keeping within the S3 multipart limits.
+func jcds2UploadPartSize(requested, size int64) int64 {
+	partSize := requested
+	if partSize <= 0 {
+		partSize = DefaultJCDS2UploadPartSize
+	}
+	if partSize < minJCDS2UploadPartSize {
+		partSize = minJCDS2UploadPartSize
+	}
+	if size/partSize >= maxJCDS2UploadParts {
+		partSize = size/maxJCDS2UploadParts + 1
+	}
+	return partSize
+}
+
+// loadJCDS2UploadState returns the recorded state of an earlier upload of the same file, or nil when there
+// is nothing to resume.
+func loadJCDS2UploadState(stateFilePath, fileName string, size, partSize int64) (*jcds2UploadState, error) {
+	if stateFilePath == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(stateFilePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload state file: %v", err)
+	}
+
+	var state jcds2UploadState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal upload state file: %v", err)
+	}
+	if state.FileName != fileName || state.Size != size || state.PartSize != partSize || state.UploadID == "" {
+		return nil, nil
+	}
+	if state.Parts == nil {
+		state.Parts = make(map[int32]string)
+	}
+
+	return &state, nil
+}
+
+// saveJCDS2UploadState writes the upload state atomically so an interruption never leaves a partial file.
+func saveJCDS2UploadState(stateFilePath string, state *jcds2UploadState) error {
+	if stateFilePath == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal upload state: %v", err)
+	}
+
+	tmpPath := stateFilePath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write upload state file: %v", err)
+	}
+	if err := os.Rename(tmpPath, stateFilePath); err != nil {
+		return fmt.Errorf("failed to write upload state file: %v", err)
+	}
+
+	return nil
+}
+
+// isJCDS2CredentialsExpired reports whether S3 rejected a request because the STS credentials expired.
+func isJCDS2CredentialsExpired(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.ErrorCode() {
+	case "ExpiredToken", "TokenRefreshRequired", "InvalidToken", "RequestExpired":
+		return true
+	}
+	return false
+}
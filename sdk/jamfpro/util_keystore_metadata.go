@@ -0,0 +1,116 @@
+// util_keystore_metadata.go
+// Local parsing of PKCS12 keystores, used to inspect certificate subject and expiry before a keystore
+// is uploaded to Jamf Pro (e.g. the SSO certificate) and to warn ahead of certificate expiry. Both legacy
+// keystores (3DES and RC2) and those written by OpenSSL 3 and current keytool releases (PBES2 with AES)
+// are supported.
+
+package jamfpro
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"time"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// KeystoreMetadata describes the leaf certificate held in a PKCS12 keystore.
+type KeystoreMetadata struct {
+	Subject            string    `json:"subject"`
+	Issuer             string    `json:"issuer"`
+	SerialNumber       string    `json:"serialNumber"`
+	NotBefore          time.Time `json:"notBefore"`
+	NotAfter           time.Time `json:"notAfter"`
+	CertificateCount   int       `json:"certificateCount"`
+	HasPrivateKey      bool      `json:"hasPrivateKey"`
+	SignatureAlgorithm string    `json:"signatureAlgorithm"`
+}
+
+// ExpiresWithin reports whether the certificate expires within the given window from now.
+func (m *KeystoreMetadata) ExpiresWithin(window time.Duration) bool {
+	return time.Until(m.NotAfter) <= window
+}
+
+// ReadPKCS12KeystoreMetadata reads a PKCS12 keystore file and returns the metadata of its leaf certificate.
+func ReadPKCS12KeystoreMetadata(keystorePath, password string) (*KeystoreMetadata, error) {
+	data, err := os.ReadFile(keystorePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore %s, error: %v", keystorePath, err)
+	}
+	return ParsePKCS12KeystoreMetadata(data, password)
+}
+
+// ParsePKCS12KeystoreMetadata decodes a PKCS12 keystore with the given password and returns the metadata of
+// its leaf certificate. When the keystore contains a chain, the leaf is the first certificate that is not a CA.
+// Keystores using an algorithm that cannot be decoded locally return an error wrapping
+// pkcs12.NotImplementedError.
+func ParsePKCS12KeystoreMetadata(data []byte, password string) (*KeystoreMetadata, error) {
+	blocks, err := pkcs12.ToPEM(data, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode pkcs12 keystore, error: %w", err)
+	}
+
+	var certificates []*x509.Certificate
+	hasPrivateKey := false
+	for _, block := range blocks {
+		switch block.Type {
+		case "CERTIFICATE":
+			certificate, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse keystore certificate, error: %v", err)
+			}
+			certificates = append(certificates, certificate)
+		case "PRIVATE KEY", "RSA PRIVATE KEY", "EC PRIVATE KEY":
+			hasPrivateKey = true
+		}
+	}
+
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("pkcs12 keystore contains no certificates")
+	}
+
+	leaf := certificates[0]
+	for _, certificate := range certificates {
+		if !certificate.IsCA {
+			leaf = certificate
+			break
+		}
+	}
+
+	return &KeystoreMetadata{
+		Subject:            leaf.Subject.String(),
+		Issuer:             leaf.Issuer.String(),
+		SerialNumber:       leaf.SerialNumber.String(),
+		NotBefore:          leaf.NotBefore,
+		NotAfter:           leaf.NotAfter,
+		CertificateCount:   len(certificates),
+		HasPrivateKey:      hasPrivateKey,
+		SignatureAlgorithm: leaf.SignatureAlgorithm.String(),
+	}, nil
+}
+
+// ParsePEMCertificateMetadata returns the metadata of the first certificate in PEM encoded data,
+// e.g. a certificate downloaded from Jamf Pro.
+func ParsePEMCertificateMetadata(data []byte) (*KeystoreMetadata, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no pem encoded certificate found")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate, error: %v", err)
+	}
+
+	return &KeystoreMetadata{
+		Subject:            certificate.Subject.String(),
+		Issuer:             certificate.Issuer.String(),
+		SerialNumber:       certificate.SerialNumber.String(),
+		NotBefore:          certificate.NotBefore,
+		NotAfter:           certificate.NotAfter,
+		CertificateCount:   1,
+		SignatureAlgorithm: certificate.SignatureAlgorithm.String(),
+	}, nil
+}
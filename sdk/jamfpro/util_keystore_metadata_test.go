@@ -0,0 +1,258 @@
+package jamfpro
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// newTestCertificate creates a certificate for subject, signed by parent or self-signed when parent is nil.
+func newTestCertificate(t *testing.T, subject string, isCA bool, notAfter time.Time, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: subject},
+		NotBefore:             notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return certificate, key
+}
+
+func encodeTestKeystore(t *testing.T, encoder *pkcs12.Encoder, key *ecdsa.PrivateKey, certificate *x509.Certificate, caCerts []*x509.Certificate, password string) []byte {
+	t.Helper()
+
+	data, err := encoder.Encode(key, certificate, caCerts, password)
+	if err != nil {
+		t.Fatalf("failed to encode keystore: %v", err)
+	}
+	return data
+}
+
+// testPFX mirrors the PKCS12 PFX structure, enough to recombine the safes of two keystores.
+type testPFX struct {
+	Version  int
+	AuthSafe testContentInfo
+	MacData  testMacData `asn1:"optional"`
+}
+
+type testContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"tag:0,explicit,optional"`
+}
+
+type testMacData struct {
+	Mac struct {
+		Algorithm pkix.AlgorithmIdentifier
+		Digest    []byte
+	}
+	MacSalt    []byte
+	Iterations int `asn1:"optional,default:1"`
+}
+
+// encodeTestKeystoreWithKeyPassword encodes a keystore whose private key is encrypted with keyPassword while
+// the certificates and integrity check use storePassword, as keytool and some PKI exports produce. The
+// certificate safe of one keystore and the key safe of another are combined and the MAC is recomputed.
+func encodeTestKeystoreWithKeyPassword(t *testing.T, key *ecdsa.PrivateKey, certificate *x509.Certificate, storePassword, keyPassword string) []byte {
+	t.Helper()
+
+	var store, keyed testPFX
+	for pfx, password := range map[*testPFX]string{&store: storePassword, &keyed: keyPassword} {
+		if _, err := asn1.Unmarshal(encodeTestKeystore(t, pkcs12.Modern, key, certificate, nil, password), pfx); err != nil {
+			t.Fatalf("failed to decode keystore: %v", err)
+		}
+	}
+
+	safes, err := asn1.Marshal([]testContentInfo{testAuthenticatedSafe(t, store)[0], testAuthenticatedSafe(t, keyed)[1]})
+	if err != nil {
+		t.Fatalf("failed to encode authenticated safe: %v", err)
+	}
+
+	store.MacData.Mac.Digest = testPKCS12MAC(safes, store.MacData.MacSalt, store.MacData.Iterations, storePassword)
+	store.AuthSafe.Content = asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true}
+	if store.AuthSafe.Content.Bytes, err = asn1.Marshal(safes); err != nil {
+		t.Fatalf("failed to encode authenticated safe: %v", err)
+	}
+
+	data, err := asn1.Marshal(store)
+	if err != nil {
+		t.Fatalf("failed to encode keystore: %v", err)
+	}
+	return data
+}
+
+func testAuthenticatedSafe(t *testing.T, pfx testPFX) []testContentInfo {
+	t.Helper()
+
+	var content []byte
+	if _, err := asn1.Unmarshal(pfx.AuthSafe.Content.Bytes, &content); err != nil {
+		t.Fatalf("failed to decode authenticated safe: %v", err)
+	}
+	var safes []testContentInfo
+	if _, err := asn1.Unmarshal(content, &safes); err != nil {
+		t.Fatalf("failed to decode authenticated safe: %v", err)
+	}
+	return safes
+}
+
+// testPKCS12MAC computes the HMAC-SHA-256 integrity check of a keystore, keyed with the PKCS12 key derivation
+// of RFC 7292 appendix B.2. A single SHA-256 block gives the 32 byte key, so only its first round is needed.
+func testPKCS12MAC(message, salt []byte, iterations int, password string) []byte {
+	const blockSize = 64
+	repeat := func(pattern []byte) []byte {
+		out := make([]byte, blockSize*((len(pattern)+blockSize-1)/blockSize))
+		for i := range out {
+			out[i] = pattern[i%len(pattern)]
+		}
+		return out
+	}
+
+	var bmpPassword []byte
+	for _, r := range utf16.Encode([]rune(password)) {
+		bmpPassword = append(bmpPassword, byte(r>>8), byte(r))
+	}
+	bmpPassword = append(bmpPassword, 0, 0)
+
+	input := make([]byte, blockSize)
+	for i := range input {
+		input[i] = 3
+	}
+	input = append(append(input, repeat(salt)...), repeat(bmpPassword)...)
+
+	sum := sha256.Sum256(input)
+	for i := 1; i < iterations; i++ {
+		sum = sha256.Sum256(sum[:])
+	}
+
+	mac := hmac.New(sha256.New, sum[:])
+	mac.Write(message)
+	return mac.Sum(nil)
+}
+
+func writeTestKeystore(t *testing.T, data []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "keystore.p12")
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatalf("failed to write keystore: %v", err)
+	}
+	return path
+}
+
+func TestParsePKCS12KeystoreMetadata(t *testing.T) {
+	notAfter := time.Now().Add(90 * 24 * time.Hour).Truncate(time.Second).UTC()
+	ca, caKey := newTestCertificate(t, "Test CA", true, notAfter.Add(time.Hour), nil, nil)
+	leaf, leafKey := newTestCertificate(t, "sso.example.com", false, notAfter, ca, caKey)
+
+	tests := []struct {
+		name             string
+		data             []byte
+		password         string
+		wantSubject      string
+		wantCertificates int
+		wantErr          bool
+		wantPasswordErr  bool
+	}{
+		{
+			name:             "modern keystore",
+			data:             encodeTestKeystore(t, pkcs12.Modern, leafKey, leaf, nil, "secret"),
+			password:         "secret",
+			wantSubject:      "CN=sso.example.com",
+			wantCertificates: 1,
+		},
+		{
+			name:             "legacy keystore",
+			data:             encodeTestKeystore(t, pkcs12.LegacyRC2, leafKey, leaf, nil, "secret"),
+			password:         "secret",
+			wantSubject:      "CN=sso.example.com",
+			wantCertificates: 1,
+		},
+		{
+			name:             "chain selects the leaf",
+			data:             encodeTestKeystore(t, pkcs12.Modern, leafKey, leaf, []*x509.Certificate{ca}, "secret"),
+			password:         "secret",
+			wantSubject:      "CN=sso.example.com",
+			wantCertificates: 2,
+		},
+		{
+			name:            "wrong password",
+			data:            encodeTestKeystore(t, pkcs12.Modern, leafKey, leaf, nil, "secret"),
+			password:        "wrong",
+			wantErr:         true,
+			wantPasswordErr: true,
+		},
+		{
+			name:     "not a keystore",
+			data:     []byte("not a keystore"),
+			password: "secret",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata, err := ParsePKCS12KeystoreMetadata(tt.data, tt.password)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got metadata %+v", metadata)
+				}
+				if tt.wantPasswordErr && !errors.Is(err, pkcs12.ErrIncorrectPassword) {
+					t.Fatalf("expected an incorrect password error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if metadata.Subject != tt.wantSubject {
+				t.Errorf("subject = %q, want %q", metadata.Subject, tt.wantSubject)
+			}
+			if metadata.CertificateCount != tt.wantCertificates {
+				t.Errorf("certificate count = %d, want %d", metadata.CertificateCount, tt.wantCertificates)
+			}
+			if !metadata.NotAfter.Equal(notAfter) {
+				t.Errorf("not after = %v, want %v", metadata.NotAfter, notAfter)
+			}
+			if !metadata.HasPrivateKey {
+				t.Error("expected the keystore to report a private key")
+			}
+			if metadata.ExpiresWithin(30*24*time.Hour) || !metadata.ExpiresWithin(100*24*time.Hour) {
+				t.Errorf("unexpected expiry window for %v", metadata.NotAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// util_package_checksums.go
+// Package integrity for JCDS 2.0 uploads. Checksums are computed by streaming the package once, so
+// multi-GB packages are never held in memory. The MD5 is matched against the JCDS 2.0 inventory to skip
+// uploading content that is already there, and the SHA-512 is recorded on the Jamf Pro package so
+// VerifyPackage can later check the stored object against it.
+
+package jamfpro
+
+import (
+	"crypto/md5"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+)
+
+// Package hash types as stored on a Jamf Pro package.
+const (
+	PackageHashTypeMD5    = "MD5"
+	PackageHashTypeSHA512 = "SHA_512"
+)
+
+// PackageChecksums holds the hashes of a package file as lowercase hex.
+type PackageChecksums struct {
+	MD5    string `json:"md5"`
+	SHA512 string `json:"sha512"`
+	Size   int64  `json:"size"`
+}
+
+// PackageVerification is the outcome of VerifyPackage.
+type PackageVerification struct {
+	PackageID int    `json:"packageId"`
+	FileName  string `json:"fileName"`
+	HashType  string `json:"hashType"`
+	Expected  string `json:"expected"`
+	Actual    string `json:"actual"`
+	Size      int64  `json:"size"`
+	Valid     bool   `json:"valid"`
+}
+
+// ComputePackageChecksums reads r to the end and returns its MD5 and SHA-512 checksums.
+func ComputePackageChecksums(r io.Reader) (*PackageChecksums, error) {
+	hasher := newPackageHasher()
+	if _, err := io.Copy(hasher, r); err != nil {
+		return nil, fmt.Errorf("failed to compute package checksums: %v", err)
+	}
+
+	return hasher.checksums(), nil
+}
+
+// packageHasher computes the checksums of the bytes written to it.
+type packageHasher struct {
+	md5    hash.Hash
+	sha512 hash.Hash
+	size   int64
+}
+
+func newPackageHasher() *packageHasher {
+	return &packageHasher{md5: md5.New(), sha512: sha512.New()}
+}
+
+// Write implements the io.Writer interface.
+func (h *packageHasher) Write(p []byte) (int, error) {
+	h.md5.Write(p)
+	h.sha512.Write(p)
+	h.size += int64(len(p))
+	return len(p), nil
+}
+
+// checksums returns the checksums of the bytes written so far.
+func (h *packageHasher) checksums() *PackageChecksums {
+	return &PackageChecksums{
+		MD5:    hex.EncodeToString(h.md5.Sum(nil)),
+		SHA512: hex.EncodeToString(h.sha512.Sum(nil)),
+		Size:   h.size,
+	}
+}
+
+// Matches reports whether a JCDS 2.0 file has the same content as the checksummed package.
+func (p *PackageChecksums) Matches(file ResponseJCDS2List) bool {
+	return strings.EqualFold(file.MD5, p.MD5) && file.Length == p.Size
+}
+
+// FindJCDS2PackageByChecksums returns the JCDS 2.0 file with the same content as the checksummed package, or
+// nil when there is none. A file with the preferred name is returned over other files with the same content.
+func (c *Client) FindJCDS2PackageByChecksums(checksums *PackageChecksums, preferredFileName string) (*ResponseJCDS2List, error) {
+	files, err := c.GetJCDS2Packages()
+	if err != nil {
+		return nil, err
+	}
+
+	return matchJCDS2Package(files, checksums, preferredFileName), nil
+}
+
+// matchJCDS2Package returns the file with the same content as the checksummed package, preferring a file with
+// the preferred name, or nil when there is none.
+func matchJCDS2Package(files []ResponseJCDS2List, checksums *PackageChecksums, preferredFileName string) *ResponseJCDS2List {
+	var match *ResponseJCDS2List
+	for i := range files {
+		if !checksums.Matches(files[i]) {
+			continue
+		}
+		if files[i].FileName == preferredFileName {
+			return &files[i]
+		}
+		if match == nil {
+			match = &files[i]
+		}
+	}
+	return match
+}
+
+// VerifyPackage streams the JCDS 2.0 object of a Jamf Pro package and checks it against the hash recorded on
+// the package. Packages without a recorded hash are checked against the MD5 reported by JCDS 2.0. Broken
+// connections are continued with ranged requests, as for DownloadJCDS2Package.
+func (c *Client) VerifyPackage(id int) (*PackageVerification, error) {
+	pkg, err := c.GetPackageByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if pkg.Filename == "" {
+		return nil, fmt.Errorf("package %d has no file name", id)
+	}
+
+	file, err := c.getJCDS2File(pkg.Filename)
+	if err != nil {
+		return nil, err
+	}
+
+	verification := &PackageVerification{
+		PackageID: id,
+		FileName:  pkg.Filename,
+		HashType:  pkg.HashType,
+		Expected:  strings.ToLower(pkg.HashValue),
+	}
+	if verification.Expected == "" {
+		if file.MD5 == "" {
+			return nil, fmt.Errorf("package %d has no recorded hash and JCDS 2.0 reports no MD5 for %s", id, pkg.Filename)
+		}
+		verification.HashType = PackageHashTypeMD5
+		verification.Expected = strings.ToLower(file.MD5)
+	}
+
+	hasher := newPackageHasher()
+	if err := c.downloadJCDS2Range(file, hasher, 0); err != nil {
+		return nil, err
+	}
+	checksums := hasher.checksums()
+
+	verification.Size = checksums.Size
+	switch strings.ToUpper(verification.HashType) {
+	case PackageHashTypeMD5:
+		verification.Actual = checksums.MD5
+	case PackageHashTypeSHA512:
+		verification.Actual = checksums.SHA512
+	default:
+		return nil, fmt.Errorf("package %d has unsupported hash type %s", id, verification.HashType)
+	}
+	verification.Valid = verification.Actual == verification.Expected
+
+	return verification, nil
+}
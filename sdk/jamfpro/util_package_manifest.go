@@ -0,0 +1,187 @@
+// util_package_manifest.go
+// Package metadata from a sidecar manifest. A YAML or JSON file next to a package, e.g. Firefox.pkg.yaml or
+// Firefox.yaml for Firefox.pkg, sets the fields of the Jamf Pro package created for it, using the same field
+// names as the Classic API. Fields the manifest leaves out are taken from the defaults given in code and,
+// for the name and notes, from the Distribution and PackageInfo of the flat package.
+// DoPackageUploadWithManifest reads it on upload; DoPackageUpload and DoPackageUploadWithOptions use the
+// package data as given.
+
+package jamfpro
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// packageManifestExtensions are the sidecar manifest extensions, in the order they are looked for.
+var packageManifestExtensions = []string{".yaml", ".yml", ".json"}
+
+// PackageManifest is the package metadata read from a sidecar manifest. Unset fields leave the package
+// unchanged.
+type PackageManifest struct {
+	Name                       *string `yaml:"name" json:"name"`
+	Category                   *string `yaml:"category" json:"category"`
+	Info                       *string `yaml:"info" json:"info"`
+	Notes                      *string `yaml:"notes" json:"notes"`
+	Priority                   *int    `yaml:"priority" json:"priority"`
+	RebootRequired             *bool   `yaml:"reboot_required" json:"reboot_required"`
+	FillUserTemplate           *bool   `yaml:"fill_user_template" json:"fill_user_template"`
+	FillExistingUsers          *bool   `yaml:"fill_existing_users" json:"fill_existing_users"`
+	BootVolumeRequired         *bool   `yaml:"boot_volume_required" json:"boot_volume_required"`
+	AllowUninstalled           *bool   `yaml:"allow_uninstalled" json:"allow_uninstalled"`
+	OSRequirements             *string `yaml:"os_requirements" json:"os_requirements"`
+	RequiredProcessor          *string `yaml:"required_processor" json:"required_processor"`
+	SwitchWithPackage          *string `yaml:"switch_with_package" json:"switch_with_package"`
+	InstallIfReportedAvailable *bool   `yaml:"install_if_reported_available" json:"install_if_reported_available"`
+	ReinstallOption            *string `yaml:"reinstall_option" json:"reinstall_option"`
+	TriggeringFiles            *string `yaml:"triggering_files" json:"triggering_files"`
+	SendNotification           *bool   `yaml:"send_notification" json:"send_notification"`
+}
+
+// FindPackageManifest returns the path of the sidecar manifest of a package, or an empty string when there
+// is none. <package>.pkg.yaml is preferred over <package>.yaml, and YAML over JSON.
+func FindPackageManifest(packagePath string) (string, error) {
+	bases := []string{packagePath}
+	if ext := filepath.Ext(packagePath); ext != "" {
+		bases = append(bases, strings.TrimSuffix(packagePath, ext))
+	}
+
+	for _, base := range bases {
+		for _, ext := range packageManifestExtensions {
+			candidate := base + ext
+			info, err := os.Stat(candidate)
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			if err != nil {
+				return "", fmt.Errorf("failed to read package manifest %s: %v", candidate, err)
+			}
+			if !info.IsDir() {
+				return candidate, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// LoadPackageManifest reads a YAML or JSON package manifest. Unknown fields are rejected so that misspelt
+// fields are not silently ignored.
+func LoadPackageManifest(manifestPath string) (*PackageManifest, error) {
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read package manifest %s: %v", manifestPath, err)
+	}
+
+	manifest := &PackageManifest{}
+	if strings.EqualFold(filepath.Ext(manifestPath), ".json") {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(manifest)
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(manifest)
+		// An empty manifest sets nothing
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse package manifest %s: %v", manifestPath, err)
+	}
+
+	return manifest, nil
+}
+
+// Apply sets the fields of the package that the manifest sets.
+func (m *PackageManifest) Apply(pkg *ResourcePackage) {
+	setString := func(target *string, value *string) {
+		if value != nil {
+			*target = *value
+		}
+	}
+	setBool := func(target *bool, value *bool) {
+		if value != nil {
+			*target = *value
+		}
+	}
+
+	setString(&pkg.Name, m.Name)
+	setString(&pkg.Category, m.Category)
+	setString(&pkg.Info, m.Info)
+	setString(&pkg.Notes, m.Notes)
+	if m.Priority != nil {
+		pkg.Priority = *m.Priority
+	}
+	setBool(&pkg.RebootRequired, m.RebootRequired)
+	setBool(&pkg.FillUserTemplate, m.FillUserTemplate)
+	setBool(&pkg.FillExistingUsers, m.FillExistingUsers)
+	setBool(&pkg.BootVolumeRequired, m.BootVolumeRequired)
+	setBool(&pkg.AllowUninstalled, m.AllowUninstalled)
+	setString(&pkg.OSRequirements, m.OSRequirements)
+	setString(&pkg.RequiredProcessor, m.RequiredProcessor)
+	setString(&pkg.SwitchWithPackage, m.SwitchWithPackage)
+	setBool(&pkg.InstallIfReportedAvailable, m.InstallIfReportedAvailable)
+	setString(&pkg.ReinstallOption, m.ReinstallOption)
+	setString(&pkg.TriggeringFiles, m.TriggeringFiles)
+	setBool(&pkg.SendNotification, m.SendNotification)
+}
+
+// BuildPackageMetadata returns the package metadata for a package file. It starts from defaults, which may
+// be nil, fills an empty name and notes from the flat package's Distribution and PackageInfo, and then
+// applies the sidecar manifest when there is one. A name that is still empty is set to the file name.
+// Packages that are not flat packages, such as disk images, only use the defaults and manifest.
+func BuildPackageMetadata(filePath string, defaults *ResourcePackage) (*ResourcePackage, error) {
+	pkg := &ResourcePackage{}
+	if defaults != nil {
+		*pkg = *defaults
+	}
+
+	if info, err := ReadFlatPackageInfo(filePath); err == nil {
+		if pkg.Name == "" && info.Title != "" {
+			pkg.Name = strings.TrimSpace(info.Title + " " + info.Version)
+		}
+		if pkg.Notes == "" {
+			pkg.Notes = flatPackageNotes(info)
+		}
+	}
+
+	manifestPath, err := FindPackageManifest(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if manifestPath != "" {
+		manifest, err := LoadPackageManifest(manifestPath)
+		if err != nil {
+			return nil, err
+		}
+		manifest.Apply(pkg)
+	}
+
+	if pkg.Name == "" {
+		pkg.Name = filepath.Base(filePath)
+	}
+
+	return pkg, nil
+}
+
+// flatPackageNotes describes a flat package for the notes of its Jamf Pro package.
+func flatPackageNotes(info *FlatPackageInfo) string {
+	lines := []string{
+		"Bundle ID: " + info.Identifier,
+		"Version: " + info.Version,
+	}
+	if info.MinimumOSVersion != "" {
+		lines = append(lines, "Minimum macOS: "+info.MinimumOSVersion)
+	}
+	return strings.Join(lines, "\n")
+}
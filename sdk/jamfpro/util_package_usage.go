@@ -0,0 +1,356 @@
+// util_package_usage.go
+// Package usage and orphan analysis. AnalyzePackageUsage cross-references the packages of a tenant with the
+// policies, patch software title configurations and computer prestages that install them, and with the
+// files in JCDS 2.0. The report shows which packages are safe to delete, which packages have lost their file
+// and which JCDS 2.0 files no package refers to.
+
+package jamfpro
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Package reference types.
+const (
+	PackageReferencePolicy     = "policy"
+	PackageReferencePatchTitle = "patch_title"
+	PackageReferencePrestage   = "computer_prestage"
+)
+
+// DefaultPackageUsageConcurrency is the number of packages or policies read at once by AnalyzePackageUsage.
+const DefaultPackageUsageConcurrency = 8
+
+// PackageUsageOptions configures AnalyzePackageUsage.
+type PackageUsageOptions struct {
+	// Concurrency is the number of packages or policies read at once. Zero uses
+	// DefaultPackageUsageConcurrency.
+	Concurrency int
+	// SkipJCDS leaves out the JCDS 2.0 checks, for tenants that do not distribute packages with JCDS 2.0.
+	SkipJCDS bool
+}
+
+// PackageUsageReport is the result of AnalyzePackageUsage.
+type PackageUsageReport struct {
+	GeneratedAt time.Time           `json:"generatedAt"`
+	JCDSChecked bool                `json:"jcdsChecked"`
+	Summary     PackageUsageSummary `json:"summary"`
+	Packages    []PackageUsage      `json:"packages"`
+	// UnresolvedReferences are references to package IDs that do not exist in Jamf Pro.
+	UnresolvedReferences []PackageReference `json:"unresolvedReferences"`
+	// OrphanedJCDSFiles are JCDS 2.0 files that no package refers to.
+	OrphanedJCDSFiles []ResponseJCDS2List `json:"orphanedJcdsFiles"`
+}
+
+// PackageUsageSummary counts the findings of the report.
+type PackageUsageSummary struct {
+	Packages             int `json:"packages"`
+	Unused               int `json:"unused"`
+	MissingFromJCDS      int `json:"missingFromJcds"`
+	UnresolvedReferences int `json:"unresolvedReferences"`
+	OrphanedJCDSFiles    int `json:"orphanedJcdsFiles"`
+}
+
+// PackageUsage is a package and everything that refers to it.
+type PackageUsage struct {
+	ID         int                `json:"id"`
+	Name       string             `json:"name"`
+	FileName   string             `json:"fileName"`
+	Category   string             `json:"category,omitempty"`
+	References []PackageReference `json:"references"`
+	// InJCDS is only meaningful when the report's JCDSChecked is set.
+	InJCDS bool `json:"inJcds"`
+}
+
+// PackageReference is a policy, patch software title configuration or prestage that refers to a package.
+type PackageReference struct {
+	PackageID int    `json:"packageId"`
+	Type      string `json:"type"`
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	// Detail is the policy package action or the patch version.
+	Detail string `json:"detail,omitempty"`
+}
+
+// Used reports whether anything refers to the package.
+func (u PackageUsage) Used() bool {
+	return len(u.References) > 0
+}
+
+// Unused returns the packages that no policy, patch software title configuration or prestage refers to.
+func (r *PackageUsageReport) Unused() []PackageUsage {
+	var unused []PackageUsage
+	for _, pkg := range r.Packages {
+		if !pkg.Used() {
+			unused = append(unused, pkg)
+		}
+	}
+	return unused
+}
+
+// MissingFromJCDS returns the packages whose file is not in JCDS 2.0. It is empty when JCDS 2.0 was not
+// checked.
+func (r *PackageUsageReport) MissingFromJCDS() []PackageUsage {
+	if !r.JCDSChecked {
+		return nil
+	}
+	var missing []PackageUsage
+	for _, pkg := range r.Packages {
+		if !pkg.InJCDS {
+			missing = append(missing, pkg)
+		}
+	}
+	return missing
+}
+
+// AnalyzePackageUsage reads every package, policy, patch software title configuration and computer prestage
+// of the tenant, and the JCDS 2.0 file list, and reports how the packages are used. Any read that fails
+// aborts the analysis, as a partial report could mark packages in use as unused.
+func (c *Client) AnalyzePackageUsage(options *PackageUsageOptions) (*PackageUsageReport, error) {
+	if options == nil {
+		options = &PackageUsageOptions{}
+	}
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultPackageUsageConcurrency
+	}
+
+	report := &PackageUsageReport{GeneratedAt: time.Now().UTC(), JCDSChecked: !options.SkipJCDS}
+
+	// Packages, with the file name that only the full record carries
+	packageList, err := c.GetPackages()
+	if err != nil {
+		return nil, err
+	}
+	report.Packages = make([]PackageUsage, len(packageList.Package))
+	err = forEachConcurrently(len(packageList.Package), concurrency, func(i int) error {
+		pkg, err := c.GetPackageByID(packageList.Package[i].ID)
+		if err != nil {
+			return err
+		}
+		report.Packages[i] = PackageUsage{ID: pkg.ID, Name: pkg.Name, FileName: pkg.Filename, Category: pkg.Category}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*PackageUsage, len(report.Packages))
+	byName := make(map[string]*PackageUsage, len(report.Packages))
+	for i := range report.Packages {
+		byID[report.Packages[i].ID] = &report.Packages[i]
+		byName[report.Packages[i].Name] = &report.Packages[i]
+	}
+	addReference := func(ref PackageReference) {
+		if pkg, ok := byID[ref.PackageID]; ok {
+			pkg.References = append(pkg.References, ref)
+			return
+		}
+		report.UnresolvedReferences = append(report.UnresolvedReferences, ref)
+	}
+
+	// Policies
+	policyList, err := c.GetPolicies()
+	if err != nil {
+		return nil, err
+	}
+	policies := make([]*ResourcePolicy, len(policyList.Policy))
+	err = forEachConcurrently(len(policyList.Policy), concurrency, func(i int) error {
+		policy, err := c.GetPolicyByID(policyList.Policy[i].ID)
+		if err != nil {
+			return err
+		}
+		policies[i] = policy
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, policy := range policies {
+		for _, policyPackage := range policy.PackageConfiguration.Packages {
+			packageID := policyPackage.ID
+			// Policies created through the API may name a package without its ID
+			if pkg, ok := byName[policyPackage.Name]; packageID == 0 && ok {
+				packageID = pkg.ID
+			}
+			addReference(PackageReference{
+				PackageID: packageID,
+				Type:      PackageReferencePolicy,
+				ID:        strconv.Itoa(policy.General.ID),
+				Name:      policy.General.Name,
+				Detail:    policyPackage.Action,
+			})
+		}
+	}
+
+	// Patch software title configurations
+	patchTitles, err := c.GetPatchSoftwareTitleConfigurations()
+	if err != nil {
+		return nil, err
+	}
+	for _, title := range patchTitles.Results {
+		for _, patchPackage := range title.Packages {
+			packageID, err := strconv.Atoi(patchPackage.PackageId)
+			if err != nil {
+				return nil, fmt.Errorf("patch software title configuration %s refers to invalid package ID %s", title.ID, patchPackage.PackageId)
+			}
+			addReference(PackageReference{
+				PackageID: packageID,
+				Type:      PackageReferencePatchTitle,
+				ID:        title.ID,
+				Name:      title.DisplayName,
+				Detail:    "version " + patchPackage.Version,
+			})
+		}
+	}
+
+	// Computer prestages
+	prestages, err := c.GetComputerPrestages("")
+	if err != nil {
+		return nil, err
+	}
+	for _, prestage := range prestages.Results {
+		for _, id := range prestage.CustomPackageIds {
+			packageID, err := strconv.Atoi(id)
+			if err != nil {
+				return nil, fmt.Errorf("computer prestage %s refers to invalid package ID %s", prestage.ID, id)
+			}
+			addReference(PackageReference{
+				PackageID: packageID,
+				Type:      PackageReferencePrestage,
+				ID:        prestage.ID,
+				Name:      prestage.DisplayName,
+			})
+		}
+	}
+
+	// JCDS 2.0 files
+	if report.JCDSChecked {
+		files, err := c.GetJCDS2Packages()
+		if err != nil {
+			return nil, err
+		}
+		inJCDS := make(map[string]bool, len(files))
+		for _, file := range files {
+			inJCDS[file.FileName] = true
+		}
+		referenced := make(map[string]bool, len(report.Packages))
+		for i := range report.Packages {
+			report.Packages[i].InJCDS = inJCDS[report.Packages[i].FileName]
+			referenced[report.Packages[i].FileName] = true
+		}
+		for _, file := range files {
+			if !referenced[file.FileName] {
+				report.OrphanedJCDSFiles = append(report.OrphanedJCDSFiles, file)
+			}
+		}
+		sort.Slice(report.OrphanedJCDSFiles, func(i, j int) bool {
+			return report.OrphanedJCDSFiles[i].FileName < report.OrphanedJCDSFiles[j].FileName
+		})
+	}
+
+	sort.Slice(report.Packages, func(i, j int) bool {
+		return strings.ToLower(report.Packages[i].Name) < strings.ToLower(report.Packages[j].Name)
+	})
+	report.Summary = PackageUsageSummary{
+		Packages:             len(report.Packages),
+		Unused:               len(report.Unused()),
+		MissingFromJCDS:      len(report.MissingFromJCDS()),
+		UnresolvedReferences: len(report.UnresolvedReferences),
+		OrphanedJCDSFiles:    len(report.OrphanedJCDSFiles),
+	}
+
+	return report, nil
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *PackageUsageReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "    ")
+	if err := encoder.Encode(r); err != nil {
+		return fmt.Errorf(errMsgFailedJsonMarshal, "package usage report", err)
+	}
+	return nil
+}
+
+// WriteText writes a human readable summary of the report, listing unused packages, packages missing from
+// JCDS 2.0, unresolved references and orphaned JCDS 2.0 files.
+func (r *PackageUsageReport) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Jamf Pro package usage (%s)\n", r.GeneratedAt.Format(time.RFC3339))
+	fmt.Fprintf(tw, "Packages:\t%d\n", r.Summary.Packages)
+	fmt.Fprintf(tw, "Unused:\t%d\n", r.Summary.Unused)
+	if r.JCDSChecked {
+		fmt.Fprintf(tw, "Missing from JCDS 2.0:\t%d\n", r.Summary.MissingFromJCDS)
+		fmt.Fprintf(tw, "Orphaned JCDS 2.0 files:\t%d\n", r.Summary.OrphanedJCDSFiles)
+	}
+	fmt.Fprintf(tw, "Unresolved references:\t%d\n", r.Summary.UnresolvedReferences)
+
+	if unused := r.Unused(); len(unused) > 0 {
+		fmt.Fprintln(tw, "\nUnused packages:")
+		for _, pkg := range unused {
+			fmt.Fprintf(tw, "  %d\t%s\t%s\n", pkg.ID, pkg.Name, pkg.FileName)
+		}
+	}
+	if missing := r.MissingFromJCDS(); len(missing) > 0 {
+		fmt.Fprintln(tw, "\nPackages missing from JCDS 2.0:")
+		for _, pkg := range missing {
+			fmt.Fprintf(tw, "  %d\t%s\t%s\t%d references\n", pkg.ID, pkg.Name, pkg.FileName, len(pkg.References))
+		}
+	}
+	if len(r.UnresolvedReferences) > 0 {
+		fmt.Fprintln(tw, "\nReferences to packages that do not exist:")
+		for _, ref := range r.UnresolvedReferences {
+			fmt.Fprintf(tw, "  package %d\t%s %s\t%s\n", ref.PackageID, ref.Type, ref.ID, ref.Name)
+		}
+	}
+	if len(r.OrphanedJCDSFiles) > 0 {
+		fmt.Fprintln(tw, "\nJCDS 2.0 files without a package:")
+		for _, file := range r.OrphanedJCDSFiles {
+			fmt.Fprintf(tw, "  %s\t%s\n", file.FileName, formatBytes(file.Length))
+		}
+	}
+	return tw.Flush()
+}
+
+// String returns the human readable summary of the report.
+func (r *PackageUsageReport) String() string {
+	var b strings.Builder
+	_ = r.WriteText(&b)
+	return b.String()
+}
+
+// forEachConcurrently calls fn for the indexes 0 to n-1, running up to concurrency calls at once. It returns
+// the first error once all calls have finished.
+func forEachConcurrently(n, concurrency int, fn func(i int) error) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	semaphore := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			if err := fn(i); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	return firstErr
+}
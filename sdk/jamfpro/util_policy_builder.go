@@ -0,0 +1,451 @@
+// util_policy_builder.go
+// Builder for ResourcePolicy that resolves packages, scripts, computer groups and categories by name,
+// and validates trigger, frequency, date limitation, script parameter and reboot settings locally
+// before the policy is sent to Jamf Pro with CreatePolicy or UpdatePolicyByID.
+
+/*
+Example:
+
+	policy, err := client.NewPolicyBuilder("Install Google Chrome").
+		Category("Browsers").
+		Triggers(PolicyTriggerCheckin).
+		CustomTrigger("install-chrome").
+		Frequency(PolicyFrequencyOncePerComputer).
+		InstallPackage("GoogleChrome-124.pkg").
+		AddScript("Remove Quarantine", PolicyScriptPriorityAfter, "/Applications/Google Chrome.app").
+		ScopeComputerGroups("All Managed Clients").
+		Build()
+*/
+
+package jamfpro
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Policy triggers
+const (
+	PolicyTriggerCheckin            = "checkin"
+	PolicyTriggerEnrollmentComplete = "enrollment_complete"
+	PolicyTriggerLogin              = "login"
+	PolicyTriggerLogout             = "logout"
+	PolicyTriggerNetworkStateChange = "network_state_changed"
+	PolicyTriggerStartup            = "startup"
+)
+
+// Policy execution frequencies
+const (
+	PolicyFrequencyOncePerComputer        = "Once per computer"
+	PolicyFrequencyOncePerUserPerComputer = "Once per user per computer"
+	PolicyFrequencyOncePerUser            = "Once per user"
+	PolicyFrequencyOnceEveryDay           = "Once every day"
+	PolicyFrequencyOnceEveryWeek          = "Once every week"
+	PolicyFrequencyOnceEveryMonth         = "Once every month"
+	PolicyFrequencyOngoing                = "Ongoing"
+)
+
+// Policy package actions
+const (
+	PolicyPackageActionInstall       = "Install"
+	PolicyPackageActionCache         = "Cache"
+	PolicyPackageActionInstallCached = "Install Cached"
+	PolicyPackageActionUninstall     = "Uninstall"
+)
+
+// Policy script priorities
+const (
+	PolicyScriptPriorityBefore = "Before"
+	PolicyScriptPriorityAfter  = "After"
+)
+
+// Policy retry events
+const (
+	PolicyRetryEventNone    = "none"
+	PolicyRetryEventTrigger = "trigger"
+	PolicyRetryEventCheckin = "check-in"
+)
+
+// policyDateTimeLayout is the date format used by policy date and time limitations.
+const policyDateTimeLayout = "2006-01-02 15:04:05"
+
+// policyMaxScriptParameters is the number of script parameters (4 to 11) that can be passed from a policy.
+const policyMaxScriptParameters = 8
+
+// policyMaxRetryAttempts is the maximum number of retry attempts Jamf Pro accepts for a policy.
+const policyMaxRetryAttempts = 10
+
+var validPolicyFrequencies = map[string]bool{
+	PolicyFrequencyOncePerComputer:        true,
+	PolicyFrequencyOncePerUserPerComputer: true,
+	PolicyFrequencyOncePerUser:            true,
+	PolicyFrequencyOnceEveryDay:           true,
+	PolicyFrequencyOnceEveryWeek:          true,
+	PolicyFrequencyOnceEveryMonth:         true,
+	PolicyFrequencyOngoing:                true,
+}
+
+var validPolicyPackageActions = map[string]bool{
+	PolicyPackageActionInstall:       true,
+	PolicyPackageActionCache:         true,
+	PolicyPackageActionInstallCached: true,
+	PolicyPackageActionUninstall:     true,
+}
+
+var validPolicyRebootActions = map[string]bool{
+	"Restart immediately": true,
+	"Restart":             true,
+	"Do not restart":      true,
+	"Restart if a package or update requires it": true,
+}
+
+// PolicyBuilder assembles a ResourcePolicy. Names are recorded as the builder is used
+// and resolved to IDs against Jamf Pro when Build is called.
+type PolicyBuilder struct {
+	client         *Client
+	policy         ResourcePolicy
+	category       string
+	packages       []policyBuilderPackage
+	scripts        []policyBuilderScript
+	scopeGroups    []string
+	excludedGroups []string
+	errs           []string
+}
+
+type policyBuilderPackage struct {
+	name   string
+	action string
+}
+
+type policyBuilderScript struct {
+	name       string
+	priority   string
+	parameters []string
+}
+
+// NewPolicyBuilder starts a new enabled policy with the given name and a default frequency of once per computer.
+func (c *Client) NewPolicyBuilder(name string) *PolicyBuilder {
+	b := &PolicyBuilder{client: c}
+	b.policy.General.Name = name
+	b.policy.General.Enabled = true
+	b.policy.General.Frequency = PolicyFrequencyOncePerComputer
+	b.policy.General.Site = SharedResourceSite{ID: -1, Name: "None"}
+	return b
+}
+
+// Enabled sets whether the policy is enabled.
+func (b *PolicyBuilder) Enabled(enabled bool) *PolicyBuilder {
+	b.policy.General.Enabled = enabled
+	return b
+}
+
+// Category sets the policy category by name.
+func (b *PolicyBuilder) Category(name string) *PolicyBuilder {
+	b.category = name
+	return b
+}
+
+// Site sets the site the policy belongs to.
+func (b *PolicyBuilder) Site(site SharedResourceSite) *PolicyBuilder {
+	b.policy.General.Site = site
+	return b
+}
+
+// Triggers enables one or more of the built in policy triggers, e.g. PolicyTriggerCheckin.
+func (b *PolicyBuilder) Triggers(triggers ...string) *PolicyBuilder {
+	general := &b.policy.General
+	for _, trigger := range triggers {
+		switch trigger {
+		case PolicyTriggerCheckin:
+			general.TriggerCheckin = true
+		case PolicyTriggerEnrollmentComplete:
+			general.TriggerEnrollmentComplete = true
+		case PolicyTriggerLogin:
+			general.TriggerLogin = true
+		case PolicyTriggerLogout:
+			general.TriggerLogout = true
+		case PolicyTriggerNetworkStateChange:
+			general.TriggerNetworkStateChanged = true
+		case PolicyTriggerStartup:
+			general.TriggerStartup = true
+		default:
+			b.errs = append(b.errs, fmt.Sprintf("unknown policy trigger %q, use CustomTrigger for custom events", trigger))
+		}
+	}
+	return b
+}
+
+// CustomTrigger sets the custom event that runs the policy with `jamf policy -event <event>`.
+func (b *PolicyBuilder) CustomTrigger(event string) *PolicyBuilder {
+	b.policy.General.TriggerOther = event
+	return b
+}
+
+// Frequency sets the execution frequency, e.g. PolicyFrequencyOngoing.
+func (b *PolicyBuilder) Frequency(frequency string) *PolicyBuilder {
+	b.policy.General.Frequency = frequency
+	return b
+}
+
+// Retry sets the retry event and number of attempts. Jamf Pro only supports retries
+// for policies that run once per computer.
+func (b *PolicyBuilder) Retry(event string, attempts int, notifyOnEachFailedRetry bool) *PolicyBuilder {
+	b.policy.General.RetryEvent = event
+	b.policy.General.RetryAttempts = attempts
+	b.policy.General.NotifyOnEachFailedRetry = notifyOnEachFailedRetry
+	return b
+}
+
+// DateLimits restricts the policy to run between activation and expiration. A zero time leaves that limit unset.
+func (b *PolicyBuilder) DateLimits(activation, expiration time.Time) *PolicyBuilder {
+	limits := &b.policy.General.DateTimeLimitations
+	if !activation.IsZero() {
+		limits.ActivationDate = activation.Format(policyDateTimeLayout)
+	}
+	if !expiration.IsZero() {
+		limits.ExpirationDate = expiration.Format(policyDateTimeLayout)
+	}
+	return b
+}
+
+// InstallPackage adds a package to install by name.
+func (b *PolicyBuilder) InstallPackage(name string) *PolicyBuilder {
+	return b.AddPackage(name, PolicyPackageActionInstall)
+}
+
+// AddPackage adds a package by name with the given action, e.g. PolicyPackageActionCache.
+func (b *PolicyBuilder) AddPackage(name, action string) *PolicyBuilder {
+	b.packages = append(b.packages, policyBuilderPackage{name: name, action: action})
+	return b
+}
+
+// AddScript adds a script by name. Parameters are passed to the script as parameters 4 to 11 in order.
+func (b *PolicyBuilder) AddScript(name, priority string, parameters ...string) *PolicyBuilder {
+	b.scripts = append(b.scripts, policyBuilderScript{name: name, priority: priority, parameters: parameters})
+	return b
+}
+
+// ScopeAllComputers scopes the policy to all computers.
+func (b *PolicyBuilder) ScopeAllComputers() *PolicyBuilder {
+	b.policy.Scope.AllComputers = true
+	return b
+}
+
+// ScopeComputerGroups scopes the policy to computer groups by name.
+func (b *PolicyBuilder) ScopeComputerGroups(names ...string) *PolicyBuilder {
+	b.scopeGroups = append(b.scopeGroups, names...)
+	return b
+}
+
+// ExcludeComputerGroups excludes computer groups by name from the policy scope.
+func (b *PolicyBuilder) ExcludeComputerGroups(names ...string) *PolicyBuilder {
+	b.excludedGroups = append(b.excludedGroups, names...)
+	return b
+}
+
+// SelfService makes the policy available in Self Service with the given settings.
+func (b *PolicyBuilder) SelfService(selfService PolicySubsetSelfService) *PolicyBuilder {
+	selfService.UseForSelfService = true
+	b.policy.SelfService = selfService
+	return b
+}
+
+// Reboot sets the restart options for the policy.
+func (b *PolicyBuilder) Reboot(reboot PolicySubsetReboot) *PolicyBuilder {
+	b.policy.Reboot = reboot
+	return b
+}
+
+// Maintenance sets the maintenance options for the policy, e.g. updating inventory.
+func (b *PolicyBuilder) Maintenance(maintenance PolicySubsetMaintenance) *PolicyBuilder {
+	b.policy.Maintenance = maintenance
+	return b
+}
+
+// Build resolves every name recorded on the builder to its Jamf Pro ID, validates the result with
+// ValidatePolicy and returns a policy ready for CreatePolicy.
+func (b *PolicyBuilder) Build() (*ResourcePolicy, error) {
+	errs := append([]string{}, b.errs...)
+	policy := b.policy
+
+	if b.category != "" {
+		category, err := b.client.GetCategoryByName(b.category)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("category %q: %v", b.category, err))
+		} else if id, err := strconv.Atoi(category.Id); err == nil {
+			policy.General.Category = PolicyCategory{ID: id, Name: category.Name}
+		} else {
+			errs = append(errs, fmt.Sprintf("category %q has non numeric id %q", b.category, category.Id))
+		}
+	}
+
+	for _, pkg := range b.packages {
+		resolved, err := b.client.GetPackageByName(pkg.name)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("package %q: %v", pkg.name, err))
+			continue
+		}
+		policy.PackageConfiguration.Packages = append(policy.PackageConfiguration.Packages, PolicySubsetPackageConfigurationPackage{
+			ID:     resolved.ID,
+			Name:   resolved.Name,
+			Action: pkg.action,
+		})
+	}
+
+	for _, script := range b.scripts {
+		if len(script.parameters) > policyMaxScriptParameters {
+			errs = append(errs, fmt.Sprintf("script %q has %d parameters, a policy can pass at most %d (parameters 4 to 11)", script.name, len(script.parameters), policyMaxScriptParameters))
+			continue
+		}
+		resolved, err := b.client.GetScriptByName(script.name)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("script %q: %v", script.name, err))
+			continue
+		}
+		policy.Scripts.Script = append(policy.Scripts.Script, newPolicySubsetScript(resolved, script.priority, script.parameters))
+	}
+	policy.Scripts.Size = len(policy.Scripts.Script)
+
+	for _, name := range b.scopeGroups {
+		group, err := b.client.GetComputerGroupByName(name)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("computer group %q: %v", name, err))
+			continue
+		}
+		policy.Scope.ComputerGroups = append(policy.Scope.ComputerGroups, PolicyDataSubsetComputerGroup{ID: group.ID, Name: group.Name})
+	}
+
+	for _, name := range b.excludedGroups {
+		group, err := b.client.GetComputerGroupByName(name)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("excluded computer group %q: %v", name, err))
+			continue
+		}
+		policy.Scope.Exclusions.ComputerGroups = append(policy.Scope.Exclusions.ComputerGroups, PolicyDataSubsetComputerGroup{ID: group.ID, Name: group.Name})
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("failed to build policy %s: %s", policy.General.Name, strings.Join(errs, "; "))
+	}
+
+	if err := ValidatePolicy(&policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// newPolicySubsetScript maps a resolved script and its positional parameters onto a policy script entry.
+func newPolicySubsetScript(script *ResourceScript, priority string, parameters []string) PolicySubsetScript {
+	entry := PolicySubsetScript{ID: script.ID, Name: script.Name, Priority: priority}
+	fields := []*string{
+		&entry.Parameter4, &entry.Parameter5, &entry.Parameter6, &entry.Parameter7,
+		&entry.Parameter8, &entry.Parameter9, &entry.Parameter10, &entry.Parameter11,
+	}
+	for i, value := range parameters {
+		*fields[i] = value
+	}
+	return entry
+}
+
+// ValidatePolicy checks a policy for combinations that Jamf Pro rejects, or that would produce a policy
+// that can never run, before it is sent. It can be used on builder output or on hand built policies.
+func ValidatePolicy(policy *ResourcePolicy) error {
+	var errs []string
+	general := policy.General
+
+	if strings.TrimSpace(general.Name) == "" {
+		errs = append(errs, "policy name is required")
+	}
+
+	if general.Frequency != "" && !validPolicyFrequencies[general.Frequency] {
+		errs = append(errs, fmt.Sprintf("invalid frequency %q", general.Frequency))
+	}
+
+	hasTrigger := general.TriggerCheckin || general.TriggerEnrollmentComplete || general.TriggerLogin ||
+		general.TriggerLogout || general.TriggerNetworkStateChanged || general.TriggerStartup || general.TriggerOther != ""
+	if general.Enabled && !hasTrigger && !policy.SelfService.UseForSelfService {
+		errs = append(errs, "policy is enabled but has no trigger and is not available in Self Service")
+	}
+	if strings.ContainsAny(general.TriggerOther, " \t") {
+		errs = append(errs, fmt.Sprintf("custom trigger %q must not contain whitespace", general.TriggerOther))
+	}
+
+	if general.RetryEvent != "" && general.RetryEvent != PolicyRetryEventNone {
+		if general.RetryEvent != PolicyRetryEventTrigger && general.RetryEvent != PolicyRetryEventCheckin {
+			errs = append(errs, fmt.Sprintf("invalid retry event %q", general.RetryEvent))
+		}
+		if general.Frequency != PolicyFrequencyOncePerComputer {
+			errs = append(errs, fmt.Sprintf("retry is only supported with frequency %q", PolicyFrequencyOncePerComputer))
+		}
+		if general.RetryAttempts < 1 || general.RetryAttempts > policyMaxRetryAttempts {
+			errs = append(errs, fmt.Sprintf("retry attempts must be between 1 and %d", policyMaxRetryAttempts))
+		}
+	}
+
+	errs = append(errs, validatePolicyDateTimeLimitations(general.DateTimeLimitations)...)
+
+	for _, pkg := range policy.PackageConfiguration.Packages {
+		if pkg.ID == 0 && pkg.Name == "" {
+			errs = append(errs, "package configuration contains a package with no id or name")
+		}
+		if pkg.Action != "" && !validPolicyPackageActions[pkg.Action] {
+			errs = append(errs, fmt.Sprintf("package %s has invalid action %q", pkg.Name, pkg.Action))
+		}
+	}
+
+	for _, script := range policy.Scripts.Script {
+		if script.ID == "" && script.Name == "" {
+			errs = append(errs, "scripts contain a script with no id or name")
+		}
+		if script.Priority != "" && script.Priority != PolicyScriptPriorityBefore && script.Priority != PolicyScriptPriorityAfter {
+			errs = append(errs, fmt.Sprintf("script %s has invalid priority %q", script.Name, script.Priority))
+		}
+	}
+
+	reboot := policy.Reboot
+	if reboot.UserLoggedIn != "" && !validPolicyRebootActions[reboot.UserLoggedIn] {
+		errs = append(errs, fmt.Sprintf("invalid reboot action for logged in users %q", reboot.UserLoggedIn))
+	}
+	if reboot.NoUserLoggedIn != "" && !validPolicyRebootActions[reboot.NoUserLoggedIn] {
+		errs = append(errs, fmt.Sprintf("invalid reboot action when no user is logged in %q", reboot.NoUserLoggedIn))
+	}
+	if reboot.MinutesUntilReboot < 0 {
+		errs = append(errs, "minutes until reboot cannot be negative")
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid policy %s: %s", general.Name, strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// validatePolicyDateTimeLimitations checks that activation and expiration dates parse, are ordered,
+// and that the no execute start and end times are either both set or both empty.
+func validatePolicyDateTimeLimitations(limits PolicySubsetGeneralDateTimeLimitations) []string {
+	var errs []string
+	var activation, expiration time.Time
+	var err error
+
+	if limits.ActivationDate != "" {
+		if activation, err = time.Parse(policyDateTimeLayout, limits.ActivationDate); err != nil {
+			errs = append(errs, fmt.Sprintf("activation date %q must use the format YYYY-MM-DD hh:mm:ss", limits.ActivationDate))
+		}
+	}
+	if limits.ExpirationDate != "" {
+		if expiration, err = time.Parse(policyDateTimeLayout, limits.ExpirationDate); err != nil {
+			errs = append(errs, fmt.Sprintf("expiration date %q must use the format YYYY-MM-DD hh:mm:ss", limits.ExpirationDate))
+		}
+	}
+	if !activation.IsZero() && !expiration.IsZero() && !expiration.After(activation) {
+		errs = append(errs, "expiration date must be after the activation date")
+	}
+	if (limits.NoExecuteStart == "") != (limits.NoExecuteEnd == "") {
+		errs = append(errs, "no execute start and end times must be set together")
+	}
+
+	return errs
+}
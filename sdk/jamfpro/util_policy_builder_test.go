@@ -0,0 +1,259 @@
+package jamfpro
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidatePolicy(t *testing.T) {
+	validPolicy := func() *ResourcePolicy {
+		return &ResourcePolicy{
+			General: PolicySubsetGeneral{
+				Name:           "Install Google Chrome",
+				Enabled:        true,
+				TriggerCheckin: true,
+				Frequency:      PolicyFrequencyOncePerComputer,
+			},
+			PackageConfiguration: PolicySubsetPackageConfiguration{
+				Packages: []PolicySubsetPackageConfigurationPackage{{ID: 1, Name: "GoogleChrome.pkg", Action: PolicyPackageActionInstall}},
+			},
+			Scripts: PolicySubsetScripts{
+				Script: []PolicySubsetScript{{ID: "1", Name: "Remove Quarantine", Priority: PolicyScriptPriorityAfter}},
+			},
+			Reboot: PolicySubsetReboot{UserLoggedIn: "Do not restart", NoUserLoggedIn: "Do not restart"},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(policy *ResourcePolicy)
+		wantErrs []string
+	}{
+		{
+			name:   "valid policy",
+			modify: func(policy *ResourcePolicy) {},
+		},
+		{
+			name: "self service without trigger",
+			modify: func(policy *ResourcePolicy) {
+				policy.General.TriggerCheckin = false
+				policy.SelfService.UseForSelfService = true
+			},
+		},
+		{
+			name: "disabled policy without trigger",
+			modify: func(policy *ResourcePolicy) {
+				policy.General.Enabled = false
+				policy.General.TriggerCheckin = false
+			},
+		},
+		{
+			name: "retry on check-in",
+			modify: func(policy *ResourcePolicy) {
+				policy.General.RetryEvent = PolicyRetryEventCheckin
+				policy.General.RetryAttempts = 3
+			},
+		},
+		{
+			name:     "missing name",
+			modify:   func(policy *ResourcePolicy) { policy.General.Name = " " },
+			wantErrs: []string{"policy name is required"},
+		},
+		{
+			name:     "invalid frequency",
+			modify:   func(policy *ResourcePolicy) { policy.General.Frequency = "Hourly" },
+			wantErrs: []string{`invalid frequency "Hourly"`},
+		},
+		{
+			name:     "enabled without trigger",
+			modify:   func(policy *ResourcePolicy) { policy.General.TriggerCheckin = false },
+			wantErrs: []string{"has no trigger and is not available in Self Service"},
+		},
+		{
+			name:     "custom trigger with whitespace",
+			modify:   func(policy *ResourcePolicy) { policy.General.TriggerOther = "install chrome" },
+			wantErrs: []string{`custom trigger "install chrome" must not contain whitespace`},
+		},
+		{
+			name: "invalid retry settings",
+			modify: func(policy *ResourcePolicy) {
+				policy.General.Frequency = PolicyFrequencyOngoing
+				policy.General.RetryEvent = "hourly"
+				policy.General.RetryAttempts = 11
+			},
+			wantErrs: []string{`invalid retry event "hourly"`, "retry is only supported with frequency", "retry attempts must be between 1 and 10"},
+		},
+		{
+			name: "invalid date limitations",
+			modify: func(policy *ResourcePolicy) {
+				policy.General.DateTimeLimitations.ActivationDate = "2024-02-01"
+			},
+			wantErrs: []string{"activation date"},
+		},
+		{
+			name: "invalid package",
+			modify: func(policy *ResourcePolicy) {
+				policy.PackageConfiguration.Packages = append(policy.PackageConfiguration.Packages,
+					PolicySubsetPackageConfigurationPackage{},
+					PolicySubsetPackageConfigurationPackage{ID: 2, Name: "Other.pkg", Action: "Download"})
+			},
+			wantErrs: []string{"package with no id or name", `package Other.pkg has invalid action "Download"`},
+		},
+		{
+			name: "invalid script",
+			modify: func(policy *ResourcePolicy) {
+				policy.Scripts.Script = append(policy.Scripts.Script,
+					PolicySubsetScript{},
+					PolicySubsetScript{ID: "2", Name: "Cleanup", Priority: "During"})
+			},
+			wantErrs: []string{"script with no id or name", `script Cleanup has invalid priority "During"`},
+		},
+		{
+			name: "invalid reboot settings",
+			modify: func(policy *ResourcePolicy) {
+				policy.Reboot.UserLoggedIn = "Shut down"
+				policy.Reboot.NoUserLoggedIn = "Reboot"
+				policy.Reboot.MinutesUntilReboot = -1
+			},
+			wantErrs: []string{`for logged in users "Shut down"`, `when no user is logged in "Reboot"`, "minutes until reboot cannot be negative"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := validPolicy()
+			tt.modify(policy)
+
+			err := ValidatePolicy(policy)
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidatePolicyDateTimeLimitations(t *testing.T) {
+	tests := []struct {
+		name     string
+		limits   PolicySubsetGeneralDateTimeLimitations
+		wantErrs []string
+	}{
+		{
+			name: "no limitations",
+		},
+		{
+			name: "ordered dates and execution window",
+			limits: PolicySubsetGeneralDateTimeLimitations{
+				ActivationDate: "2024-02-01 08:00:00",
+				ExpirationDate: "2024-03-01 08:00:00",
+				NoExecuteStart: "9:00 AM",
+				NoExecuteEnd:   "5:00 PM",
+			},
+		},
+		{
+			name:   "activation date only",
+			limits: PolicySubsetGeneralDateTimeLimitations{ActivationDate: "2024-02-01 08:00:00"},
+		},
+		{
+			name:     "activation date without time",
+			limits:   PolicySubsetGeneralDateTimeLimitations{ActivationDate: "2024-02-01"},
+			wantErrs: []string{`activation date "2024-02-01" must use the format YYYY-MM-DD hh:mm:ss`},
+		},
+		{
+			name:     "unparsable expiration date",
+			limits:   PolicySubsetGeneralDateTimeLimitations{ExpirationDate: "01/03/2024 08:00"},
+			wantErrs: []string{`expiration date "01/03/2024 08:00" must use the format YYYY-MM-DD hh:mm:ss`},
+		},
+		{
+			name: "expiration before activation",
+			limits: PolicySubsetGeneralDateTimeLimitations{
+				ActivationDate: "2024-03-01 08:00:00",
+				ExpirationDate: "2024-02-01 08:00:00",
+			},
+			wantErrs: []string{"expiration date must be after the activation date"},
+		},
+		{
+			name: "expiration equal to activation",
+			limits: PolicySubsetGeneralDateTimeLimitations{
+				ActivationDate: "2024-03-01 08:00:00",
+				ExpirationDate: "2024-03-01 08:00:00",
+			},
+			wantErrs: []string{"expiration date must be after the activation date"},
+		},
+		{
+			name:     "execution window start only",
+			limits:   PolicySubsetGeneralDateTimeLimitations{NoExecuteStart: "9:00 AM"},
+			wantErrs: []string{"no execute start and end times must be set together"},
+		},
+		{
+			name:     "execution window end only",
+			limits:   PolicySubsetGeneralDateTimeLimitations{NoExecuteEnd: "5:00 PM"},
+			wantErrs: []string{"no execute start and end times must be set together"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validatePolicyDateTimeLimitations(tt.limits)
+			if len(errs) != len(tt.wantErrs) {
+				t.Fatalf("got errors %q, want %q", errs, tt.wantErrs)
+			}
+			for i := range errs {
+				if errs[i] != tt.wantErrs[i] {
+					t.Errorf("error %d = %q, want %q", i, errs[i], tt.wantErrs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNewPolicySubsetScript(t *testing.T) {
+	script := &ResourceScript{ID: "7", Name: "Remove Quarantine"}
+
+	tests := []struct {
+		name       string
+		parameters []string
+		want       PolicySubsetScript
+	}{
+		{
+			name: "no parameters",
+			want: PolicySubsetScript{ID: "7", Name: "Remove Quarantine", Priority: PolicyScriptPriorityAfter},
+		},
+		{
+			name:       "parameters start at 4",
+			parameters: []string{"/Applications/Google Chrome.app", "--force"},
+			want: PolicySubsetScript{
+				ID: "7", Name: "Remove Quarantine", Priority: PolicyScriptPriorityAfter,
+				Parameter4: "/Applications/Google Chrome.app", Parameter5: "--force",
+			},
+		},
+		{
+			name:       "all parameters up to 11",
+			parameters: []string{"4", "5", "6", "7", "8", "9", "10", "11"},
+			want: PolicySubsetScript{
+				ID: "7", Name: "Remove Quarantine", Priority: PolicyScriptPriorityAfter,
+				Parameter4: "4", Parameter5: "5", Parameter6: "6", Parameter7: "7",
+				Parameter8: "8", Parameter9: "9", Parameter10: "10", Parameter11: "11",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newPolicySubsetScript(script, PolicyScriptPriorityAfter, tt.parameters); got != tt.want {
+				t.Errorf("script = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
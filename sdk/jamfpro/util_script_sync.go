@@ -0,0 +1,491 @@
+// util_script_sync.go
+// Synchronises Jamf Pro scripts with a directory of script files, e.g. a Git checkout. Each file may start,
+// after its shebang, with a front-matter header in comment lines between two --- markers:
+//
+//	#!/bin/zsh
+//	# ---
+//	# name: Install Rosetta
+//	# category: Utilities
+//	# info: Installs Rosetta 2 on Apple silicon
+//	# os_requirements: 13.x, 14.x
+//	# priority: before
+//	# parameters:
+//	#   4: Username
+//	#   5: Install location
+//	# ---
+//
+// The header is YAML. Lines may be commented with # or //. The whole file, header included, becomes the script
+// contents. A file without a header is synchronised under its file name with the Jamf Pro defaults.
+
+package jamfpro
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Script sync actions.
+const (
+	ScriptSyncCreate    = "create"
+	ScriptSyncUpdate    = "update"
+	ScriptSyncDelete    = "delete"
+	ScriptSyncUnchanged = "unchanged"
+	// ScriptSyncKeep is a Jamf Pro script without a file that is kept because deletion is not enabled.
+	ScriptSyncKeep = "keep"
+)
+
+// Jamf Pro script priorities.
+const (
+	ScriptPriorityBefore   = "BEFORE"
+	ScriptPriorityAfter    = "AFTER"
+	ScriptPriorityAtReboot = "AT_REBOOT"
+)
+
+// scriptNoCategoryID is the category ID Jamf Pro reports for scripts without a category.
+const scriptNoCategoryID = "-1"
+
+// DefaultScriptExtensions are the file extensions synchronised when ScriptSyncOptions.Extensions is empty.
+var DefaultScriptExtensions = []string{".sh", ".bash", ".zsh", ".py", ".pl", ".rb", ".js", ".swift"}
+
+// ScriptFileHeader is the front-matter header of a script file.
+type ScriptFileHeader struct {
+	Name           string         `yaml:"name"`
+	Category       string         `yaml:"category"`
+	Info           string         `yaml:"info"`
+	Notes          string         `yaml:"notes"`
+	OSRequirements string         `yaml:"os_requirements"`
+	Priority       string         `yaml:"priority"`
+	Parameters     map[int]string `yaml:"parameters"`
+}
+
+// ScriptFile is a script read from a file.
+type ScriptFile struct {
+	Path   string
+	Header ScriptFileHeader
+	Script ResourceScript
+}
+
+// ScriptSyncOptions configures SyncScripts.
+type ScriptSyncOptions struct {
+	// DryRun plans the changes without making them.
+	DryRun bool
+	// Delete removes Jamf Pro scripts that no file defines. Without it they are reported with the keep action.
+	Delete bool
+	// ManagedCategories limits deletion to scripts in these categories, so scripts maintained in Jamf Pro by
+	// hand are never deleted. Delete requires it unless DeleteUnmanaged is set.
+	ManagedCategories []string
+	// DeleteUnmanaged lets Delete remove scripts in any category when ManagedCategories is empty.
+	DeleteUnmanaged bool
+	// Extensions are the file extensions read from the directory. Empty uses DefaultScriptExtensions.
+	Extensions []string
+}
+
+// ScriptSyncAction is one planned change.
+type ScriptSyncAction struct {
+	Action string `json:"action"`
+	Name   string `json:"name"`
+	// ID is the Jamf Pro script ID, or the ID of the created script after a create.
+	ID   string `json:"id,omitempty"`
+	File string `json:"file,omitempty"`
+	// Changes lists the fields an update changes.
+	Changes []string `json:"changes,omitempty"`
+	Error   string   `json:"error,omitempty"`
+
+	script *ResourceScript
+}
+
+// ScriptSyncPlan is the set of changes that brings Jamf Pro in line with the directory.
+type ScriptSyncPlan struct {
+	Directory string             `json:"directory"`
+	DryRun    bool               `json:"dryRun"`
+	Actions   []ScriptSyncAction `json:"actions"`
+}
+
+// Counts returns the number of actions of each type.
+func (p *ScriptSyncPlan) Counts() map[string]int {
+	counts := make(map[string]int)
+	for _, action := range p.Actions {
+		counts[action.Action]++
+	}
+	return counts
+}
+
+// HasChanges reports whether the plan creates, updates or deletes any script.
+func (p *ScriptSyncPlan) HasChanges() bool {
+	for _, action := range p.Actions {
+		switch action.Action {
+		case ScriptSyncCreate, ScriptSyncUpdate, ScriptSyncDelete:
+			return true
+		}
+	}
+	return false
+}
+
+// WriteText writes the plan with one line per script, followed by a summary.
+func (p *ScriptSyncPlan) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, action := range p.Actions {
+		detail := strings.Join(action.Changes, ", ")
+		if action.Error != "" {
+			detail = "error: " + action.Error
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", action.Action, action.Name, action.File, detail)
+	}
+	counts := p.Counts()
+	verb := "Applied"
+	if p.DryRun {
+		verb = "Plan"
+	}
+	fmt.Fprintf(tw, "\n%s: %d to create, %d to update, %d to delete, %d unchanged, %d kept\n", verb,
+		counts[ScriptSyncCreate], counts[ScriptSyncUpdate], counts[ScriptSyncDelete], counts[ScriptSyncUnchanged], counts[ScriptSyncKeep])
+	return tw.Flush()
+}
+
+// String returns the plan as text.
+func (p *ScriptSyncPlan) String() string {
+	var b strings.Builder
+	_ = p.WriteText(&b)
+	return b.String()
+}
+
+// ParseScriptFile reads a script file and its front-matter header. The category is returned by name in
+// Header.Category; Script.CategoryId is left for the caller to resolve.
+func ParseScriptFile(path string) (*ScriptFile, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read script %s: %v", path, err)
+	}
+
+	file := &ScriptFile{Path: path}
+	if err := parseScriptHeader(contents, &file.Header); err != nil {
+		return nil, fmt.Errorf("failed to parse header of script %s: %v", path, err)
+	}
+
+	header := &file.Header
+	if header.Name == "" {
+		header.Name = filepath.Base(path)
+	}
+	priority, err := normaliseScriptPriority(header.Priority)
+	if err != nil {
+		return nil, fmt.Errorf("script %s: %v", path, err)
+	}
+
+	file.Script = ResourceScript{
+		Name:           header.Name,
+		CategoryName:   header.Category,
+		Info:           header.Info,
+		Notes:          header.Notes,
+		OSRequirements: header.OSRequirements,
+		Priority:       priority,
+		ScriptContents: string(contents),
+	}
+	parameters := []*string{
+		&file.Script.Parameter4, &file.Script.Parameter5, &file.Script.Parameter6, &file.Script.Parameter7,
+		&file.Script.Parameter8, &file.Script.Parameter9, &file.Script.Parameter10, &file.Script.Parameter11,
+	}
+	for number, label := range header.Parameters {
+		if number < 4 || number > 11 {
+			return nil, fmt.Errorf("script %s: parameter %d is not between 4 and 11", path, number)
+		}
+		*parameters[number-4] = label
+	}
+
+	return file, nil
+}
+
+// parseScriptHeader finds the front-matter header after an optional shebang and decodes it into header.
+// Contents without a header leave header empty.
+func parseScriptHeader(contents []byte, header *ScriptFileHeader) error {
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	scanner.Buffer(make([]byte, 64*1024), len(contents)+1)
+
+	var (
+		prefix  string
+		started bool
+		yamlDoc strings.Builder
+	)
+	for line := 0; scanner.Scan(); line++ {
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if !started {
+			if line == 0 && strings.HasPrefix(text, "#!") {
+				continue
+			}
+			trimmed := strings.TrimSpace(text)
+			if trimmed == "" {
+				continue
+			}
+			for _, candidate := range []string{"#", "//"} {
+				if strings.HasPrefix(trimmed, candidate) && strings.TrimSpace(strings.TrimPrefix(trimmed, candidate)) == "---" {
+					prefix = candidate
+				}
+			}
+			if prefix == "" {
+				return nil
+			}
+			started = true
+			continue
+		}
+
+		trimmed := strings.TrimLeft(text, " \t")
+		if !strings.HasPrefix(trimmed, prefix) {
+			return fmt.Errorf("header is not closed with %s ---", prefix)
+		}
+		body := strings.TrimPrefix(trimmed, prefix)
+		if strings.TrimSpace(body) == "---" {
+			if err := yaml.Unmarshal([]byte(yamlDoc.String()), header); err != nil {
+				return err
+			}
+			return nil
+		}
+		// Drop the single space conventionally written after the comment marker, keeping YAML indentation
+		yamlDoc.WriteString(strings.TrimPrefix(body, " "))
+		yamlDoc.WriteString("\n")
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if started {
+		return fmt.Errorf("header is not closed with %s ---", prefix)
+	}
+	return nil
+}
+
+// normaliseScriptPriority maps a header priority such as "before" or "at reboot" to the Jamf Pro value. An
+// empty priority is AFTER, the Jamf Pro default.
+func normaliseScriptPriority(priority string) (string, error) {
+	value := strings.ToUpper(strings.TrimSpace(priority))
+	value = strings.NewReplacer(" ", "_", "-", "_").Replace(value)
+	switch value {
+	case "":
+		return ScriptPriorityAfter, nil
+	case ScriptPriorityBefore, ScriptPriorityAfter, ScriptPriorityAtReboot:
+		return value, nil
+	}
+	return "", fmt.Errorf("priority %q must be before, after or at_reboot", priority)
+}
+
+// LoadScriptDirectory parses every script file in a directory. Subdirectories are searched as well. Two files
+// with the same script name are an error.
+func LoadScriptDirectory(directory string, extensions []string) ([]ScriptFile, error) {
+	if len(extensions) == 0 {
+		extensions = DefaultScriptExtensions
+	}
+
+	var files []ScriptFile
+	names := make(map[string]string)
+	err := filepath.WalkDir(directory, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != directory && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !hasExtension(path, extensions) {
+			return nil
+		}
+
+		file, err := ParseScriptFile(path)
+		if err != nil {
+			return err
+		}
+		if other, ok := names[file.Script.Name]; ok {
+			return fmt.Errorf("scripts %s and %s are both named %s", other, path, file.Script.Name)
+		}
+		names[file.Script.Name] = path
+		files = append(files, *file)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Script.Name < files[j].Script.Name
+	})
+	return files, nil
+}
+
+// hasExtension reports whether path ends with one of the extensions, ignoring case.
+func hasExtension(path string, extensions []string) bool {
+	ext := filepath.Ext(path)
+	for _, allowed := range extensions {
+		if strings.EqualFold(ext, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// PlanScriptSync compares the scripts in a directory with the scripts in Jamf Pro and returns the changes
+// that SyncScripts would make. Categories named in headers must already exist in Jamf Pro.
+func (c *Client) PlanScriptSync(directory string, options *ScriptSyncOptions) (*ScriptSyncPlan, error) {
+	if options == nil {
+		options = &ScriptSyncOptions{}
+	}
+	if options.Delete && len(options.ManagedCategories) == 0 && !options.DeleteUnmanaged {
+		return nil, fmt.Errorf("script sync with Delete requires ManagedCategories, or DeleteUnmanaged to delete scripts in any category")
+	}
+
+	files, err := LoadScriptDirectory(directory, options.Extensions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve category names once
+	categoryIDs := map[string]string{"": scriptNoCategoryID}
+	for i := range files {
+		name := files[i].Header.Category
+		if _, ok := categoryIDs[name]; !ok {
+			category, err := c.GetCategoryByName(name)
+			if err != nil {
+				return nil, fmt.Errorf("category %s of script %s cannot be used: %v", name, files[i].Path, err)
+			}
+			categoryIDs[name] = category.Id
+		}
+		files[i].Script.CategoryId = categoryIDs[name]
+	}
+
+	existing, err := c.GetScripts("")
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]ResourceScript, len(existing.Results))
+	for _, script := range existing.Results {
+		if _, ok := byName[script.Name]; !ok {
+			byName[script.Name] = script
+		}
+	}
+
+	plan := &ScriptSyncPlan{Directory: directory, DryRun: options.DryRun}
+	defined := make(map[string]bool, len(files))
+	for i := range files {
+		file := &files[i]
+		defined[file.Script.Name] = true
+		action := ScriptSyncAction{Name: file.Script.Name, File: file.Path, script: &file.Script}
+
+		current, ok := byName[file.Script.Name]
+		if !ok {
+			action.Action = ScriptSyncCreate
+			plan.Actions = append(plan.Actions, action)
+			continue
+		}
+
+		action.ID = current.ID
+		action.Changes = diffScripts(&current, &file.Script)
+		action.Action = ScriptSyncUpdate
+		if len(action.Changes) == 0 {
+			action.Action = ScriptSyncUnchanged
+		}
+		plan.Actions = append(plan.Actions, action)
+	}
+
+	managed := make(map[string]bool, len(options.ManagedCategories))
+	for _, category := range options.ManagedCategories {
+		managed[category] = true
+	}
+	for _, script := range existing.Results {
+		if defined[script.Name] {
+			continue
+		}
+		action := ScriptSyncAction{Action: ScriptSyncKeep, Name: script.Name, ID: script.ID}
+		if options.Delete && (len(managed) == 0 || managed[script.CategoryName]) {
+			action.Action = ScriptSyncDelete
+		}
+		plan.Actions = append(plan.Actions, action)
+	}
+
+	return plan, nil
+}
+
+// SyncScripts makes the scripts in Jamf Pro match the scripts in a directory: scripts are created and
+// updated from their files, and with options.Delete scripts without a file are deleted. With options.DryRun
+// only the plan is returned. Every change is attempted; failures are recorded on their action and reported
+// together in the returned error.
+func (c *Client) SyncScripts(directory string, options *ScriptSyncOptions) (*ScriptSyncPlan, error) {
+	plan, err := c.PlanScriptSync(directory, options)
+	if err != nil || plan.DryRun {
+		return plan, err
+	}
+
+	var failed []string
+	for i := range plan.Actions {
+		action := &plan.Actions[i]
+		var err error
+		switch action.Action {
+		case ScriptSyncCreate:
+			var created *ResponseScriptCreate
+			created, err = c.CreateScript(action.script)
+			if err == nil {
+				action.ID = created.ID
+			}
+		case ScriptSyncUpdate:
+			_, err = c.UpdateScriptByID(action.ID, action.script)
+		case ScriptSyncDelete:
+			err = c.DeleteScriptByID(action.ID)
+		}
+		if err != nil {
+			action.Error = err.Error()
+			failed = append(failed, action.Name)
+		}
+	}
+
+	if len(failed) > 0 {
+		return plan, errors.New("failed to sync scripts: " + strings.Join(failed, ", "))
+	}
+	return plan, nil
+}
+
+// diffScripts returns the names of the fields that differ between the Jamf Pro script and the file.
+func diffScripts(current, desired *ResourceScript) []string {
+	currentPriority, _ := normaliseScriptPriority(current.Priority)
+	currentCategory := current.CategoryId
+	if currentCategory == "" {
+		currentCategory = scriptNoCategoryID
+	}
+
+	fields := []struct {
+		name             string
+		current, desired string
+	}{
+		{"category", currentCategory, desired.CategoryId},
+		{"info", current.Info, desired.Info},
+		{"notes", current.Notes, desired.Notes},
+		{"os_requirements", current.OSRequirements, desired.OSRequirements},
+		{"priority", currentPriority, desired.Priority},
+		{"contents", normaliseLineEndings(current.ScriptContents), normaliseLineEndings(desired.ScriptContents)},
+		{"parameter4", current.Parameter4, desired.Parameter4},
+		{"parameter5", current.Parameter5, desired.Parameter5},
+		{"parameter6", current.Parameter6, desired.Parameter6},
+		{"parameter7", current.Parameter7, desired.Parameter7},
+		{"parameter8", current.Parameter8, desired.Parameter8},
+		{"parameter9", current.Parameter9, desired.Parameter9},
+		{"parameter10", current.Parameter10, desired.Parameter10},
+		{"parameter11", current.Parameter11, desired.Parameter11},
+	}
+
+	var changes []string
+	for _, field := range fields {
+		if field.current != field.desired {
+			changes = append(changes, field.name)
+		}
+	}
+	return changes
+}
+
+// normaliseLineEndings converts CRLF line endings to LF, as Jamf Pro may store either.
+func normaliseLineEndings(s string) string {
+	return strings.ReplaceAll(s, "\r\n", "\n")
+}
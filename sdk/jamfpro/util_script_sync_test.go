@@ -0,0 +1,200 @@
+package jamfpro
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseScriptHeader(t *testing.T) {
+	tests := []struct {
+		name      string
+		contents  string
+		want      ScriptFileHeader
+		wantError string
+	}{
+		{
+			name: "shell header after shebang",
+			contents: "#!/bin/zsh\n" +
+				"# ---\n" +
+				"# name: Install Rosetta\n" +
+				"# category: Utilities\n" +
+				"# priority: before\n" +
+				"# parameters:\n" +
+				"#   4: Username\n" +
+				"#   5: Install location\n" +
+				"# ---\n" +
+				"softwareupdate --install-rosetta\n",
+			want: ScriptFileHeader{
+				Name:       "Install Rosetta",
+				Category:   "Utilities",
+				Priority:   "before",
+				Parameters: map[int]string{4: "Username", 5: "Install location"},
+			},
+		},
+		{
+			name:     "slash comments and windows line endings",
+			contents: "#!/usr/bin/env swift\r\n\r\n// ---\r\n// name: Report\r\n// info: Reports the model\r\n// ---\r\nprint(1)\r\n",
+			want:     ScriptFileHeader{Name: "Report", Info: "Reports the model"},
+		},
+		{
+			name:     "header without shebang",
+			contents: "# ---\n# name: No Shebang\n# ---\necho\n",
+			want:     ScriptFileHeader{Name: "No Shebang"},
+		},
+		{
+			name:     "no header",
+			contents: "#!/bin/sh\n# Removes quarantine\nxattr -d com.apple.quarantine \"$4\"\n",
+		},
+		{
+			name:     "empty file",
+			contents: "",
+		},
+		{
+			name:     "header marker after code",
+			contents: "#!/bin/sh\necho\n# ---\n# name: Ignored\n# ---\n",
+		},
+		{
+			name:      "unclosed header",
+			contents:  "#!/bin/sh\n# ---\n# name: Open\necho\n",
+			wantError: "header is not closed with # ---",
+		},
+		{
+			name:      "unclosed header at end of file",
+			contents:  "#!/bin/sh\n# ---\n# name: Open\n",
+			wantError: "header is not closed with # ---",
+		},
+		{
+			name:      "invalid yaml",
+			contents:  "# ---\n# name: [Open\n# ---\n",
+			wantError: "yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var header ScriptFileHeader
+			err := parseScriptHeader([]byte(tt.contents), &header)
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("expected an error containing %q, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(header, tt.want) {
+				t.Errorf("header = %+v, want %+v", header, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseScriptFile(t *testing.T) {
+	header := func(lines ...string) string {
+		return "#!/bin/zsh\n# ---\n# " + strings.Join(lines, "\n# ") + "\n# ---\necho\n"
+	}
+
+	tests := []struct {
+		name      string
+		fileName  string
+		contents  string
+		want      ResourceScript
+		wantError string
+	}{
+		{
+			name:     "header values",
+			fileName: "rosetta.sh",
+			contents: header("name: Install Rosetta", "category: Utilities", "os_requirements: 14.x", "priority: at reboot"),
+			want: ResourceScript{
+				Name: "Install Rosetta", CategoryName: "Utilities", OSRequirements: "14.x", Priority: ScriptPriorityAtReboot,
+			},
+		},
+		{
+			name:     "defaults without header",
+			fileName: "cleanup.sh",
+			contents: "#!/bin/sh\necho\n",
+			want:     ResourceScript{Name: "cleanup.sh", Priority: ScriptPriorityAfter},
+		},
+		{
+			name:     "parameters 4 and 11",
+			fileName: "params.sh",
+			contents: header("parameters:", "  4: First", "  11: Last"),
+			want:     ResourceScript{Name: "params.sh", Priority: ScriptPriorityAfter, Parameter4: "First", Parameter11: "Last"},
+		},
+		{
+			name:      "parameter below 4",
+			fileName:  "low.sh",
+			contents:  header("parameters:", "  3: Username"),
+			wantError: "parameter 3 is not between 4 and 11",
+		},
+		{
+			name:      "parameter above 11",
+			fileName:  "high.sh",
+			contents:  header("parameters:", "  12: Extra"),
+			wantError: "parameter 12 is not between 4 and 11",
+		},
+		{
+			name:      "invalid priority",
+			fileName:  "priority.sh",
+			contents:  header("priority: during"),
+			wantError: `priority "during" must be before, after or at_reboot`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.fileName)
+			if err := os.WriteFile(path, []byte(tt.contents), 0o644); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			file, err := ParseScriptFile(path)
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("expected an error containing %q, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tt.want.ScriptContents = tt.contents
+			if !reflect.DeepEqual(file.Script, tt.want) {
+				t.Errorf("script = %+v, want %+v", file.Script, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormaliseScriptPriority(t *testing.T) {
+	tests := []struct {
+		priority  string
+		want      string
+		wantError bool
+	}{
+		{priority: "", want: ScriptPriorityAfter},
+		{priority: "before", want: ScriptPriorityBefore},
+		{priority: " After ", want: ScriptPriorityAfter},
+		{priority: "at reboot", want: ScriptPriorityAtReboot},
+		{priority: "at-reboot", want: ScriptPriorityAtReboot},
+		{priority: "AT_REBOOT", want: ScriptPriorityAtReboot},
+		{priority: "during", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.priority, func(t *testing.T) {
+			got, err := normaliseScriptPriority(tt.priority)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("error = %v, want error %v", err, tt.wantError)
+			}
+			if got != tt.want {
+				t.Errorf("priority = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// util_self_service_icons.go
+// Bulk export of Self Service icons. ExportSelfServiceIcons finds every icon used by policies, Mac App Store
+// apps and mobile device apps and downloads each icon once to a directory, named by icon ID with the
+// extension of its detected content type, e.g. 42.png.
+
+package jamfpro
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Self Service icon reference types.
+const (
+	IconReferencePolicy                  = "policy"
+	IconReferenceMacApplication          = "mac_application"
+	IconReferenceMobileDeviceApplication = "mobile_device_application"
+)
+
+// DefaultIconExportConcurrency is the number of records or icons read at once by ExportSelfServiceIcons.
+const DefaultIconExportConcurrency = 8
+
+// SelfServiceIconExportOptions configures ExportSelfServiceIcons.
+type SelfServiceIconExportOptions struct {
+	// Concurrency is the number of records or icons read at once. Zero uses DefaultIconExportConcurrency.
+	Concurrency int
+	// Res and Scale are passed to DownloadIconTo. Empty values use the Jamf Pro defaults.
+	Res   string
+	Scale string
+	// SkipExisting leaves icons that already have a file in the directory alone.
+	SkipExisting bool
+}
+
+// SelfServiceIconReference is a record that shows an icon in Self Service.
+type SelfServiceIconReference struct {
+	Type string `json:"type"`
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ExportedSelfServiceIcon is the outcome of exporting one icon.
+type ExportedSelfServiceIcon struct {
+	IconID      int                        `json:"iconId"`
+	File        string                     `json:"file,omitempty"`
+	ContentType string                     `json:"contentType,omitempty"`
+	Skipped     bool                       `json:"skipped,omitempty"`
+	References  []SelfServiceIconReference `json:"references"`
+	Error       string                     `json:"error,omitempty"`
+}
+
+// SelfServiceIconExport is the result of ExportSelfServiceIcons.
+type SelfServiceIconExport struct {
+	Directory string                    `json:"directory"`
+	Icons     []ExportedSelfServiceIcon `json:"icons"`
+}
+
+// ExportSelfServiceIcons downloads every Self Service icon referenced by policies, Mac App Store apps and
+// mobile device apps to directory, which is created when missing. Failing to read the records aborts the
+// export; failures of single icons are recorded on the icon and reported together in the returned error.
+func (c *Client) ExportSelfServiceIcons(directory string, options *SelfServiceIconExportOptions) (*SelfServiceIconExport, error) {
+	if options == nil {
+		options = &SelfServiceIconExportOptions{}
+	}
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultIconExportConcurrency
+	}
+
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create icon directory %s: %v", directory, err)
+	}
+
+	references, err := c.findSelfServiceIcons(concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &SelfServiceIconExport{Directory: directory}
+	for iconID, refs := range references {
+		sort.Slice(refs, func(i, j int) bool {
+			if refs[i].Type != refs[j].Type {
+				return refs[i].Type < refs[j].Type
+			}
+			return refs[i].ID < refs[j].ID
+		})
+		export.Icons = append(export.Icons, ExportedSelfServiceIcon{IconID: iconID, References: refs})
+	}
+	sort.Slice(export.Icons, func(i, j int) bool {
+		return export.Icons[i].IconID < export.Icons[j].IconID
+	})
+
+	_ = forEachConcurrently(len(export.Icons), concurrency, func(i int) error {
+		icon := &export.Icons[i]
+		if err := c.exportSelfServiceIcon(directory, icon, options); err != nil {
+			icon.Error = err.Error()
+		}
+		return nil
+	})
+
+	var failed []string
+	for _, icon := range export.Icons {
+		if icon.Error != "" {
+			failed = append(failed, strconv.Itoa(icon.IconID))
+		}
+	}
+	if len(failed) > 0 {
+		return export, errors.New("failed to export icons: " + strings.Join(failed, ", "))
+	}
+	return export, nil
+}
+
+// findSelfServiceIcons reads policies, Mac App Store apps and mobile device apps and returns the records
+// that use each icon ID.
+func (c *Client) findSelfServiceIcons(concurrency int) (map[int][]SelfServiceIconReference, error) {
+	var mu sync.Mutex
+	references := make(map[int][]SelfServiceIconReference)
+	add := func(iconID int, ref SelfServiceIconReference) {
+		if iconID == 0 {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		references[iconID] = append(references[iconID], ref)
+	}
+
+	policies, err := c.GetPolicies()
+	if err != nil {
+		return nil, err
+	}
+	err = forEachConcurrently(len(policies.Policy), concurrency, func(i int) error {
+		policy, err := c.GetPolicyByID(policies.Policy[i].ID)
+		if err != nil {
+			return err
+		}
+		add(policy.SelfService.SelfServiceIcon.ID, SelfServiceIconReference{Type: IconReferencePolicy, ID: policy.General.ID, Name: policy.General.Name})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	macApplications, err := c.GetMacApplications()
+	if err != nil {
+		return nil, err
+	}
+	err = forEachConcurrently(len(macApplications.MacApplications), concurrency, func(i int) error {
+		item := macApplications.MacApplications[i]
+		application, err := c.GetMacApplicationByID(item.ID)
+		if err != nil {
+			return err
+		}
+		add(application.SelfService.SelfServiceIcon.ID, SelfServiceIconReference{Type: IconReferenceMacApplication, ID: item.ID, Name: item.Name})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mobileApplications, err := c.GetMobileDeviceApplications()
+	if err != nil {
+		return nil, err
+	}
+	err = forEachConcurrently(len(mobileApplications.MobileDeviceApplications), concurrency, func(i int) error {
+		item := mobileApplications.MobileDeviceApplications[i]
+		application, err := c.GetMobileDeviceApplicationByID(item.ID)
+		if err != nil {
+			return err
+		}
+		add(application.General.SelfService.SelfServiceIcon.ID, SelfServiceIconReference{Type: IconReferenceMobileDeviceApplication, ID: item.ID, Name: item.Name})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return references, nil
+}
+
+// exportSelfServiceIcon downloads one icon to a temporary file and renames it to its ID and extension once
+// complete.
+func (c *Client) exportSelfServiceIcon(directory string, icon *ExportedSelfServiceIcon, options *SelfServiceIconExportOptions) error {
+	if options.SkipExisting {
+		existing, _ := filepath.Glob(filepath.Join(directory, strconv.Itoa(icon.IconID)+".*"))
+		if len(existing) > 0 {
+			icon.File = existing[0]
+			icon.Skipped = true
+			return nil
+		}
+	}
+
+	tmp, err := os.CreateTemp(directory, fmt.Sprintf(".%d.*", icon.IconID))
+	if err != nil {
+		return fmt.Errorf("failed to create icon file: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	contentType, err := c.DownloadIconTo(icon.IconID, tmp, options.Res, options.Scale)
+	if err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write icon file: %v", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return fmt.Errorf("failed to set permissions of icon file: %v", err)
+	}
+
+	path := filepath.Join(directory, strconv.Itoa(icon.IconID)+IconFileExtension(contentType))
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to move icon file into place: %v", err)
+	}
+	icon.File = path
+	icon.ContentType = contentType
+	return nil
+}
@@ -0,0 +1,437 @@
+// APIPrivilegeTableGenerator generates the privilege table of the jamfpro SDK. It parses the Client methods
+// in sdk/jamfpro, resolves the endpoint and HTTP method of every request they make and maps each endpoint to
+// the Jamf Pro API role privileges it needs. Methods that call other Client methods inherit their privileges.
+//
+// Usage, from sdk/jamfpro:
+//
+//	go generate ./...
+//
+// The privilege names per resource are maintained in resources.go. When a new uri constant is added to the
+// SDK the generator fails until the resource is added there.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// endpoint is a request made directly by a Client method.
+type endpoint struct {
+	Method     string
+	Path       string
+	Resource   string
+	Privileges []string
+}
+
+// clientMethod is a Client method, its direct requests and the Client methods it calls.
+type clientMethod struct {
+	Name      string
+	Endpoints []endpoint
+	Calls     []string
+}
+
+var formatVerb = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the jamfpro package")
+	out := flag.String("out", "shared_api_privileges_table.go", "output file, relative to dir")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	files, err := parseFiles(fset, *dir, *out)
+	if err != nil {
+		log.Fatalf("failed to parse %s: %v", *dir, err)
+	}
+
+	constants := collectURIConstants(files)
+	methods := collectClientMethods(files, constants)
+
+	var missing []string
+	for _, method := range methods {
+		for i, e := range method.Endpoints {
+			privileges, ok := endpointPrivileges(e)
+			if !ok {
+				missing = append(missing, fmt.Sprintf("%s (%s %s)", e.Resource, e.Method, e.Path))
+				continue
+			}
+			method.Endpoints[i].Privileges = privileges
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		log.Fatalf("no privileges defined in resources.go for:\n  %s", strings.Join(uniqueStrings(missing), "\n  "))
+	}
+
+	source, err := render(methods)
+	if err != nil {
+		log.Fatalf("failed to render privilege table: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(*dir, *out), source, 0644); err != nil {
+		log.Fatalf("failed to write privilege table: %v", err)
+	}
+}
+
+// parseFiles parses the package sources, skipping tests and the generated output.
+func parseFiles(fset *token.FileSet, dir, out string) ([]*ast.File, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") || filepath.Base(path) == out {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// collectURIConstants returns the string value of every uri constant in the package.
+func collectURIConstants(files []*ast.File) map[string]string {
+	constants := make(map[string]string)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				value := spec.(*ast.ValueSpec)
+				for i, name := range value.Names {
+					if !strings.HasPrefix(name.Name, "uri") || i >= len(value.Values) {
+						continue
+					}
+					if lit, ok := value.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+						constants[name.Name], _ = strconv.Unquote(lit.Value)
+					}
+				}
+			}
+		}
+	}
+	return constants
+}
+
+// collectClientMethods finds the requests and Client calls of every method with a *Client receiver.
+func collectClientMethods(files []*ast.File, constants map[string]string) map[string]*clientMethod {
+	methods := make(map[string]*clientMethod)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil || !isClientReceiver(fn) {
+				continue
+			}
+			methods[fn.Name.Name] = &clientMethod{Name: fn.Name.Name}
+		}
+	}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil || !isClientReceiver(fn) {
+				continue
+			}
+			receiver := fn.Recv.List[0].Names[0].Name
+			method := methods[fn.Name.Name]
+			resolver := &endpointResolver{constants: constants, assignments: collectAssignments(fn.Body)}
+
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				selector, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+
+				switch x := selector.X.(type) {
+				case *ast.SelectorExpr:
+					// c.HTTP.DoRequest(method, endpoint, ...) and c.HTTP.DoMultipartRequest(method, endpoint, ...)
+					if ident, ok := x.X.(*ast.Ident); ok && ident.Name == receiver && x.Sel.Name == "HTTP" && len(call.Args) >= 2 {
+						if selector.Sel.Name == "DoRequest" || selector.Sel.Name == "DoMultipartRequest" {
+							if httpMethod, ok := stringLiteral(call.Args[0]); ok {
+								method.addEndpoint(httpMethod, resolver, call.Args[1])
+							}
+						}
+					}
+				case *ast.Ident:
+					if x.Name != receiver {
+						return true
+					}
+					switch selector.Sel.Name {
+					case "DoPaginatedGet", "doRawDownload":
+						method.addEndpoint("GET", resolver, call.Args[0])
+					case "doRawMultipartUpload":
+						if httpMethod, ok := stringLiteral(call.Args[0]); ok && len(call.Args) >= 2 {
+							method.addEndpoint(httpMethod, resolver, call.Args[1])
+						}
+					default:
+						if _, ok := methods[selector.Sel.Name]; ok {
+							method.Calls = append(method.Calls, selector.Sel.Name)
+						}
+					}
+				}
+				return true
+			})
+		}
+	}
+	return methods
+}
+
+func (m *clientMethod) addEndpoint(httpMethod string, resolver *endpointResolver, expr ast.Expr) {
+	path, resource := resolver.resolve(expr)
+	if resource == "" {
+		return
+	}
+	m.Endpoints = append(m.Endpoints, endpoint{Method: httpMethod, Path: normalizePath(path), Resource: resource})
+}
+
+func isClientReceiver(fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 || len(fn.Recv.List[0].Names) != 1 {
+		return false
+	}
+	star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == "Client"
+}
+
+// collectAssignments records the first value assigned to each local variable, e.g. endpoint := ...
+func collectAssignments(body *ast.BlockStmt) map[string]ast.Expr {
+	assignments := make(map[string]ast.Expr)
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch stmt := n.(type) {
+		case *ast.AssignStmt:
+			if len(stmt.Lhs) != len(stmt.Rhs) {
+				return true
+			}
+			for i, lhs := range stmt.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok {
+					if _, seen := assignments[ident.Name]; !seen {
+						assignments[ident.Name] = stmt.Rhs[i]
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			for i, name := range stmt.Names {
+				if i < len(stmt.Values) {
+					if _, seen := assignments[name.Name]; !seen {
+						assignments[name.Name] = stmt.Values[i]
+					}
+				}
+			}
+		}
+		return true
+	})
+	return assignments
+}
+
+// endpointResolver turns an endpoint expression into a path pattern, with {param} for runtime values.
+type endpointResolver struct {
+	constants   map[string]string
+	assignments map[string]ast.Expr
+	resource    string
+	depth       int
+}
+
+// resolve returns the path pattern and the first uri constant it is built from.
+func (r *endpointResolver) resolve(expr ast.Expr) (string, string) {
+	r.resource = ""
+	r.depth = 0
+	path := r.value(expr)
+	return path, r.resource
+}
+
+func (r *endpointResolver) value(expr ast.Expr) string {
+	r.depth++
+	defer func() { r.depth-- }()
+	if r.depth > 16 {
+		return "{param}"
+	}
+
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if s, ok := stringLiteral(e); ok {
+			return s
+		}
+	case *ast.Ident:
+		if value, ok := r.constants[e.Name]; ok {
+			if r.resource == "" {
+				r.resource = e.Name
+			}
+			return value
+		}
+		if assigned, ok := r.assignments[e.Name]; ok {
+			return r.value(assigned)
+		}
+	case *ast.ParenExpr:
+		return r.value(e.X)
+	case *ast.BinaryExpr:
+		if e.Op == token.ADD {
+			return r.value(e.X) + r.value(e.Y)
+		}
+	case *ast.CallExpr:
+		if selector, ok := e.Fun.(*ast.SelectorExpr); ok && selector.Sel.Name == "Sprintf" && len(e.Args) > 0 {
+			return r.sprintf(r.value(e.Args[0]), e.Args[1:])
+		}
+	}
+	return "{param}"
+}
+
+// sprintf substitutes the resolved arguments into a format string.
+func (r *endpointResolver) sprintf(format string, args []ast.Expr) string {
+	next := 0
+	return formatVerb.ReplaceAllStringFunc(format, func(verb string) string {
+		if verb == "%%" {
+			return "%"
+		}
+		if next >= len(args) {
+			return "{param}"
+		}
+		value := r.value(args[next])
+		next++
+		return value
+	})
+}
+
+// normalizePath drops the query string and duplicate or trailing slashes.
+func normalizePath(path string) string {
+	if i := strings.IndexAny(path, "?"); i >= 0 {
+		path = path[:i]
+	}
+	for strings.Contains(path, "//") {
+		path = strings.ReplaceAll(path, "//", "/")
+	}
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return path
+}
+
+func stringLiteral(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+// methodPrivileges returns the privileges of a method including those of the Client methods it calls. A
+// method rule replaces the privileges of the method's own requests.
+func methodPrivileges(methods map[string]*clientMethod, name string, visiting map[string]bool) []string {
+	if visiting[name] {
+		return nil
+	}
+	visiting[name] = true
+	defer delete(visiting, name)
+
+	method := methods[name]
+	var privileges []string
+	if rule, ok := methodRules[name]; ok {
+		privileges = append(privileges, rule...)
+	} else {
+		for _, e := range method.Endpoints {
+			privileges = append(privileges, e.Privileges...)
+		}
+	}
+	for _, call := range method.Calls {
+		privileges = append(privileges, methodPrivileges(methods, call, visiting)...)
+	}
+	return uniqueStrings(privileges)
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	out := []string{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			out = append(out, value)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func render(methods map[string]*clientMethod) ([]byte, error) {
+	var names []string
+	for name := range methods {
+		if ast.IsExported(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	endpoints := make(map[string]*endpoint)
+	for _, method := range methods {
+		for _, e := range method.Endpoints {
+			key := e.Method + " " + e.Path
+			if existing, ok := endpoints[key]; ok {
+				existing.Privileges = uniqueStrings(append(existing.Privileges, e.Privileges...))
+				continue
+			}
+			copied := e
+			endpoints[key] = &copied
+		}
+	}
+	var keys []string
+	for key := range endpoints {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := endpoints[keys[i]], endpoints[keys[j]]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Method < b.Method
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by tools/APIPrivilegeTableGenerator. DO NOT EDIT.\n\n")
+	buf.WriteString("package jamfpro\n\n")
+	buf.WriteString("// clientMethodPrivileges maps Client method names to the privileges an API role needs to call them.\n")
+	buf.WriteString("var clientMethodPrivileges = map[string][]string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q: {%s},\n", name, quoteAll(methodPrivileges(methods, name, map[string]bool{})))
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("// endpointPrivilegeTable maps the endpoints requested by the SDK to the privileges they need.\n")
+	buf.WriteString("var endpointPrivilegeTable = []endpointPrivileges{\n")
+	for _, key := range keys {
+		e := endpoints[key]
+		fmt.Fprintf(&buf, "\t{Method: %q, Path: %q, Privileges: []string{%s}},\n", e.Method, e.Path, quoteAll(e.Privileges))
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
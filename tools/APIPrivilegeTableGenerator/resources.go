@@ -0,0 +1,200 @@
+package main
+
+import (
+	"strings"
+)
+
+// resource describes the privileges of the endpoints built from a uri constant.
+type resource struct {
+	// Nouns are combined with the verb of the HTTP method, e.g. "Computers" gives "Read Computers".
+	Nouns []string
+	// Methods set explicit privileges per HTTP method and take precedence over Nouns.
+	Methods map[string][]string
+	// Public marks resources that only need an authenticated token.
+	Public bool
+}
+
+// pathRule overrides the privileges of endpoints whose path contains a segment.
+type pathRule struct {
+	Method     string
+	Contains   string
+	Verb       string
+	Privileges []string
+}
+
+// httpMethodVerbs are the privilege verbs of each HTTP method.
+var httpMethodVerbs = map[string]string{
+	"GET":    "Read",
+	"POST":   "Create",
+	"PUT":    "Update",
+	"PATCH":  "Update",
+	"DELETE": "Delete",
+}
+
+// pathRules cover actions that are sent as POST but change or remove an existing object, and endpoints that
+// return secrets and need a dedicated view privilege.
+var pathRules = []pathRule{
+	{Method: "POST", Contains: "/history", Verb: "Update"},
+	{Method: "POST", Contains: "/disown", Verb: "Update"},
+	{Method: "POST", Contains: "/client-credentials", Verb: "Update"},
+	{Method: "POST", Contains: "/delete-multiple", Verb: "Delete"},
+	{Method: "POST", Contains: "/close", Verb: "Update"},
+	{Method: "POST", Contains: "/regenerate", Verb: "Update"},
+	{Method: "POST", Contains: "/verify", Verb: "Read"},
+	{Method: "POST", Contains: "/parse", Verb: "Read"},
+	{Method: "GET", Contains: "/filevault", Privileges: []string{"Read Computers", "View Disk Encryption Recovery Key"}},
+	{Method: "GET", Contains: "/view-recovery-lock-password", Privileges: []string{"View Recovery Lock"}},
+	{Method: "GET", Contains: "/password", Privileges: []string{"View Local Admin Password"}},
+	{Method: "GET", Contains: "/audit", Privileges: []string{"View Local Admin Password Audit History"}},
+}
+
+// methodRules set the privileges of Client methods whose requests need fewer privileges than the endpoint
+// they use. The membership updates of static groups share the group endpoint with smart groups but only
+// need the static group privilege.
+var methodRules = map[string][]string{
+	"updateStaticComputerGroupMembership":     {"Update Static Computer Groups"},
+	"updateStaticMobileDeviceGroupMembership": {"Update Static Mobile Device Groups"},
+	"updateStaticUserGroupMembership":         {"Update Static User Groups"},
+}
+
+// resources maps each uri constant of the SDK to the Jamf Pro privileges of its endpoints.
+var resources = map[string]resource{
+	"uriAPIAccounts":                         {Nouns: []string{"Accounts"}},
+	"uriAPIActivationCode":                   {Nouns: []string{"Activation Code"}},
+	"uriAPIAdvancedComputerSearches":         {Nouns: []string{"Advanced Computer Searches"}},
+	"uriAPIAdvancedMobileDeviceSearches":     {Nouns: []string{"Advanced Mobile Device Searches"}},
+	"uriAPIAdvancedUserSearches":             {Nouns: []string{"Advanced User Searches"}},
+	"uriAPIAllowedFileExtensions":            {Nouns: []string{"Allowed File Extensions"}},
+	"uriAccountDrivenUserEnrollment":         {Nouns: []string{"User-Initiated Enrollment"}},
+	"uriAccountPreferences":                  {Public: true},
+	"uriApiIntegrations":                     {Nouns: []string{"API Integrations"}},
+	"uriApiRolePrivileges":                   {Nouns: []string{"API Roles"}},
+	"uriApiRoles":                            {Nouns: []string{"API Roles"}},
+	"uriAuth":                                {Public: true},
+	"uriBYOProfiles":                         {Nouns: []string{"Personal Device Profiles"}},
+	"uriBrandingImages":                      {Nouns: []string{"Self Service Branding Configuration"}},
+	"uriBuildings":                           {Nouns: []string{"Buildings"}},
+	"uriCSATokenExchange":                    {Nouns: []string{"Cloud Services Settings"}},
+	"uriCacheSettings":                       {Nouns: []string{"Cache"}},
+	"uriCategories":                          {Nouns: []string{"Categories"}},
+	"uriCertificateAuthority":                {Nouns: []string{"PKI"}},
+	"uriClasses":                             {Nouns: []string{"Classes"}},
+	"uriCloudDistributionPoint":              {Nouns: []string{"Cloud Distribution Point"}},
+	"uriCloudIdentityProvider":               {Nouns: []string{"LDAP Servers"}},
+	"uriCloudLdapKeystore":                   {Nouns: []string{"LDAP Servers"}},
+	"uriCloudLdaps":                          {Nouns: []string{"LDAP Servers"}},
+	"uriComputerCheckin":                     {Nouns: []string{"Computer Check-In"}},
+	"uriComputerExtensionAttributes":         {Nouns: []string{"Computer Extension Attributes"}},
+	"uriComputerGroups":                      {Nouns: []string{"Smart Computer Groups", "Static Computer Groups"}},
+	"uriComputerInventoryCollection":         {Nouns: []string{"Computer Inventory Collection"}},
+	"uriComputerInventoryCollectionSettings": {Nouns: []string{"Computer Inventory Collection"}},
+	"uriComputerInvitations":                 {Nouns: []string{"Computer Invitations"}},
+	"uriComputerPrestagesV2":                 {Nouns: []string{"Computer PreStage Enrollments"}},
+	"uriComputerPrestagesV3":                 {Nouns: []string{"Computer PreStage Enrollments"}},
+	"uriComputers":                           {Nouns: []string{"Computers"}},
+	"uriComputersInventory":                  {Nouns: []string{"Computers"}},
+	"uriConditionalAccess":                   {Nouns: []string{"Conditional Access"}},
+	"uriDepartments":                         {Nouns: []string{"Departments"}},
+	"uriDeviceCommunicationSettings":         {Nouns: []string{"Automatically Renew MDM Profile Settings"}},
+	"uriDeviceEnrollments":                   {Nouns: []string{"Device Enrollment Program Instances"}},
+	"uriDirectoryBindings":                   {Nouns: []string{"Directory Bindings"}},
+	"uriDiskEncryptionConfigurations":        {Nouns: []string{"Disk Encryption Configurations"}},
+	"uriDistributionPoints":                  {Nouns: []string{"Distribution Points"}},
+	"uriDockItems":                           {Nouns: []string{"Dock Items"}},
+	"uriEbooks":                              {Nouns: []string{"eBooks"}},
+	"uriEnrollmentCustomizationSettings":     {Nouns: []string{"Enrollment Customizations"}},
+	"uriFileUploads":                         {Nouns: []string{"File Attachments"}},
+	"uriGSXConnection":                       {Nouns: []string{"GSX Connection"}},
+	"uriHealthCheck":                         {Public: true},
+	"uriIbeacons":                            {Nouns: []string{"iBeacon Regions"}},
+	"uriJCDS2":                               {Nouns: []string{"Jamf Content Distribution Server Files"}},
+	"uriJamfProInformation":                  {Public: true},
+	"uriJamfProServerUrl":                    {Nouns: []string{"Jamf Pro URL"}},
+	"uriJamfProVersion":                      {Public: true},
+	"uriJamfProtect":                         {Nouns: []string{"Jamf Protect Integration"}},
+	"uriLDAPServers":                         {Nouns: []string{"LDAP Servers"}},
+	"uriLicensedSoftware":                    {Nouns: []string{"Licensed Software"}},
+	"uriLocalAdminPassword":                  {Nouns: []string{"Local Admin Password Settings"}},
+	"uriMDMCommands":                         {Methods: map[string][]string{"GET": {"View MDM command information in Jamf Pro API"}, "POST": {"Send Mobile Device Remote Wipe Command"}}},
+	"uriMacOSConfigurationProfiles":          {Nouns: []string{"macOS Configuration Profiles"}},
+	"uriManagedSoftwareUpdates":              {Nouns: []string{"Managed Software Updates"}},
+	"uriMobileDeviceApplications":            {Nouns: []string{"Mobile Device Apps"}},
+	"uriMobileDeviceConfigurationProfiles":   {Nouns: []string{"iOS Configuration Profiles"}},
+	"uriMobileDeviceEnrollmentProfiles":      {Nouns: []string{"Mobile Device Enrollment Profiles"}},
+	"uriMobileDeviceExtensionAttributes":     {Nouns: []string{"Mobile Device Extension Attributes"}},
+	"uriMobileDeviceGroups":                  {Nouns: []string{"Smart Mobile Device Groups", "Static Mobile Device Groups"}},
+	"uriMobileDevicePrestages":               {Nouns: []string{"Mobile Device PreStage Enrollments"}},
+	"uriMobileDeviceProvisioningProfiles":    {Nouns: []string{"Provisioning Profiles"}},
+	"uriMobileDevices":                       {Nouns: []string{"Mobile Devices"}},
+	"uriMobileDevicesProV2":                  {Nouns: []string{"Mobile Devices"}},
+	"uriNetworkSegments":                     {Nouns: []string{"Network Segments"}},
+	"uriPackageV1":                           {Nouns: []string{"Packages"}},
+	"uriPackageV2":                           {Nouns: []string{"Packages"}},
+	"uriPackages":                            {Nouns: []string{"Packages"}},
+	"uriPatchExternalSources":                {Nouns: []string{"Patch External Source"}},
+	"uriPatchPolicies":                       {Nouns: []string{"Patch Policies"}},
+	"uriPatchPoliciesJamfProAPI":             {Nouns: []string{"Patch Policies"}},
+	"uriPatchSoftwareTitleConfigurations":    {Nouns: []string{"Patch Management Software Titles"}},
+	"uriPolicies":                            {Nouns: []string{"Policies"}},
+	"uriPrinters":                            {Nouns: []string{"Printers"}},
+	"uriRemovableMacAddresses":               {Nouns: []string{"Removable MAC Address"}},
+	"uriRestrictedSoftware":                  {Nouns: []string{"Restricted Software"}},
+	"uriReturnToService":                     {Nouns: []string{"Return to Service"}},
+	"uriSMTPServer":                          {Nouns: []string{"SMTP Server"}},
+	"uriSSOCertificate":                      {Nouns: []string{"SSO Settings"}},
+	"uriSSOFailover":                         {Nouns: []string{"SSO Settings"}},
+	"uriScripts":                             {Nouns: []string{"Scripts"}},
+	"uriSelfServiceBrandingMacOS":            {Nouns: []string{"Self Service Branding Configuration"}},
+	"uriSelfServiceSettings":                 {Nouns: []string{"Self Service"}},
+	"uriSites":                               {Nouns: []string{"Sites"}},
+	"uriSoftwareUpdateServers":               {Nouns: []string{"Software Update Servers"}},
+	"uriSsoDependencies":                     {Nouns: []string{"SSO Settings"}},
+	"uriSsoSettings":                         {Nouns: []string{"SSO Settings"}},
+	"uriStartupStatus":                       {Public: true},
+	"uriTeamViewerRemoteAdministration":      {Nouns: []string{"Remote Administration"}},
+	"uriUploadIcon":                          {Nouns: []string{"Icons"}},
+	"uriUserEnrollmentTokenSettings":         {Nouns: []string{"User-Initiated Enrollment"}},
+	"uriUserExtensionAttributes":             {Nouns: []string{"User Extension Attributes"}},
+	"uriUserGroups":                          {Nouns: []string{"Smart User Groups", "Static User Groups"}},
+	"uriUsers":                               {Nouns: []string{"Users"}},
+	"uriVPPAccounts":                         {Nouns: []string{"Volume Purchasing Administrator Accounts"}},
+	"uriVPPAssignments":                      {Nouns: []string{"VPP Assignments"}},
+	"uriVPPMacApplications":                  {Nouns: []string{"Mac Applications"}},
+	"uriVenafi":                              {Nouns: []string{"PKI"}},
+	"uriVolumePurchasingLocations":           {Nouns: []string{"Volume Purchasing Administrator Accounts"}},
+	"uriVolumePurchasingSubscriptions":       {Nouns: []string{"Volume Purchasing Subscriptions"}},
+	"uriWebhooks":                            {Nouns: []string{"Webhooks"}},
+}
+
+// endpointPrivileges returns the privileges of an endpoint, or false when its resource is not defined.
+func endpointPrivileges(e endpoint) ([]string, bool) {
+	r, ok := resources[e.Resource]
+	if !ok {
+		return nil, false
+	}
+	if r.Public {
+		return []string{}, true
+	}
+
+	verb := httpMethodVerbs[e.Method]
+	for _, rule := range pathRules {
+		if rule.Method != e.Method || !strings.Contains(e.Path, rule.Contains) {
+			continue
+		}
+		if rule.Privileges != nil {
+			return rule.Privileges, true
+		}
+		verb = rule.Verb
+		break
+	}
+
+	if privileges, ok := r.Methods[e.Method]; ok {
+		return privileges, true
+	}
+
+	privileges := make([]string, 0, len(r.Nouns))
+	for _, noun := range r.Nouns {
+		privileges = append(privileges, verb+" "+noun)
+	}
+	return privileges, true
+}
@@ -1,9 +1,12 @@
 package jamfpropackageuploader
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+)
 
-// Helper function to print ASCII art
-func PrintASCIILogo() {
+// PrintASCIILogo writes the ASCII art banner to w
+func PrintASCIILogo(w io.Writer) {
 	asciiArt := `
    ___  ___  ___  ___ _____   _____            _____          _                      _   _       _                 _            
   |_  |/ _ \ |  \/  ||  ___| | ___ \          | ___ \        | |                    | | | |     | |               | |           
@@ -14,5 +17,5 @@ func PrintASCIILogo() {
                                                                          __/ |            | |                                   
                                                                         |___/             |_|                                   
 `
-	fmt.Println(asciiArt)
+	fmt.Fprintln(w, asciiArt)
 }
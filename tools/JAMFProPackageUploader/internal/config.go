@@ -0,0 +1,156 @@
+package jamfpropackageuploader
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// envPrefix is prepended to the environment variable of every flag.
+const envPrefix = "JAMF_PACKAGE_UPLOADER_"
+
+// Output formats
+const (
+	OutputText = "text"
+	OutputJSON = "json"
+)
+
+// Config holds the settings of an upload run. Every flag falls back to an environment variable, so the
+// uploader can be configured entirely from a build pipeline.
+type Config struct {
+	// ConfigFile is the Jamf Pro client configuration file. When empty, the client is configured from the
+	// environment variables read by jamfpro.BuildClientWithEnv.
+	ConfigFile  string
+	Directory   string
+	Patterns    []string
+	Recursive   bool
+	Category    string
+	Priority    int
+	Info        string
+	Notes       string
+	DryRun      bool
+	Parallelism int
+	Output      string
+}
+
+// ParseConfig reads the configuration from command line arguments and environment variables. Flags take
+// precedence over environment variables. flag.ErrHelp is returned when usage was requested.
+func ParseConfig(args []string, stderr io.Writer) (*Config, error) {
+	config := &Config{}
+	env := &envReader{}
+	var patterns string
+
+	fs := flag.NewFlagSet("JAMFProPackageUploader", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&config.ConfigFile, "config", env.string("CONFIG", ""), "Jamf Pro client configuration file; when empty the client is configured from the environment")
+	fs.StringVar(&config.Directory, "dir", env.string("DIR", ""), "directory containing the packages to upload (required)")
+	fs.StringVar(&patterns, "pattern", env.string("PATTERN", "*.pkg"), "comma separated glob patterns of package files, matched against file names or paths relative to -dir")
+	fs.BoolVar(&config.Recursive, "recursive", env.bool("RECURSIVE", false), "search subdirectories of -dir")
+	fs.StringVar(&config.Category, "category", env.string("CATEGORY", ""), "category assigned to created packages")
+	fs.IntVar(&config.Priority, "priority", env.int("PRIORITY", 10), "priority assigned to created packages, 1 to 20")
+	fs.StringVar(&config.Info, "info", env.string("INFO", ""), "info text assigned to created packages")
+	fs.StringVar(&config.Notes, "notes", env.string("NOTES", ""), "notes assigned to created packages")
+	fs.BoolVar(&config.DryRun, "dry-run", env.bool("DRY_RUN", false), "report what would be uploaded without changing Jamf Pro")
+	fs.IntVar(&config.Parallelism, "parallel", env.int("PARALLEL", 1), "number of packages uploaded at once")
+	fs.StringVar(&config.Output, "output", env.string("OUTPUT", OutputText), "output format, text or json")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: JAMFProPackageUploader -dir <directory> [flags]\n\n")
+		fmt.Fprintf(stderr, "Uploads packages to JCDS 2.0 and creates their metadata in Jamf Pro. Each flag can also be set\n")
+		fmt.Fprintf(stderr, "with an environment variable named %s followed by the flag name in upper case, with\n", envPrefix)
+		fmt.Fprintf(stderr, "dashes replaced by underscores, e.g. %sDRY_RUN=true.\n\n", envPrefix)
+		fmt.Fprintf(stderr, "The metadata flags are defaults. A YAML or JSON manifest next to a package, e.g. Firefox.pkg.yaml,\n")
+		fmt.Fprintf(stderr, "overrides them for that package, and the name and notes are filled from the package's Distribution.\n\n")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if env.err != nil {
+		return nil, env.err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	for _, pattern := range strings.Split(patterns, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			config.Patterns = append(config.Patterns, pattern)
+		}
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// validate checks the configuration before anything is uploaded.
+func (c *Config) validate() error {
+	if c.Directory == "" {
+		return fmt.Errorf("a source directory is required, set -dir or %sDIR", envPrefix)
+	}
+	info, err := os.Stat(c.Directory)
+	if err != nil {
+		return fmt.Errorf("source directory %s cannot be read: %v", c.Directory, err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("source %s is not a directory", c.Directory)
+	}
+	if len(c.Patterns) == 0 {
+		return fmt.Errorf("at least one file pattern is required")
+	}
+	if c.Priority < 1 || c.Priority > 20 {
+		return fmt.Errorf("priority must be between 1 and 20, got %d", c.Priority)
+	}
+	if c.Parallelism < 1 {
+		return fmt.Errorf("parallel must be at least 1, got %d", c.Parallelism)
+	}
+	if c.Output != OutputText && c.Output != OutputJSON {
+		return fmt.Errorf("output must be %s or %s, got %s", OutputText, OutputJSON, c.Output)
+	}
+	return nil
+}
+
+// envReader reads flag defaults from environment variables and keeps the first invalid value it finds.
+type envReader struct {
+	err error
+}
+
+// string returns the value of the environment variable for a flag, or def when it is not set.
+func (e *envReader) string(name, def string) string {
+	if value, ok := os.LookupEnv(envPrefix + name); ok {
+		return value
+	}
+	return def
+}
+
+// bool returns the boolean environment variable for a flag, or def when it is not set.
+func (e *envReader) bool(name string, def bool) bool {
+	raw, ok := os.LookupEnv(envPrefix + name)
+	if !ok {
+		return def
+	}
+	value, err := strconv.ParseBool(raw)
+	if err != nil && e.err == nil {
+		e.err = fmt.Errorf("%s%s must be true or false, got %q", envPrefix, name, raw)
+	}
+	return value
+}
+
+// int returns the integer environment variable for a flag, or def when it is not set.
+func (e *envReader) int(name string, def int) int {
+	raw, ok := os.LookupEnv(envPrefix + name)
+	if !ok {
+		return def
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil && e.err == nil {
+		e.err = fmt.Errorf("%s%s must be a number, got %q", envPrefix, name, raw)
+	}
+	return value
+}
@@ -1,44 +1,68 @@
 package jamfpropackageuploader
 
 import (
-	"crypto/md5"
-	"crypto/sha512"
-	"encoding/hex"
 	"fmt"
-	"io"
-	"log"
-	"os"
+	"io/fs"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 )
 
-// FindPkgFiles searches the given directory for files ending with .pkg and returns their paths
-func FindPkgFiles(directory string) ([]string, error) {
-	var pkgFiles []string
-	err := filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
+// FindPackageFiles returns the files in directory matching any of the glob patterns, sorted by path. A
+// pattern without a path separator is matched against file names; a pattern with one is matched against
+// paths relative to directory. Subdirectories are searched only when recursive is set.
+func FindPackageFiles(directory string, patterns []string, recursive bool) ([]string, error) {
+	for _, pattern := range patterns {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid file pattern %s: %v", pattern, err)
+		}
+	}
+
+	var files []string
+	err := filepath.WalkDir(directory, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
-		if !info.IsDir() && strings.HasSuffix(info.Name(), ".pkg") {
-			pkgFiles = append(pkgFiles, path)
+		if d.IsDir() {
+			if path != directory && !recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		relative, err := filepath.Rel(directory, path)
+		if err != nil {
+			return err
+		}
+		if matchesAny(patterns, d.Name(), filepath.ToSlash(relative)) {
+			files = append(files, path)
 		}
 		return nil
 	})
-	return pkgFiles, err
+	if err != nil {
+		return nil, fmt.Errorf("failed to search %s for packages: %v", directory, err)
+	}
+
+	sort.Strings(files)
+	return files, nil
 }
 
-// PackageExistsInJCDS checks if a package exists in JCDS by comparing both the file name and MD5 hash.
-func PackageExistsInJCDS(packages []jamfpro.ResponseJCDS2List, fileName, fileMD5 string) bool {
-	for _, pkg := range packages {
-		fmt.Printf("JCDS Entry: FileName: %s, MD5: %s\n", pkg.FileName, pkg.MD5)
-		if pkg.FileName == fileName && strings.EqualFold(pkg.MD5, fileMD5) {
-			fmt.Printf("Match Found: Package %s with MD5 %s already exists in JCDS.\n", fileName, fileMD5)
+// matchesAny reports whether a file matches one of the patterns.
+func matchesAny(patterns []string, name, relative string) bool {
+	for _, pattern := range patterns {
+		target := name
+		if strings.Contains(pattern, "/") {
+			target = relative
+		}
+		if matched, _ := filepath.Match(pattern, target); matched {
 			return true
 		}
 	}
-	fmt.Printf("No Match: Package %s with MD5 %s does not exist in JCDS.\n", fileName, fileMD5)
 	return false
 }
 
@@ -51,43 +75,3 @@ func PackageMetadataExists(packages []jamfpro.PackageListItem, fileName string)
 	}
 	return false
 }
-
-// CalculateFileSHA3 calculates the SHA3 hash of a file
-func CalculateFileSHA3(filePath string) string {
-	file, err := os.Open(filePath)
-	if err != nil {
-		log.Fatalf("Failed to open file %s: %v", filePath, err)
-	}
-	defer file.Close()
-
-	hash := sha512.New512_256()
-	if _, err := io.Copy(hash, file); err != nil {
-		log.Fatalf("Failed to calculate SHA3 for file %s: %v", filePath, err)
-	}
-
-	return hex.EncodeToString(hash.Sum(nil))
-}
-
-// CalculateFileMD5 calculates the MD5 hash of a file and returns it as a hexadecimal string.
-func CalculateFileMD5(filePath string) string {
-	// Open the file
-	file, err := os.Open(filePath)
-	if err != nil {
-		log.Fatalf("Failed to open file %s for MD5 calculation: %v", filePath, err)
-	}
-	defer file.Close()
-
-	// Create a new MD5 hash instance
-	hash := md5.New()
-
-	// Copy the file content into the hash instance
-	if _, err := io.Copy(hash, file); err != nil {
-		log.Fatalf("Failed to calculate MD5 for file %s: %v", filePath, err)
-	}
-
-	// Compute the MD5 checksum
-	md5sum := hash.Sum(nil)
-
-	// Return the hexadecimal representation of the MD5 checksum
-	return hex.EncodeToString(md5sum)
-}
@@ -0,0 +1,195 @@
+package jamfpropackageuploader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// Result statuses
+const (
+	StatusUploaded = "uploaded"
+	StatusSkipped  = "skipped"
+	StatusPlanned  = "planned"
+	StatusFailed   = "failed"
+)
+
+// Result is the outcome of one package file. Name is the Jamf Pro package name, which a sidecar manifest or
+// the package's Distribution may set; it is the file name until the metadata is read.
+type Result struct {
+	File      string  `json:"file"`
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	Message   string  `json:"message,omitempty"`
+	PackageID int     `json:"packageId,omitempty"`
+	URI       string  `json:"uri,omitempty"`
+	Seconds   float64 `json:"seconds"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Summary counts results by status.
+type Summary struct {
+	Uploaded int `json:"uploaded"`
+	Skipped  int `json:"skipped"`
+	Planned  int `json:"planned"`
+	Failed   int `json:"failed"`
+}
+
+// Summarize counts the results by status.
+func Summarize(results []Result) Summary {
+	var summary Summary
+	for _, result := range results {
+		switch result.Status {
+		case StatusUploaded:
+			summary.Uploaded++
+		case StatusSkipped:
+			summary.Skipped++
+		case StatusPlanned:
+			summary.Planned++
+		case StatusFailed:
+			summary.Failed++
+		}
+	}
+	return summary
+}
+
+// uploadRun holds what is looked up once and shared by the uploads of a run.
+type uploadRun struct {
+	client   *jamfpro.Client
+	config   *Config
+	packages []jamfpro.PackageListItem
+	jcds     []jamfpro.ResponseJCDS2List
+}
+
+// Upload uploads the package files and creates their metadata in Jamf Pro, running up to
+// config.Parallelism uploads at once. Packages whose name already exists in Jamf Pro are skipped. With
+// config.DryRun set nothing is changed and each file that would be uploaded is reported as planned. An error
+// is returned only when the run cannot start; failures of single packages are reported in their result.
+func Upload(client *jamfpro.Client, config *Config, files []string) ([]Result, error) {
+	if config.Category != "" {
+		if _, err := client.GetCategoryByName(config.Category); err != nil {
+			return nil, fmt.Errorf("category %s cannot be used: %v", config.Category, err)
+		}
+	}
+
+	packages, err := client.GetPackages()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Jamf Pro packages: %v", err)
+	}
+	run := &uploadRun{client: client, config: config, packages: packages.Package}
+
+	if config.DryRun {
+		run.jcds, err = client.GetJCDS2Packages()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get JCDS 2.0 packages: %v", err)
+		}
+	}
+
+	results := make([]Result, len(files))
+	pending := make(chan int)
+	seen := make(map[string]string)
+	for i, file := range files {
+		results[i] = Result{File: file, Name: filepath.Base(file)}
+		if first, ok := seen[results[i].Name]; ok {
+			results[i].Status = StatusFailed
+			results[i].Error = fmt.Sprintf("file name is also used by %s, package file names must be unique", first)
+			continue
+		}
+		seen[results[i].Name] = file
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < config.Parallelism; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range pending {
+				run.upload(&results[i])
+			}
+		}()
+	}
+	for i := range results {
+		if results[i].Status == "" {
+			pending <- i
+		}
+	}
+	close(pending)
+	wg.Wait()
+
+	return results, nil
+}
+
+// upload processes one package file and records the outcome in result.
+func (r *uploadRun) upload(result *Result) {
+	start := time.Now()
+	defer func() {
+		result.Seconds = time.Since(start).Seconds()
+	}()
+
+	// The run's defaults are completed from the package itself and its sidecar manifest
+	packageData, err := jamfpro.BuildPackageMetadata(result.File, &jamfpro.ResourcePackage{
+		Category: r.config.Category,
+		Priority: r.config.Priority,
+		Info:     r.config.Info,
+		Notes:    r.config.Notes,
+	})
+	if err != nil {
+		result.Status = StatusFailed
+		result.Error = err.Error()
+		return
+	}
+	result.Name = packageData.Name
+
+	if PackageMetadataExists(r.packages, result.Name) {
+		result.Status = StatusSkipped
+		result.Message = "package already exists in Jamf Pro"
+		return
+	}
+
+	if r.config.DryRun {
+		r.plan(result)
+		return
+	}
+
+	file, created, err := r.client.DoPackageUploadWithOptions(result.File, packageData, nil)
+	if err != nil {
+		result.Status = StatusFailed
+		result.Error = err.Error()
+		return
+	}
+
+	result.Status = StatusUploaded
+	result.PackageID = created.ID
+	result.URI = file.URI
+}
+
+// plan reports what an upload of the file would do, without changing Jamf Pro.
+func (r *uploadRun) plan(result *Result) {
+	file, err := os.Open(result.File)
+	if err != nil {
+		result.Status = StatusFailed
+		result.Error = err.Error()
+		return
+	}
+	defer file.Close()
+
+	checksums, err := jamfpro.ComputePackageChecksums(file)
+	if err != nil {
+		result.Status = StatusFailed
+		result.Error = err.Error()
+		return
+	}
+
+	result.Status = StatusPlanned
+	result.Message = "would upload the package and create it in Jamf Pro"
+	for _, existing := range r.jcds {
+		if checksums.Matches(existing) {
+			result.Message = fmt.Sprintf("content is already in JCDS 2.0 as %s, would create the package in Jamf Pro only", existing.FileName)
+			break
+		}
+	}
+}
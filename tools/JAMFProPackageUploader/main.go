@@ -1,139 +1,137 @@
 package main
 
 import (
-	"bufio"
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
-	"log"
+	"io"
 	"os"
-	"path/filepath"
-	"strings"
+	"text/tabwriter"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	uploader "github.com/deploymenttheory/go-api-sdk-jamfpro/tools/JAMFProPackageUploader/internal"
 )
 
-func main() {
-	// Print the ASCII art
-	uploader.PrintASCIILogo()
+// Exit codes
+const (
+	exitOK      = 0
+	exitFailed  = 1
+	exitUsage   = 2
+	exitNoStart = 3
+)
 
-	// Set up a reader from standard input
-	reader := bufio.NewReader(os.Stdin)
+// report is the JSON output of a run.
+type report struct {
+	DryRun  bool              `json:"dryRun"`
+	Summary uploader.Summary  `json:"summary"`
+	Results []uploader.Result `json:"results"`
+	Error   string            `json:"error,omitempty"`
+}
 
-	// Define the directory containing the .pkg files
-	fmt.Print("Enter the directory containing the .pkg files: ")
-	directory, err := reader.ReadString('\n')
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run uploads the packages selected by args and returns the exit code: 1 when any package failed, 2 for
+// invalid usage and 3 when the run could not start.
+func run(args []string, stdout, stderr io.Writer) int {
+	config, err := uploader.ParseConfig(args, stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
 	if err != nil {
-		log.Fatalf("Failed to read directory input: %v", err)
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return exitUsage
 	}
 
-	// Trim space to remove any new line character
-	directory = filepath.Clean(strings.TrimSpace(directory))
-
-	// Validate the directory path
-	if _, err := os.Stat(directory); os.IsNotExist(err) {
-		log.Fatalf("Directory does not exist: %s", directory)
+	if config.Output == uploader.OutputText {
+		uploader.PrintASCIILogo(stderr)
 	}
 
-	// Find all .pkg files in the directory
-	pkgFiles, err := uploader.FindPkgFiles(directory)
+	files, err := uploader.FindPackageFiles(config.Directory, config.Patterns, config.Recursive)
 	if err != nil {
-		log.Fatalf("Failed to find .pkg files: %v", err)
+		return fail(config, stdout, stderr, err)
 	}
-
-	if len(pkgFiles) == 0 {
-		fmt.Println("No .pkg files found in the specified directory.")
-		return
+	if len(files) == 0 {
+		if config.Output == uploader.OutputText {
+			fmt.Fprintf(stderr, "No package files matching %v found in %s\n", config.Patterns, config.Directory)
+			return exitOK
+		}
+		return writeJSON(stdout, stderr, report{DryRun: config.DryRun, Results: []uploader.Result{}})
 	}
 
-	// List out the .pkg files to be uploaded
-	fmt.Println("The following .pkg files will be uploaded:")
-	for _, file := range pkgFiles {
-		fmt.Println(filepath.Base(file))
+	var client *jamfpro.Client
+	if config.ConfigFile != "" {
+		client, err = jamfpro.BuildClientWithConfigFile(config.ConfigFile)
+	} else {
+		client, err = jamfpro.BuildClientWithEnv()
 	}
-
-	// Load the client OAuth configuration
-	// Define the path to the JSON configuration file
-	configFilePath := "/Users/dafyddwatkins/localtesting/jamfpro/clientconfig.json"
-
-	// Initialize the Jamf Pro client with the HTTP client configuration
-	client, err := jamfpro.BuildClientWithConfigFile(configFilePath)
 	if err != nil {
-		log.Fatalf("Failed to initialize Jamf Pro client: %v", err)
+		return fail(config, stdout, stderr, fmt.Errorf("failed to initialize Jamf Pro client: %v", err))
 	}
 
-	jcdsPackages, err := client.GetJCDS2Packages()
-	if err != nil {
-		log.Fatalf("Failed to get JCDS 2.0 packages: %v", err)
+	// A progress bar only makes sense for one upload at a time on an interactive run
+	if config.Output == uploader.OutputText && config.Parallelism == 1 {
+		client.Progress = jamfpro.NewTerminalProgressBar(stderr)
 	}
 
-	jamfProPackages, err := client.GetPackages()
+	results, err := uploader.Upload(client, config, files)
 	if err != nil {
-		log.Fatalf("Failed to get Jamf Pro packages: %v", err)
+		return fail(config, stdout, stderr, err)
 	}
+	summary := uploader.Summarize(results)
 
-	// Create a map of existing JCDS entries
-	jcdsMap := make(map[string]bool)
-	for _, pkg := range jcdsPackages {
-		key := fmt.Sprintf("%s-%s", pkg.FileName, pkg.MD5)
-		jcdsMap[key] = true
+	if config.Output == uploader.OutputJSON {
+		if code := writeJSON(stdout, stderr, report{DryRun: config.DryRun, Summary: summary, Results: results}); code != exitOK {
+			return code
+		}
+	} else {
+		writeText(stdout, results, summary)
 	}
 
-	for _, filePath := range pkgFiles {
-		fileName := filepath.Base(filePath)
-		fileMD5 := uploader.CalculateFileMD5(filePath) // Use the MD5 calculation function
-		jcdsKey := fmt.Sprintf("%s-%s", fileName, fileMD5)
-
-		// Log the start of processing for this file
-		fmt.Println("-------------------------------------------------")
-		fmt.Printf("Processing package: %s\n", fileName)
-		fmt.Printf("Calculated MD5: %s\n", fileMD5)
-
-		// Check JCDS for the package with MD5 using the map
-		fmt.Printf("Checking JCDS for existing package...\n")
-		if _, exists := jcdsMap[jcdsKey]; exists {
-			fmt.Printf("Package %s with MD5 %s already exists in JCDS. Skipping package upload.\n", fileName, fileMD5)
-			fmt.Println("-------------------------------------------------")
-			continue
-		} else {
-			fmt.Printf("Package %s with MD5 %s does not exist in JCDS. Proceeding with package upload.\n", fileName, fileMD5)
-		}
+	if summary.Failed > 0 {
+		return exitFailed
+	}
+	return exitOK
+}
 
-		// Check Jamf Pro for existing package metadata
-		fmt.Printf("Checking Jamf Pro for existing package metadata...\n")
-		if uploader.PackageMetadataExists(jamfProPackages.Package, fileName) {
-			fmt.Printf("Package metadata for %s already exists in Jamf Pro. Skipping metadata creation.\n", fileName)
-			fmt.Println("-------------------------------------------------")
-			continue
-		} else {
-			fmt.Printf("Package metadata for %s does not exist in Jamf Pro. Proceeding with package metadata creation.\n", fileName)
+// writeText prints one line per package followed by the summary.
+func writeText(w io.Writer, results []uploader.Result, summary uploader.Summary) {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "STATUS\tPACKAGE\tID\tDETAIL")
+	for _, result := range results {
+		detail := result.Message
+		if result.Error != "" {
+			detail = result.Error
 		}
-
-		// Upload the package
-		fmt.Printf("Uploading package: %s\n", fileName)
-
-		// Assuming packageMetadata is correctly defined somewhere in your code
-		var packageMetadata *jamfpro.ResourcePackage
-
-		responseJCDS2File, responsePackageCreatedAndUpdated, err := client.DoPackageUpload(filePath, packageMetadata)
-		if err != nil {
-			log.Fatalf("Failed to upload %s: %v", filePath, err)
+		id := ""
+		if result.PackageID != 0 {
+			id = fmt.Sprint(result.PackageID)
 		}
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\n", result.Status, result.Name, id, detail)
+	}
+	table.Flush()
 
-		// Marshal and log the JCDS2File response
-		responseJCDS2Bytes, err := json.Marshal(responseJCDS2File)
-		if err != nil {
-			log.Fatalf("Failed to marshal JCDS2File response for %s: %v", filePath, err)
-		}
-		fmt.Printf("JCDS2File Upload response for %s: %s\n", fileName, string(responseJCDS2Bytes))
+	fmt.Fprintf(w, "\n%d uploaded, %d skipped, %d planned, %d failed\n", summary.Uploaded, summary.Skipped, summary.Planned, summary.Failed)
+}
 
-		// Marshal and log the PackageCreatedAndUpdated response
-		responsePackageCreatedAndUpdatedBytes, err := json.Marshal(responsePackageCreatedAndUpdated)
-		if err != nil {
-			log.Fatalf("Failed to marshal PackageCreatedAndUpdated response for %s: %v", filePath, err)
-		}
-		fmt.Printf("PackageCreatedAndUpdated response for %s: %s\n", fileName, string(responsePackageCreatedAndUpdatedBytes))
+// writeJSON writes the report to w.
+func writeJSON(w, stderr io.Writer, r report) int {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(r); err != nil {
+		fmt.Fprintf(stderr, "Error: failed to write JSON output: %v\n", err)
+		return exitFailed
+	}
+	return exitOK
+}
 
-		fmt.Println("-------------------------------------------------")
+// fail reports an error that stopped the run before any package was processed.
+func fail(config *uploader.Config, stdout, stderr io.Writer, err error) int {
+	fmt.Fprintf(stderr, "Error: %v\n", err)
+	if config.Output == uploader.OutputJSON {
+		writeJSON(stdout, stderr, report{DryRun: config.DryRun, Results: []uploader.Result{}, Error: err.Error()})
 	}
+	return exitNoStart
 }